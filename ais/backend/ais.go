@@ -576,6 +576,7 @@ func (m *AISbp) GetObj(_ context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Re
 		params.OWT = owt
 		params.Size = size
 		params.Atime = time.Now()
+		params.ColdGET = true
 	}
 	err = m.t.PutObject(lom, params)
 	core.FreePutParams(params)
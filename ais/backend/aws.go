@@ -63,7 +63,10 @@ var (
 )
 
 // interface guard
-var _ core.Backend = (*s3bp)(nil)
+var (
+	_ core.Backend   = (*s3bp)(nil)
+	_ core.ObjTagger = (*s3bp)(nil)
+)
 
 // environment variables => static defaults that can still be overridden via bck.Props.Extra.AWS
 // in addition to these two (below), default bucket region = env.AwsDefaultRegion()
@@ -653,6 +656,7 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 		svc                   *s3.Client
 		uploader              *s3manager.Uploader
 		uploadOutput          *s3manager.UploadOutput
+		input                 *s3.PutObjectInput
 		h                     = cmn.BackendHelpers.Amazon
 		cksumType, cksumValue = lom.Checksum().Get()
 		cloudBck              = lom.Bck().RemoteBck()
@@ -699,12 +703,16 @@ func (*s3bp) PutObj(ctx context.Context, r io.ReadCloser, lom *core.LOM, oreq *h
 		uploader.PartSize = partSize
 	}
 
-	uploadOutput, err = uploader.Upload(ctx, &s3.PutObjectInput{
+	input = &s3.PutObjectInput{
 		Bucket:   aws.String(cloudBck.Name),
 		Key:      aws.String(lom.ObjName),
 		Body:     r,
 		Metadata: md,
-	})
+	}
+	if tags, ok := lom.GetCustomKey(cmn.TagsObjMD); ok && tags != "" {
+		input.Tagging = aws.String(tags)
+	}
+	uploadOutput, err = uploader.Upload(ctx, input)
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 		cos.Close(r)
@@ -771,6 +779,52 @@ func (*s3bp) DeleteObj(ctx context.Context, lom *core.LOM) (ecode int, err error
 	return
 }
 
+//
+// OBJECT TAGGING
+//
+
+// (compare with tags passed via PutObj above)
+func (*s3bp) PutObjTags(ctx context.Context, lom *core.LOM, tags string) (ecode int, err error) {
+	const tag = "[put_object_tagging]"
+	var (
+		svc      *s3.Client
+		cloudBck = lom.Bck().RemoteBck()
+		sessConf = sessConf{bck: cloudBck}
+	)
+	svc, err = sessConf.s3client(tag)
+	if err != nil {
+		return
+	}
+	if tags == "" {
+		_, err = svc.DeleteObjectTagging(ctx, &s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(cloudBck.Name),
+			Key:    aws.String(lom.ObjName),
+		})
+	} else {
+		var kvs cos.StrKVs
+		if kvs, err = cmn.ParseObjTags(tags); err != nil {
+			return http.StatusBadRequest, err
+		}
+		tagSet := make([]types.Tag, 0, len(kvs))
+		for k, v := range kvs {
+			tagSet = append(tagSet, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		_, err = svc.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
+			Bucket:  aws.String(cloudBck.Name),
+			Key:     aws.String(lom.ObjName),
+			Tagging: &types.Tagging{TagSet: tagSet},
+		})
+	}
+	if err != nil {
+		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
+		return
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln(tag, lom.String())
+	}
+	return
+}
+
 //
 // static helpers
 //
@@ -0,0 +1,489 @@
+//go:build file
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// file:// backend
+// - exposes an existing directory tree (e.g., NFS mount visible to all targets) as remote buckets
+// - configured via `backend.file.root`: each subdirectory of the root is a bucket
+// - object name is the file's path relative to the bucket directory
+// - version is the file's modification time (in nanoseconds), ETag - a combination of mtime and size
+// - remote PUT writes a temporary file and then renames it, to replace the destination atomically
+
+const fileTmpPrefix = ".ais-tmp." // temporary (work) files; never listed
+
+type (
+	filebp struct {
+		t core.TargetPut
+		base
+	}
+	fileWalk struct {
+		msg     *apc.LsoMsg
+		lst     *cmn.LsoRes
+		bdir    string
+		token   string // continuation token => walk key (see below)
+		last    string // last listed name
+		noRecur bool
+		noDirs  bool
+		custom  bool
+		props   bool
+		full    bool // page is full
+	}
+)
+
+// interface guard
+var _ core.Backend = (*filebp)(nil)
+
+var (
+	errFileWalkStop = errors.New("stop")
+	errFileOname    = errors.New("invalid object name")
+)
+
+func NewFile(t core.TargetPut, _ *cmn.Config, tstats stats.Tracker, startingUp bool) (core.Backend, error) {
+	bp := &filebp{
+		t:    t,
+		base: base{provider: apc.File},
+	}
+	bp.init(t.Snode(), tstats, startingUp)
+	return bp, nil
+}
+
+// (root may change at runtime - see config.Backend)
+func (*filebp) root() (string, error) {
+	switch conf := cmn.GCO.Get().Backend.Get(apc.File).(type) {
+	case nil:
+		return "", &cmn.ErrMissingBackend{Provider: apc.File}
+	case cmn.BackendConfFile:
+		return conf.Root, nil
+	default:
+		var fconf cmn.BackendConfFile
+		if err := cos.MorphMarshal(conf, &fconf); err != nil {
+			return "", err
+		}
+		if err := fconf.Validate(); err != nil {
+			return "", err
+		}
+		return fconf.Root, nil
+	}
+}
+
+func (bp *filebp) bckDir(bck *meta.Bck) (string, error) {
+	root, err := bp.root()
+	if err != nil {
+		return "", err
+	}
+	var (
+		cloudBck = bck.RemoteBck()
+		bdir     = filepath.Join(root, cloudBck.Name)
+	)
+	if filepath.Dir(bdir) != root {
+		return "", fmt.Errorf("invalid %s bucket name %q", apc.File, cloudBck.Name)
+	}
+	return bdir, nil
+}
+
+func (bp *filebp) objPath(lom *core.LOM) (string, error) {
+	bdir, err := bp.bckDir(lom.Bck())
+	if err != nil {
+		return "", err
+	}
+	// must resolve to a file inside the bucket directory
+	fpath := filepath.Join(bdir, lom.ObjName)
+	if !strings.HasPrefix(fpath, bdir+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w %q", errFileOname, lom.ObjName)
+	}
+	return fpath, nil
+}
+
+func fileErr(err error, cname string) (int, error) {
+	switch {
+	case os.IsNotExist(err):
+		return http.StatusNotFound, cos.NewErrNotFound(core.T, cname)
+	case os.IsPermission(err):
+		return http.StatusForbidden, err
+	case errors.Is(err, errFileOname):
+		return http.StatusBadRequest, err
+	default:
+		return 0, err
+	}
+}
+
+// (mtime-based) version and ETag
+func fileVersion(finfo os.FileInfo) string {
+	return strconv.FormatInt(finfo.ModTime().UnixNano(), 10)
+}
+
+func fileETag(finfo os.FileInfo) string {
+	return strconv.FormatInt(finfo.ModTime().UnixNano(), 16) + "-" + strconv.FormatInt(finfo.Size(), 16)
+}
+
+func setCustomFile(lom *core.LOM, finfo os.FileInfo) {
+	v := fileVersion(finfo)
+	lom.SetVersion(v)
+	lom.SetCustomKey(cmn.SourceObjMD, apc.File)
+	lom.SetCustomKey(cmn.VersionObjMD, v)
+	lom.SetCustomKey(cmn.ETag, fileETag(finfo))
+	lom.SetCustomKey(cmn.LsoLastModified, fmtLsoTime(finfo.ModTime()))
+	lom.SetCustomKey(cos.HdrLastModified, fmtHdrTime(finfo.ModTime()))
+}
+
+//
+// HEAD BUCKET
+//
+
+func (bp *filebp) HeadBucket(_ context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
+	bdir, err := bp.bckDir(bck)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	finfo, err := os.Stat(bdir)
+	if err != nil || !finfo.IsDir() {
+		if err == nil || os.IsNotExist(err) {
+			return nil, http.StatusNotFound, cmn.NewErrRemoteBckNotFound(bck.RemoteBck())
+		}
+		ecode, err := fileErr(err, bck.Cname(""))
+		return nil, ecode, err
+	}
+	bckProps := make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.File
+	// every file has a version (its mtime) - see setCustomFile
+	bckProps[apc.HdrBucketVerEnabled] = "true"
+	return bckProps, 0, nil
+}
+
+//
+// LIST BUCKETS
+//
+
+func (bp *filebp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, _ int, _ error) {
+	root, err := bp.root()
+	if err != nil {
+		return nil, 0, err
+	}
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		ecode, err := fileErr(err, root)
+		return nil, ecode, err
+	}
+	for _, ent := range entries {
+		if !ent.IsDir() {
+			continue
+		}
+		bck := cmn.Bck{Name: ent.Name(), Provider: apc.File}
+		if bck.ValidateName() != nil {
+			continue
+		}
+		bcks = append(bcks, bck)
+	}
+	return bcks, 0, nil
+}
+
+//
+// LIST OBJECTS
+// - directory tree walk in lexical order with `/` sorting before any other character (see walkKey)
+// - continuation token is the last listed name
+//
+
+func (bp *filebp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	bdir, err := bp.bckDir(bck)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if _, err := os.Stat(bdir); err != nil {
+		if os.IsNotExist(err) {
+			return http.StatusNotFound, cmn.NewErrRemoteBckNotFound(bck.RemoteBck())
+		}
+		return fileErr(err, bck.Cname(""))
+	}
+	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
+
+	w := &fileWalk{
+		msg:     msg,
+		lst:     lst,
+		bdir:    bdir,
+		token:   walkKey(msg.ContinuationToken),
+		noRecur: msg.IsFlagSet(apc.LsNoRecursion),
+		noDirs:  msg.IsFlagSet(apc.LsNoDirs),
+		custom:  msg.WantProp(apc.GetPropsCustom),
+		props:   !msg.IsFlagSet(apc.LsNameOnly) && !msg.IsFlagSet(apc.LsNameSize),
+	}
+	lst.Entries = lst.Entries[:0]
+
+	// start from the deepest directory that contains the prefix
+	start := bdir
+	if i := strings.LastIndexByte(msg.Prefix, '/'); i > 0 {
+		start = filepath.Join(bdir, msg.Prefix[:i])
+	}
+	err = filepath.WalkDir(start, w.cb)
+	if err != nil && err != errFileWalkStop && !os.IsNotExist(err) {
+		return fileErr(err, bck.Cname(""))
+	}
+	if w.full {
+		lst.ContinuationToken = w.last
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[list_objects]", bck.Cname(""), len(lst.Entries))
+	}
+	return 0, nil
+}
+
+// walk order: "a/b" < "a-b" (compare with plain lexical)
+func walkKey(name string) string { return strings.ReplaceAll(name, "/", "\x00") }
+
+func (w *fileWalk) cb(fqn string, de fs.DirEntry, err error) error {
+	if err != nil {
+		if os.IsNotExist(err) || os.IsPermission(err) {
+			return nil
+		}
+		return err
+	}
+	if fqn == w.bdir {
+		return nil
+	}
+	rel := filepath.ToSlash(fqn[len(w.bdir)+1:])
+	if de.IsDir() {
+		return w.dir(rel)
+	}
+	if !de.Type().IsRegular() || strings.HasPrefix(de.Name(), fileTmpPrefix) {
+		return nil
+	}
+	if !strings.HasPrefix(rel, w.msg.Prefix) {
+		return nil
+	}
+	if w.token != "" && walkKey(rel) <= w.token {
+		return nil
+	}
+	en := &cmn.LsoEnt{Name: rel}
+	if !w.msg.IsFlagSet(apc.LsNameOnly) {
+		finfo, err := de.Info()
+		if err != nil {
+			return nil // (removed in the meantime)
+		}
+		en.Size = finfo.Size()
+		if w.props {
+			en.Version = fileVersion(finfo)
+			if w.custom {
+				en.Custom = cmn.CustomProps2S(cmn.ETag, fileETag(finfo), cmn.LsoLastModified, fmtLsoTime(finfo.ModTime()))
+			}
+		}
+	}
+	return w.add(en)
+}
+
+func (w *fileWalk) dir(rel string) error {
+	var (
+		prefix = w.msg.Prefix
+		dir    = rel + "/"
+	)
+	// same branch?
+	if prefix != "" && !strings.HasPrefix(prefix, dir) && !strings.HasPrefix(dir, prefix) {
+		return filepath.SkipDir
+	}
+	// already listed?
+	if w.token != "" {
+		key := walkKey(dir)
+		if key <= w.token && !strings.HasPrefix(w.token, key) {
+			return filepath.SkipDir
+		}
+	}
+	if !w.noRecur || len(dir) <= len(prefix) {
+		return nil
+	}
+	// no recursion: virtual directory
+	if w.noDirs || (w.token != "" && walkKey(dir) <= w.token) {
+		return filepath.SkipDir
+	}
+	if err := w.add(&cmn.LsoEnt{Name: dir, Flags: apc.EntryIsDir}); err != nil {
+		return err
+	}
+	return filepath.SkipDir
+}
+
+func (w *fileWalk) add(en *cmn.LsoEnt) error {
+	if int64(len(w.lst.Entries)) >= w.msg.PageSize {
+		w.full = true
+		return errFileWalkStop
+	}
+	w.lst.Entries = append(w.lst.Entries, en)
+	w.last = en.Name
+	return nil
+}
+
+//
+// HEAD OBJECT
+//
+
+func (bp *filebp) HeadObj(_ context.Context, lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
+	fpath, err := bp.objPath(lom)
+	if err != nil {
+		ecode, err := fileErr(err, lom.Cname())
+		return nil, ecode, err
+	}
+	finfo, err := os.Stat(fpath)
+	if err == nil && !finfo.Mode().IsRegular() {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		ecode, err := fileErr(err, lom.Cname())
+		return nil, ecode, err
+	}
+	v := fileVersion(finfo)
+	oa := &cmn.ObjAttrs{Size: finfo.Size(), CustomMD: make(cos.StrKVs, 5)}
+	oa.SetVersion(v)
+	oa.SetCustomKey(cmn.SourceObjMD, apc.File)
+	oa.SetCustomKey(cmn.VersionObjMD, v)
+	oa.SetCustomKey(cmn.ETag, fileETag(finfo))
+	oa.SetCustomKey(cos.HdrLastModified, fmtHdrTime(finfo.ModTime()))
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[head_object]", lom.Cname(), fpath)
+	}
+	return oa, 0, nil
+}
+
+//
+// GET OBJECT
+//
+
+func (bp *filebp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *http.Request) (int, error) {
+	res := bp.GetObjReader(ctx, lom, 0, 0)
+	if res.Err != nil {
+		return res.ErrCode, res.Err
+	}
+	params := allocPutParams(res, owt)
+	err := bp.t.PutObject(lom, params)
+	core.FreePutParams(params)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[get_object]", lom.String(), err)
+	}
+	return 0, err
+}
+
+func (bp *filebp) GetObjReader(_ context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+	fpath, err := bp.objPath(lom)
+	if err != nil {
+		res.ErrCode, res.Err = fileErr(err, lom.Cname())
+		return res
+	}
+	finfo, err := os.Stat(fpath)
+	if err == nil && !finfo.Mode().IsRegular() {
+		err = os.ErrNotExist
+	}
+	if err != nil {
+		res.ErrCode, res.Err = fileErr(err, lom.Cname())
+		return res
+	}
+	if length > 0 {
+		if offset+length > finfo.Size() {
+			res.Err = cmn.NewErrRangeNotSatisfiable(nil, nil, finfo.Size())
+			res.ErrCode = http.StatusRequestedRangeNotSatisfiable
+			return res
+		}
+		fsh, err := cos.NewFileSectionHandle(fpath, offset, length)
+		if err != nil {
+			res.ErrCode, res.Err = fileErr(err, lom.Cname())
+			return res
+		}
+		res.R, res.Size = fsh, length
+		return res
+	}
+	fh, err := os.Open(fpath)
+	if err != nil {
+		res.ErrCode, res.Err = fileErr(err, lom.Cname())
+		return res
+	}
+	setCustomFile(lom, finfo)
+	res.R, res.Size = fh, finfo.Size()
+	return res
+}
+
+//
+// PUT OBJECT
+//
+
+func (bp *filebp) PutObj(_ context.Context, r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
+	fpath, err := bp.objPath(lom)
+	if err != nil {
+		cos.Close(r)
+		return fileErr(err, lom.Cname())
+	}
+	tmp := filepath.Join(filepath.Dir(fpath), fileTmpPrefix+filepath.Base(fpath)+"."+cos.GenTie())
+	written, err := bp.write(tmp, r)
+	cos.Close(r)
+	if err == nil {
+		err = os.Rename(tmp, fpath)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(tmp); errRm != nil {
+			nlog.Errorln("failed to remove", tmp, "[", errRm, "]")
+		}
+		return fileErr(err, lom.Cname())
+	}
+	finfo, err := os.Stat(fpath)
+	if err != nil {
+		return fileErr(err, lom.Cname())
+	}
+	setCustomFile(lom, finfo)
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[put_object]", lom.String(), "size", written, "=>", fpath)
+	}
+	return 0, nil
+}
+
+func (bp *filebp) write(fpath string, r io.Reader) (int64, error) {
+	fh, err := cos.CreateFile(fpath)
+	if err != nil {
+		return 0, err
+	}
+	buf, slab := bp.t.PageMM().Alloc()
+	written, err := io.CopyBuffer(fh, r, buf)
+	slab.Free(buf)
+	if err == nil {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	return written, err
+}
+
+//
+// DELETE OBJECT
+//
+
+func (bp *filebp) DeleteObj(_ context.Context, lom *core.LOM) (int, error) {
+	fpath, err := bp.objPath(lom)
+	if err == nil {
+		err = os.Remove(fpath)
+	}
+	if err != nil {
+		return fileErr(err, lom.Cname())
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleBackend) {
+		nlog.Infoln("[delete_object]", lom.String(), fpath)
+	}
+	return 0, nil
+}
@@ -0,0 +1,244 @@
+//go:build file
+
+// Package backend contains core/backend interface implementations for supported backend providers.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	coremock "github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const testFileBck = "fbck"
+
+// root/fbck/... in walk order (compare with plain lexical: "a-b" < "a/b-c")
+var testFileObjs = []string{
+	"a/b/c",
+	"a/b-c",
+	"a/bc",
+	"a-b",
+	"b/x",
+	"b/y/z",
+	"c",
+}
+
+func newTestFile(t *testing.T) (*filebp, *meta.Bck, string) {
+	root := t.TempDir()
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.Conf = map[string]any{apc.File: cmn.BackendConfFile{Root: root}}
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Backend.Conf = nil
+		cmn.GCO.CommitUpdate(config)
+	})
+
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+
+	bck := meta.NewBck(testFileBck, apc.File, cmn.NsGlobal, &cmn.Bprops{BID: 0x42})
+	tgt := coremock.NewTarget(coremock.NewBaseBownerMock(bck))
+
+	bdir := filepath.Join(root, testFileBck)
+	for _, name := range testFileObjs {
+		fpath := filepath.Join(bdir, name)
+		tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fpath)))
+		tassert.CheckFatal(t, os.WriteFile(fpath, []byte(name), 0o644))
+	}
+	// (never listed)
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(bdir, "a", fileTmpPrefix+"bc.123"), nil, 0o644))
+
+	return &filebp{t: tgt, base: base{provider: apc.File}}, bck, bdir
+}
+
+func newTestFileLOM(t *testing.T, bck *meta.Bck, name string) *core.LOM {
+	lom := core.AllocLOM(name)
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	t.Cleanup(func() { core.FreeLOM(lom) })
+	return lom
+}
+
+func (bp *filebp) lsAll(t *testing.T, bck *meta.Bck, msg *apc.LsoMsg) (names []string, pages int) {
+	for {
+		lst := &cmn.LsoRes{}
+		_, err := bp.ListObjects(bck, msg, lst)
+		tassert.CheckFatal(t, err)
+		for _, en := range lst.Entries {
+			names = append(names, en.Name)
+		}
+		pages++
+		if lst.ContinuationToken == "" {
+			return names, pages
+		}
+		tassert.Fatalf(t, pages <= len(testFileObjs), "endless listing (token %q)", lst.ContinuationToken)
+		msg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+func TestFileWalkKey(t *testing.T) {
+	sorted := slices.Clone(testFileObjs)
+	slices.SortFunc(sorted, func(a, b string) int { return strings.Compare(walkKey(a), walkKey(b)) })
+	tassert.Errorf(t, slices.Equal(sorted, testFileObjs), "walk order: expecting %v, got %v", testFileObjs, sorted)
+	tassert.Errorf(t, walkKey("a/b") < walkKey("a-b"), "expecting '/' to sort first")
+}
+
+func TestFileListObjects(t *testing.T) {
+	bp, bck, _ := newTestFile(t)
+
+	// all pages, in walk order
+	for _, pageSize := range []int64{0, 1, 2, 4, int64(len(testFileObjs))} {
+		names, pages := bp.lsAll(t, bck, &apc.LsoMsg{PageSize: pageSize})
+		tassert.Errorf(t, slices.Equal(names, testFileObjs), "page size %d: expecting %v, got %v", pageSize, testFileObjs, names)
+		if pageSize == 1 {
+			tassert.Errorf(t, pages == len(testFileObjs), "page size 1: expecting %d pages, got %d", len(testFileObjs), pages)
+		}
+	}
+
+	// prefix
+	tests := []struct {
+		prefix string
+		flags  uint64
+		expect []string
+	}{
+		{"a/b", 0, []string{"a/b/c", "a/b-c", "a/bc"}},
+		{"a/", 0, []string{"a/b/c", "a/b-c", "a/bc"}},
+		{"a/b/", 0, []string{"a/b/c"}},
+		{"b/y", 0, []string{"b/y/z"}},
+		{"a", apc.LsNoRecursion, []string{"a/", "a-b"}},
+		{"", apc.LsNoRecursion, []string{"a/", "a-b", "b/", "c"}},
+		{"", apc.LsNoRecursion | apc.LsNoDirs, []string{"a-b", "c"}},
+		{"b/", apc.LsNoRecursion, []string{"b/x", "b/y/"}},
+		{"x", 0, nil},
+	}
+	for _, test := range tests {
+		for _, pageSize := range []int64{0, 1} {
+			names, _ := bp.lsAll(t, bck, &apc.LsoMsg{Prefix: test.prefix, Flags: test.flags, PageSize: pageSize})
+			tassert.Errorf(t, slices.Equal(names, test.expect), "prefix %q, flags %x, page size %d: expecting %v, got %v",
+				test.prefix, test.flags, pageSize, test.expect, names)
+		}
+	}
+
+	// resume from any listed name
+	for i, token := range testFileObjs {
+		names, _ := bp.lsAll(t, bck, &apc.LsoMsg{ContinuationToken: token})
+		tassert.Errorf(t, slices.Equal(names, testFileObjs[i+1:]), "token %q: expecting %v, got %v", token, testFileObjs[i+1:], names)
+	}
+
+	// props
+	lst := &cmn.LsoRes{}
+	_, err := bp.ListObjects(bck, &apc.LsoMsg{Prefix: "a/b/", Props: apc.GetPropsCustom}, lst)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == 1, "expecting a/b/c, got %d entries", len(lst.Entries))
+	en := lst.Entries[0]
+	tassert.Errorf(t, en.Size == int64(len("a/b/c")) && en.Version != "" && en.Custom != "", "unexpected %+v", en)
+}
+
+func TestFileObjPath(t *testing.T) {
+	bp, bck, bdir := newTestFile(t)
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"a/b/c", true},
+		{"x/../a-b", true},
+		{"..", false},
+		{"../fbck2/obj", false},
+		{"a/../../obj", false},
+		{"a/../..", false},
+		{".", false},
+		{"a/..", false},
+	}
+	for _, test := range tests {
+		fpath, err := bp.objPath(newTestFileLOM(t, bck, test.name))
+		if !test.ok {
+			tassert.Errorf(t, err != nil, "%q: expecting error, got %q", test.name, fpath)
+			ecode, _ := fileErr(err, test.name)
+			tassert.Errorf(t, ecode == 400, "%q: expecting bad request, got %d", test.name, ecode)
+			continue
+		}
+		tassert.CheckError(t, err)
+		tassert.Errorf(t, strings.HasPrefix(fpath, bdir+"/"), "%q: %q outside %q", test.name, fpath, bdir)
+	}
+
+	// bucket name
+	_, err := bp.bckDir(meta.NewBck("..", apc.File, cmn.NsGlobal))
+	tassert.Errorf(t, err != nil, "expecting invalid bucket name")
+}
+
+func TestFilePutDelete(t *testing.T) {
+	bp, bck, bdir := newTestFile(t)
+	ctx := context.Background()
+
+	newLOM := func(name string) *core.LOM { return newTestFileLOM(t, bck, name) }
+
+	// new object (and directories)
+	lom := newLOM("new/dir/obj")
+	payload := "new content"
+	_, err := bp.PutObj(ctx, io.NopCloser(strings.NewReader(payload)), lom, nil)
+	tassert.CheckFatal(t, err)
+	b, err := os.ReadFile(filepath.Join(bdir, "new/dir/obj"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == payload, "expecting %q, got %q", payload, b)
+	v, _ := lom.GetCustomKey(cmn.VersionObjMD)
+	tassert.Errorf(t, v != "" && lom.Version() == v, "expecting mtime-based version, got %q (%q)", lom.Version(), v)
+
+	oa, _, err := bp.HeadObj(ctx, lom, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, oa.Size == int64(len(payload)) && oa.Version() == v, "HEAD: unexpected %+v", oa)
+
+	// overwrite
+	payload = "overwritten"
+	_, err = bp.PutObj(ctx, io.NopCloser(strings.NewReader(payload)), newLOM("a/bc"), nil)
+	tassert.CheckFatal(t, err)
+	res := bp.GetObjReader(ctx, newLOM("a/bc"), 0, 0)
+	tassert.CheckFatal(t, res.Err)
+	b, err = io.ReadAll(res.R)
+	res.R.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == payload, "expecting %q, got %q", payload, b)
+
+	// no temporary files left behind
+	names, _ := bp.lsAll(t, bck, &apc.LsoMsg{Prefix: "new/"})
+	tassert.Errorf(t, slices.Equal(names, []string{"new/dir/obj"}), "unexpected %v", names)
+	entries, err := os.ReadDir(filepath.Join(bdir, "a"))
+	tassert.CheckFatal(t, err)
+	for _, de := range entries {
+		tassert.Errorf(t, !strings.HasPrefix(de.Name(), fileTmpPrefix) || de.Name() == fileTmpPrefix+"bc.123",
+			"unexpected work file %s", de.Name())
+	}
+
+	// delete
+	_, err = bp.DeleteObj(ctx, lom)
+	tassert.CheckFatal(t, err)
+	_, err = os.Stat(filepath.Join(bdir, "new/dir/obj"))
+	tassert.Errorf(t, os.IsNotExist(err), "expecting deleted, got %v", err)
+	ecode, err := bp.DeleteObj(ctx, lom)
+	tassert.Errorf(t, err != nil && ecode == 404, "expecting not found, got %d: %v", ecode, err)
+	_, ecode, err = bp.HeadObj(ctx, lom, nil)
+	tassert.Errorf(t, err != nil && ecode == 404, "HEAD: expecting not found, got %d: %v", ecode, err)
+
+	// reject traversal
+	lom = newLOM("../escape")
+	ecode, err = bp.PutObj(ctx, io.NopCloser(strings.NewReader(payload)), lom, nil)
+	tassert.Errorf(t, err != nil && ecode == 400, "expecting bad request, got %d: %v", ecode, err)
+	_, err = os.Stat(filepath.Join(filepath.Dir(bdir), "escape"))
+	tassert.Errorf(t, os.IsNotExist(err), "expecting nothing written outside the bucket, got %v", err)
+}
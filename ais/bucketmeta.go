@@ -90,6 +90,7 @@ type (
 		wait         bool
 		needReMirror bool
 		needReEC     bool
+		needReencode bool // (needReEC on a bucket that is already erasure coded)
 		terminate    bool
 		singleTarget bool
 	}
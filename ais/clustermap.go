@@ -82,6 +82,7 @@ type (
 		nsi         *meta.Snode  // new node to be added
 		nid         string       // node ID of the candidate primary
 		sid         string       // ID of the node to modify
+		domain      string       // failure domain to set (apc.ActSetFdomain)
 		flags       cos.BitFlags // enum cmn.Snode* to set or clear
 		nver        int64        // new Smap version (cloned and modified `smap` - see above)
 		status      int          // resulting http.Status*
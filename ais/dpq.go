@@ -33,6 +33,9 @@ type dpq struct {
 	etl struct {
 		name, targs string // QparamETLName, QparamETLTransformArgs
 	}
+	mpt struct {
+		id, part string // QparamMptUploadID, QparamMptPartNo
+	}
 
 	ptime       string // req timestamp at calling/redirecting proxy (QparamUnixTime)
 	uuid        string // xaction
@@ -40,6 +43,7 @@ type dpq struct {
 	owt         string // object write transaction { OwtPut, ... }
 	fltPresence string // QparamFltPresence
 	binfo       string // bucket info, with or without requirement to summarize remote obj-s
+	versionID   string // QparamVersionID (noncurrent version)
 
 	skipVC        bool // QparamSkipVC (skip loading existing object's metadata)
 	isGFN         bool // QparamIsGFNRequest
@@ -139,6 +143,10 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			}
 		case apc.QparamOWT:
 			dpq.owt = value
+		case apc.QparamMptUploadID:
+			dpq.mpt.id = value
+		case apc.QparamMptPartNo:
+			dpq.mpt.part = value
 
 		case apc.QparamFltPresence:
 			dpq.fltPresence = value
@@ -155,6 +163,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.silent = cos.IsParseBool(value)
 		case apc.QparamLatestVer:
 			dpq.latestVer = cos.IsParseBool(value)
+		case apc.QparamVersionID:
+			dpq.versionID = value
 
 		default: // the key must be known or `_except`-ed
 			if strings.HasPrefix(key, s3.HeaderPrefix) {
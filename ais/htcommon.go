@@ -17,6 +17,7 @@ import (
 	"net/url"
 	"os"
 	rdebug "runtime/debug"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -865,6 +866,7 @@ func apiReqFree(a *apiRequest) {
 // http response: xaction ID most of the time but may be any string
 func writeXid(w http.ResponseWriter, xid string) {
 	debug.Assert(xid != "")
+	auditXid(w, xid)
 	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xid)))
 	w.Write(cos.UnsafeB(xid))
 }
@@ -939,11 +941,16 @@ func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt in
 		return
 	}
 	if smap != nil {
-		targetCnt = smap.CountActiveTs()
+		targetCnt = smap.CountActiveDomains()
 	}
-	if !bprops.EC.Enabled ||
-		(bprops.EC.DataSlices != nprops.EC.DataSlices || bprops.EC.ParitySlices != nprops.EC.ParitySlices) {
+	if !bprops.EC.Enabled || _ecLayoutChanged(&bprops.EC, &nprops.EC) {
 		yes = true
 	}
 	return
 }
+
+// whether existing erasure coded objects need to be re-encoded (see ec-reencode)
+func _ecLayoutChanged(bconf, nconf *cmn.ECConf) bool {
+	return bconf.DataSlices != nconf.DataSlices || bconf.ParitySlices != nconf.ParitySlices ||
+		bconf.ObjSizeLimit != nconf.ObjSizeLimit || !slices.Equal(bconf.Profiles, nconf.Profiles)
+}
@@ -0,0 +1,105 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket CORS (see cmn/cors.go):
+// - preflight (OPTIONS) requests are handled by the proxy, without authentication
+// - actual cross-origin GET, HEAD, and PUT responses carry `Access-Control-*` headers
+//   set by the proxy (when redirecting) and by the target (when responding)
+
+var errCORSPreflight = errors.New("invalid CORS preflight request: expecting Origin and Access-Control-Request-Method")
+
+// actual (non-preflight) request: no Origin or no rules - nothing to do
+func corsActual(hdr, rhdr http.Header, method string, props *cmn.Bprops) {
+	origin := rhdr.Get(cos.HdrOrigin)
+	if origin == "" || props == nil || !props.CORS.IsActive() {
+		return
+	}
+	hdr.Add(cos.HdrVary, cos.HdrOrigin)
+	rule := props.CORS.Match(origin, method, nil)
+	if rule == nil {
+		return
+	}
+	_corsOrigin(hdr, rule, origin)
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+}
+
+// OPTIONS /v1/objects/<bucket-name>[/<object-name>] and /s3/<bucket-name>[/<object-name>]
+func corsPreflight(hdr, rhdr http.Header, bck string, props *cmn.Bprops) (int, error) {
+	var (
+		origin = rhdr.Get(cos.HdrOrigin)
+		method = rhdr.Get(cos.HdrACRequestMethod)
+	)
+	if origin == "" || method == "" {
+		return http.StatusBadRequest, errCORSPreflight
+	}
+	var reqHeaders []string
+	if s := rhdr.Get(cos.HdrACRequestHeaders); s != "" {
+		for _, h := range strings.Split(s, ",") {
+			if h = strings.TrimSpace(h); h != "" {
+				reqHeaders = append(reqHeaders, h)
+			}
+		}
+	}
+	rule := props.CORS.Match(origin, method, reqHeaders)
+	if rule == nil {
+		return http.StatusForbidden, fmt.Errorf("CORS is not allowed for bucket %s (origin %q, method %s)", bck, origin, method)
+	}
+
+	hdr.Add(cos.HdrVary, cos.HdrOrigin)
+	hdr.Add(cos.HdrVary, cos.HdrACRequestMethod)
+	hdr.Add(cos.HdrVary, cos.HdrACRequestHeaders)
+	_corsOrigin(hdr, rule, origin)
+	hdr.Set(cos.HdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(reqHeaders) > 0 {
+		hdr.Set(cos.HdrACAllowHeaders, strings.Join(reqHeaders, ", "))
+	}
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		hdr.Set(cos.HdrACMaxAge, strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	return 0, nil
+}
+
+func _corsOrigin(hdr http.Header, rule *cmn.CORSRule, origin string) {
+	if rule.AnyOrigin() {
+		hdr.Set(cos.HdrACAllowOrigin, "*")
+	} else {
+		hdr.Set(cos.HdrACAllowOrigin, origin)
+	}
+}
+
+// OPTIONS /v1/objects/<bucket-name>[/<object-name>]
+func (p *proxy) httpobjoptions(w http.ResponseWriter, r *http.Request) {
+	apireq := apiReqAlloc(1, apc.URLPathObjects.L, false)
+	defer apiReqFree(apireq)
+	if err := p.parseReq(w, r, apireq); err != nil {
+		return
+	}
+	bck := apireq.bck
+	if err := bck.Init(p.owner.bmd); err != nil {
+		p.writeErr(w, r, err, http.StatusNotFound, Silent)
+		return
+	}
+	if ecode, err := corsPreflight(w.Header(), r.Header, bck.Cname(""), bck.Props); err != nil {
+		p.writeErr(w, r, err, ecode, Silent)
+	}
+}
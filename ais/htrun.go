@@ -2368,7 +2368,9 @@ func (h *htrun) newAmsg(amsg *apc.ActMsg, bmd *bucketMD, uuid ...string) *actMsg
 // apc.ActMsg c-tor and reader
 func (*htrun) readActionMsg(w http.ResponseWriter, r *http.Request) (msg *apc.ActMsg, err error) {
 	msg = &apc.ActMsg{}
-	err = cmn.ReadJSON(w, r, msg)
+	if err = cmn.ReadJSON(w, r, msg); err == nil {
+		auditAction(w, msg)
+	}
 	return
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
@@ -57,6 +58,7 @@ type (
 	proxy struct {
 		htrun
 		authn      *authManager
+		audit      *audit.Log
 		metasyncer *metasyncer
 		ic         ic
 		rproxy     reverseProxy
@@ -197,6 +199,7 @@ func (p *proxy) Run() error {
 	p.bootstrap()
 
 	p.authn = newAuthManager(config)
+	p.audit = audit.NewLog(config.LogDir)
 
 	p.rproxy.init()
 
@@ -210,16 +213,16 @@ func (p *proxy) Run() error {
 		{r: apc.Reverse, h: p.reverseHandler, net: accessNetPublic},
 
 		// pubnet handlers: cluster must be started
-		{r: apc.Buckets, h: p.bucketHandler, net: accessNetPublic},
-		{r: apc.Objects, h: p.objectHandler, net: accessNetPublic},
-		{r: apc.Download, h: p.dloadHandler, net: accessNetPublic},
-		{r: apc.ETL, h: p.etlHandler, net: accessNetPublic},
-		{r: apc.Sort, h: p.dsortHandler, net: accessNetPublic},
+		{r: apc.Buckets, h: p.audited(p.bucketHandler), net: accessNetPublic},
+		{r: apc.Objects, h: p.audited(p.objectHandler), net: accessNetPublic},
+		{r: apc.Download, h: p.audited(p.dloadHandler), net: accessNetPublic},
+		{r: apc.ETL, h: p.audited(p.etlHandler), net: accessNetPublic},
+		{r: apc.Sort, h: p.audited(p.dsortHandler), net: accessNetPublic},
 
 		{r: apc.IC, h: p.ic.handler, net: accessNetIntraControl},
-		{r: apc.Daemon, h: p.daemonHandler, net: accessNetPublicControl},
-		{r: apc.Cluster, h: p.clusterHandler, net: accessNetPublicControl},
-		{r: apc.Tokens, h: p.tokenHandler, net: accessNetPublic},
+		{r: apc.Daemon, h: p.audited(p.daemonHandler), net: accessNetPublicControl},
+		{r: apc.Cluster, h: p.audited(p.clusterHandler), net: accessNetPublicControl},
+		{r: apc.Tokens, h: p.audited(p.tokenHandler), net: accessNetPublic},
 
 		{r: apc.Metasync, h: p.metasyncHandler, net: accessNetIntraControl},
 		{r: apc.Health, h: p.healthHandler, net: accessNetPublicControl},
@@ -229,15 +232,15 @@ func (p *proxy) Run() error {
 		{r: apc.EC, h: p.ecHandler, net: accessNetIntraControl},
 
 		// S3 compatibility
-		{r: "/" + apc.S3, h: p.s3Handler, net: accessNetPublic},
+		{r: "/" + apc.S3, h: p.audited(p.s3Handler), net: accessNetPublic},
 
 		// "easy URL"
-		{r: "/" + apc.GSScheme, h: p.easyURLHandler, net: accessNetPublic},
-		{r: "/" + apc.AZScheme, h: p.easyURLHandler, net: accessNetPublic},
-		{r: "/" + apc.AISScheme, h: p.easyURLHandler, net: accessNetPublic},
+		{r: "/" + apc.GSScheme, h: p.audited(p.easyURLHandler), net: accessNetPublic},
+		{r: "/" + apc.AZScheme, h: p.audited(p.easyURLHandler), net: accessNetPublic},
+		{r: "/" + apc.AISScheme, h: p.audited(p.easyURLHandler), net: accessNetPublic},
 
 		// S3 compatibility, depending on feature flag
-		{r: "/", h: p.rootHandler, net: accessNetPublic},
+		{r: "/", h: p.audited(p.rootHandler), net: accessNetPublic},
 	}
 	p.regNetHandlers(networkHandlers)
 
@@ -427,8 +430,9 @@ func (p *proxy) _parseReqTry(w http.ResponseWriter, r *http.Request, bckArgs *bc
 		return
 	}
 	bckArgs.bck, bckArgs.query = apireq.bck, apireq.query
-	bck, err = bckArgs.initAndTry()
 	objName = apireq.items[1]
+	bckArgs.objName = objName
+	bck, err = bckArgs.initAndTry()
 
 	apiReqFree(apireq)
 	freeBctx(bckArgs) // caller does alloc
@@ -487,9 +491,11 @@ func (p *proxy) objectHandler(w http.ResponseWriter, r *http.Request) {
 		p.httpobjhead(w, r)
 	case http.MethodPatch:
 		p.httpobjpatch(w, r)
+	case http.MethodOptions:
+		p.httpobjoptions(w, r) // CORS preflight
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
-			http.MethodPost, http.MethodPut)
+			http.MethodOptions, http.MethodPatch, http.MethodPost, http.MethodPut)
 	}
 }
 
@@ -689,6 +695,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	}
 	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceObjLIST, bck: bck, dpq: dpq}
 	bckArgs.createAIS = false
+	bckArgs.scope = newLsScope(lsmsg.Prefix)
 
 	if lsmsg.IsFlagSet(apc.LsBckPresent) {
 		bckArgs.dontHeadRemote = true
@@ -703,7 +710,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	if errN != nil {
 		return
 	}
-	p.listObjects(w, r, bck, msg /*amsg*/, &lsmsg)
+	p.listObjects(w, r, bck, msg /*amsg*/, &lsmsg, bckArgs.scope)
 }
 
 // GET /v1/objects/bucket-name/object-name
@@ -724,6 +731,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		bckArgs.bck = apireq.bck
 		bckArgs.dpq = apireq.dpq
 		bckArgs.perms = apc.AceGET
+		bckArgs.objName = apireq.items[1]
 		bckArgs.createAIS = false
 	}
 	if len(origURLBck) > 0 {
@@ -765,6 +773,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 	}
 
 	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData, netPub)
+	corsActual(w.Header(), r.Header, r.Method, bck.Props)
 	http.Redirect(w, r, redirectURL, http.StatusMovedPermanently)
 
 	// 5. stats
@@ -809,6 +818,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 		bckArgs.w = w
 		bckArgs.r = r
 		bckArgs.perms = perms
+		bckArgs.objName = apireq.items[1]
 		bckArgs.createAIS = false
 	}
 	bckArgs.bck, bckArgs.dpq = apireq.bck, apireq.dpq
@@ -864,6 +874,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	}
 
 	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData, netPub)
+	corsActual(w.Header(), r.Header, r.Method, bck.Props)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 
 	// 5. stats
@@ -931,6 +942,9 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 	bck := apireq.bck
 	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: perms, bck: bck, dpq: apireq.dpq, query: apireq.query}
 	bckArgs.createAIS = false
+	if perms == apc.AceObjDELETE {
+		bckArgs.scope = newLrScope(msg)
+	}
 	if msg.Action == apc.ActEvictRemoteBck {
 		var ecode int
 		bckArgs.dontHeadRemote = true // unconditionally
@@ -1207,6 +1221,9 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 	}
 	bckArgs := bctx{p: p, w: w, r: r, bck: bck, msg: msg, query: query}
 	bckArgs.createAIS = false
+	if msg.Action == apc.ActArchive {
+		bckArgs.scope = newLrScope(msg)
+	}
 	if bck, err = bckArgs.initAndTry(); err != nil {
 		return
 	}
@@ -1223,8 +1240,12 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 		bckTo := meta.CloneBck(&archMsg.ToBck)
 		if bckTo.IsEmpty() {
 			bckTo = bckFrom
+			if err := p.checkAccessObj(w, r, bckTo, archMsg.ArchName, apc.AcePUT); err != nil {
+				return
+			}
 		} else {
 			bckToArgs := bctx{p: p, w: w, r: r, bck: bckTo, msg: msg, perms: apc.AcePUT, query: query}
+			bckToArgs.objName = archMsg.ArchName
 			bckToArgs.createAIS = false
 			if bckTo, err = bckToArgs.initAndTry(); err != nil {
 				return
@@ -1302,6 +1323,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 
 	bckArgs := bctx{p: p, w: w, r: r, bck: bck, perms: apc.AccessNone /* access checked below */, msg: msg, query: query}
 	bckArgs.createAIS = false
+	switch msg.Action {
+	case apc.ActCopyObjects, apc.ActETLObjects, apc.ActPrefetchObjects:
+		bckArgs.scope = newLrScope(msg)
+	}
 	if bck, err = bckArgs.initAndTry(); err != nil {
 		return
 	}
@@ -1659,7 +1684,8 @@ func crerrStatus(err error) (ecode int) {
 }
 
 // one page => msgpack rsp
-func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg) {
+func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg,
+	scope *aceScope) {
 	// LsVerChanged a.k.a. '--check-versions' limitations
 	if lsmsg.IsFlagSet(apc.LsDiff) {
 		if err := _checkVerChanged(bck, lsmsg); err != nil {
@@ -1669,6 +1695,30 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 		}
 	}
 
+	// server-side filter
+	if lsmsg.Filter != nil {
+		if err := lsmsg.Filter.Validate(); err != nil {
+			p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+
+	// index-served listing (in-cluster objects only)
+	if lsmsg.IsFlagSet(apc.LsIndexed) {
+		if !bck.Props.Features.IsSet(feat.ListIndex) {
+			p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+			p.writeErrf(w, r, "cannot list %s from index: feature flag %q is not set", bck.Cname(""), "Enable-List-Index")
+			return
+		}
+		if lsmsg.IsFlagSet(apc.LsValidateCksum) {
+			p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
+			p.writeErrf(w, r, "cannot validate %s checksums when listing from index", bck.Cname(""))
+			return
+		}
+		lsmsg.SetFlag(apc.LsCached)
+	}
+
 	// default props & flags => user-provided message
 	switch lsmsg.Props {
 	case "":
@@ -1695,6 +1745,7 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 		p.writeErr(w, r, err)
 		return
 	}
+	lst.Entries = scope.filter(lst.Entries) // prefix-scoped ACLs
 
 	vlabs := map[string]string{stats.VlabBucket: bck.Cname("")}
 	p.statsT.IncWith(stats.ListCount, vlabs)
@@ -1853,7 +1904,8 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
-	if msg.Action == apc.ActRenameObject {
+	switch msg.Action {
+	case apc.ActRenameObject, apc.ActMptCreate, apc.ActMptListParts, apc.ActMptComplete, apc.ActMptAbort:
 		apireq.after = 2
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
@@ -1870,7 +1922,11 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 
 	switch msg.Action {
 	case apc.ActRenameObject:
-		if err := p.checkAccess(w, r, bck, apc.AceObjMOVE); err != nil {
+		if err := p.checkAccessObj(w, r, bck, apireq.items[1], apc.AceObjMOVE); err != nil {
+			p.statsT.IncBck(stats.ErrRenameCount, bck.Bucket())
+			return
+		}
+		if err := p.checkAccessObj(w, r, bck, msg.Name /*destination*/, apc.AceObjMOVE); err != nil {
 			p.statsT.IncBck(stats.ErrRenameCount, bck.Bucket())
 			return
 		}
@@ -1918,7 +1974,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 	case apc.ActBlobDl:
 		// TODO: add stats.GetBlobCount and *ErrCount
-		if err := p.checkAccess(w, r, bck, apc.AccessRW); err != nil {
+		if err := p.checkAccessObj(w, r, bck, msg.Name, apc.AccessRW); err != nil {
 			return
 		}
 		if err := cmn.ValidateRemoteBck(apc.ActBlobDl, bck.Bucket()); err != nil {
@@ -1927,6 +1983,24 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		objName := msg.Name
 		p.redirectAction(w, r, bck, objName, msg)
+	case apc.ActMptCreate, apc.ActMptListParts, apc.ActMptComplete, apc.ActMptAbort:
+		perms := apc.AcePUT
+		if msg.Action == apc.ActMptListParts {
+			perms = apc.AceObjHEAD
+		}
+		objName := apireq.items[1]
+		if err := p.checkAccessObj(w, r, bck, objName, perms); err != nil {
+			return
+		}
+		if err := cmn.ValidOname(objName); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if bck.IsRemote() {
+			p.writeErr(w, r, cmn.NewErrUnsupp(msg.Action, bck.Cname("")+" (supported: ais:// buckets with no remote backend)"))
+			return
+		}
+		p.redirectAction(w, r, bck, objName, msg)
 	default:
 		p.writeErrAct(w, r, msg.Action)
 	}
@@ -2142,6 +2216,22 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if nprops.Replication.Enabled {
+		// ditto: replication destination (remote AIS bucket)
+		dstBck, err := nprops.Replication.DstBck()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		dstBck.Ns.UUID = p.a2u(dstBck.Ns.UUID)
+		dst := meta.CloneBck(&dstBck)
+		args := bctx{p: p, w: w, r: r, bck: dst, msg: msg, dpq: apireq.dpq, query: apireq.query}
+		args.createAIS = false
+		if _, err = args.initAndTry(); err != nil {
+			return
+		}
+		nprops.Replication.Dst = dst.Cname("") // (normalized: alias => UUID)
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -2181,6 +2271,7 @@ func (p *proxy) httpobjhead(w http.ResponseWriter, r *http.Request, origURLBck .
 		nlog.Infoln(r.Method, bck.Cname(objName), "=>", si.StringEx())
 	}
 	redirectURL := p.redirectURL(r, si, time.Now() /*started*/, cmn.NetIntraControl)
+	corsActual(w.Header(), r.Header, r.Method, bck.Props)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 }
 
@@ -2673,6 +2764,9 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	case apc.WhatSysInfo:
 		p.writeJSON(w, r, apc.GetMemCPU(), what)
 
+	case apc.WhatAudit:
+		p.daeAudit(w, r, what, query)
+
 	case apc.WhatSmap:
 		const retries = 16
 		var (
@@ -3401,6 +3495,9 @@ func (p *proxy) Stop(err error) {
 		nlog.Warningf("%s: %v", s, err)
 	}
 	xreg.AbortAll(errors.New("p-stop"))
+	if p.audit != nil {
+		p.audit.Close()
+	}
 
 	p.htrun.stop(&sync.WaitGroup{}, !isPrimary && smap.isValid() && !isEnu /*rmFromSmap*/)
 }
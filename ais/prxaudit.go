@@ -0,0 +1,238 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Audit trail (see cmn/audit and cmn.AuditConf):
+// - public API handlers are wrapped to capture the result (HTTP status)
+// - the handling code fills in the details it has: action, bucket, object or prefix,
+//   and the ID of the job (xaction) that the call has started, if any
+// - upon completion, the call is recorded subject to the configured filters
+// - intra-cluster calls are never audited
+
+type auditW struct {
+	http.ResponseWriter
+	rec audit.Record
+}
+
+// interface guard
+var _ http.ResponseWriter = (*auditW)(nil)
+
+func (p *proxy) audited(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		config := cmn.GCO.Get()
+		if !config.Audit.Enabled || p.checkIntraCall(r.Header, false /*from primary*/) == nil {
+			h(w, r)
+			return
+		}
+		aw := &auditW{ResponseWriter: w}
+		aw.rec.Method, aw.rec.Path = r.Method, r.URL.Path
+		h(aw, r)
+
+		rec := &aw.rec
+		if rec.Code == 0 {
+			rec.Code = http.StatusOK
+		}
+		if !config.Audit.Selected(rec.Method, rec.Action, rec.Code) {
+			return
+		}
+		rec.Time = time.Now().UnixNano()
+		rec.Node = p.SID()
+		rec.ClientIP = audit.ClientIP(r)
+		if cmn.Rom.AuthEnabled() {
+			rec.User = p.auditUser(r.Header)
+		}
+		if rec.Bucket == "" {
+			p.auditURL(rec, r)
+		}
+		p.audit.Add(rec, int64(config.Audit.MaxSize))
+	}
+}
+
+// (silent - compare w/ p.validateToken)
+func (p *proxy) auditUser(hdr http.Header) string {
+	token, err := tok.ExtractToken(hdr)
+	if err != nil {
+		return ""
+	}
+	tk, err := p.authn.validateToken(token)
+	if err != nil {
+		return ""
+	}
+	return tk.UserID
+}
+
+// when not provided by the handler: bucket and object from the URL path
+// (by the time we get here "easy URLs" are already converted to native API)
+func (p *proxy) auditURL(rec *audit.Record, r *http.Request) {
+	var (
+		items []string
+		bck   *cmn.Bck
+		path  = r.URL.Path
+	)
+	switch {
+	case strings.HasPrefix(path, apc.URLPathBuckets.S+"/"):
+		items = strings.SplitN(path[len(apc.URLPathBuckets.S)+1:], "/", 2)[:1]
+	case strings.HasPrefix(path, apc.URLPathObjects.S+"/"):
+		items = strings.SplitN(path[len(apc.URLPathObjects.S)+1:], "/", 2)
+	case strings.HasPrefix(path, "/"+apc.Version+"/") || path == "/":
+		return
+	default:
+		// S3 API: bucket name must unambiguously resolve via BMD
+		path = strings.TrimPrefix(strings.TrimPrefix(path, apc.URLPathS3.S), "/")
+		items = strings.SplitN(path, "/", 2)
+		if items[0] == "" {
+			return
+		}
+		if b, _, err := meta.InitByNameOnly(items[0], p.owner.bmd); err == nil {
+			bck = b.Bucket()
+		} else {
+			bck = &cmn.Bck{Name: items[0]}
+		}
+	}
+	if items[0] == "" {
+		return
+	}
+	if bck == nil {
+		query := r.URL.Query()
+		bck = &cmn.Bck{
+			Name:     items[0],
+			Provider: apc.NormalizeProvider(query.Get(apc.QparamProvider)),
+			Ns:       cmn.ParseNsUname(query.Get(apc.QparamNamespace)),
+		}
+	}
+	if bck.Provider == "" {
+		rec.Bucket = bck.Name
+	} else {
+		rec.Bucket = bck.Cname("")
+	}
+	if len(items) > 1 && rec.Object == "" {
+		rec.Object = items[1]
+	}
+}
+
+////////////
+// auditW //
+////////////
+
+func (aw *auditW) WriteHeader(code int) {
+	if aw.rec.Code == 0 {
+		aw.rec.Code = code
+	}
+	aw.ResponseWriter.WriteHeader(code)
+}
+
+func (aw *auditW) Write(b []byte) (int, error) {
+	if aw.rec.Code == 0 {
+		aw.rec.Code = http.StatusOK
+	}
+	return aw.ResponseWriter.Write(b)
+}
+
+// (http.ResponseController)
+func (aw *auditW) Unwrap() http.ResponseWriter { return aw.ResponseWriter }
+
+func auditAction(w http.ResponseWriter, msg *apc.ActMsg) {
+	if aw, ok := w.(*auditW); ok {
+		aw.rec.Action = msg.Action
+	}
+}
+
+// (see writeXid)
+func auditXid(w http.ResponseWriter, xid string) {
+	if aw, ok := w.(*auditW); ok {
+		aw.rec.Xid = xid
+	}
+}
+
+func auditScope(w http.ResponseWriter, bck *meta.Bck, objName string, scope *aceScope) {
+	aw, ok := w.(*auditW)
+	if !ok {
+		return
+	}
+	aw.rec.Bucket = bck.Cname("")
+	aw.rec.Object = objName
+	if scope != nil {
+		if objName == "" {
+			aw.rec.Object = scope.objName
+		}
+		aw.rec.Prefix = scope.prefix
+	}
+}
+
+//
+// query: GET /v1/cluster?what=audit (all gateways) and /v1/daemon?what=audit (this one)
+//
+
+// merges this gateway's records with those of all other gateways, most recent first
+func (p *proxy) qcluAudit(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	q := &audit.Query{}
+	if err := q.FillFromQuery(query); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	recs, err := p.audit.Query(q)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.timeout = cmn.Rom.MaxKeepalive()
+	args.to = core.Proxies
+	args.cresv = cresjGeneric[[]*audit.Record]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(p.String(), "failed to query audit log of", res.si.StringEx(), "err:", res.err)
+			continue
+		}
+		recs = append(recs, *res.v.(*[]*audit.Record)...)
+	}
+	freeBcastRes(results)
+
+	sort.Slice(recs, func(i, j int) bool { return recs[i].Time > recs[j].Time })
+	if n := q.NumRecords(); len(recs) > n {
+		recs = recs[:n]
+	}
+	p.writeJSON(w, r, recs, what)
+}
+
+func (p *proxy) daeAudit(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	q := &audit.Query{}
+	if err := q.FillFromQuery(query); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	recs, err := p.audit.Query(q)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	p.writeJSON(w, r, recs, what)
+}
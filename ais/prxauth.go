@@ -18,7 +18,6 @@ import (
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
-	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
@@ -30,12 +29,18 @@ type (
 	authManager struct {
 		// cache of decrypted tokens
 		tkList tkList
+		// S3 access key ID => regular token issued on its behalf (see p.sigv4)
+		accessKeys map[string]string
 		// list of invalid tokens(revoked or of deleted users)
 		// Authn sends these tokens to primary for broadcasting
 		revokedTokens map[string]bool
-		version       int64
-		// signing key secret
+		// IDs of revoked API keys (see updateRevokedList)
+		revokedKeys map[string]bool
+		version     int64
+		// signing key secret (HS256)
 		secret string
+		// cached public keys (RS256, ES256)
+		jwks jwksCache
 		// lock
 		sync.Mutex
 	}
@@ -48,17 +53,18 @@ type (
 func newAuthManager(config *cmn.Config) *authManager {
 	return &authManager{
 		tkList:        make(tkList),
+		accessKeys:    make(map[string]string),
 		revokedTokens: make(map[string]bool), // TODO: preallocate
+		revokedKeys:   make(map[string]bool),
 		version:       1,
 		secret:        cos.Right(config.Auth.Secret, os.Getenv(env.AisAuthSecretKey)), // environment override
 	}
 }
 
 // Add tokens to the list of invalid ones and clean up the list from expired tokens.
+// NOTE: parsing (and validating) tokens outside the lock - may need to fetch public keys (see jwksCache)
 func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *tokenList) {
 	a.Lock()
-	defer a.Unlock()
-
 	switch {
 	case newRevoked.Version == 0: // Manually revoked tokens
 		a.version++
@@ -66,6 +72,7 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 		a.version = newRevoked.Version
 	default:
 		nlog.Errorf("Current token list v%d is greater than received v%d", a.version, newRevoked.Version)
+		a.Unlock()
 		return
 	}
 
@@ -73,28 +80,60 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 	for _, token := range newRevoked.Tokens {
 		a.revokedTokens[token] = true
 		delete(a.tkList, token)
+		if bearer, ok := a.accessKeys[token]; ok {
+			delete(a.tkList, bearer)
+			delete(a.accessKeys, token)
+		}
 	}
-
-	allRevoked = &tokenList{
-		Tokens:  make([]string, 0, len(a.revokedTokens)),
-		Version: a.version,
+	var (
+		version = a.version
+		tokens  = make([]string, 0, len(a.revokedTokens))
+	)
+	for token := range a.revokedTokens {
+		tokens = append(tokens, token)
 	}
+	a.Unlock()
 
 	// Clean up expired tokens from the revoked list.
-	now := time.Now()
+	// NOTE: AuthN revokes API keys by key ID - with a (permission-less) token that carries the ID
+	// and expires along with the key
+	var (
+		expired                  []string
+		revokedKeys, expiredKeys []string
+		now                      = time.Now()
+	)
+	for _, token := range tokens {
+		tk, err := a.parse(token)
+		switch {
+		case err != nil:
+			expired = append(expired, token)
+		case tk.Expires.Before(now):
+			expired = append(expired, token)
+			if tk.KeyID != "" {
+				expiredKeys = append(expiredKeys, tk.KeyID)
+			}
+		case tk.KeyID != "":
+			revokedKeys = append(revokedKeys, tk.KeyID)
+		}
+	}
 
-	for token := range a.revokedTokens {
-		tk, err := tok.DecryptToken(token, a.secret)
-		debug.AssertNoErr(err)
-		if tk.Expires.Before(now) {
-			delete(a.revokedTokens, token)
-		} else {
+	a.Lock()
+	for _, token := range expired {
+		delete(a.revokedTokens, token)
+	}
+	for _, kid := range expiredKeys {
+		delete(a.revokedKeys, kid)
+	}
+	for _, kid := range revokedKeys {
+		a.revokedKeys[kid] = true
+	}
+	if l := len(a.revokedTokens); l > 0 {
+		allRevoked = &tokenList{Tokens: make([]string, 0, l), Version: version}
+		for token := range a.revokedTokens {
 			allRevoked.Tokens = append(allRevoked.Tokens, token)
 		}
 	}
-	if len(allRevoked.Tokens) == 0 {
-		allRevoked = nil
-	}
+	a.Unlock()
 	return
 }
 
@@ -119,36 +158,93 @@ func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 //   - must have all mandatory fields: userID, creds, issued, expires
 //
 // Returns decrypted token information if it is valid
-func (a *authManager) validateToken(token string) (tk *tok.Token, err error) {
+func (a *authManager) validateToken(token string) (*tok.Token, error) {
 	a.Lock()
 	if _, ok := a.revokedTokens[token]; ok {
-		tk, err = nil, fmt.Errorf("%v: %s", tok.ErrTokenRevoked, tk)
-	} else {
-		tk, err = a.validateAddRm(token, time.Now())
+		a.Unlock()
+		return nil, tok.ErrTokenRevoked
 	}
+	tk := a.tkList[token]
 	a.Unlock()
-	return
-}
 
-// Decrypts and validates token. Adds it to authManager.token if not found. Removes if expired.
-// Must be called under lock.
-func (a *authManager) validateAddRm(token string, now time.Time) (*tok.Token, error) {
-	tk, ok := a.tkList[token]
-	if !ok || tk == nil {
+	if tk == nil {
+		// not holding the lock - may need to fetch public keys (see jwksCache)
 		var err error
-		if tk, err = tok.DecryptToken(token, a.secret); err != nil {
+		if tk, err = a.parse(token); err != nil {
 			nlog.Errorln(err)
 			return nil, tok.ErrInvalidToken
 		}
-		a.tkList[token] = tk
+		if tk.IsAccessKey {
+			return nil, tok.ErrAccessKey
+		}
 	}
-	if tk.Expires.Before(now) {
+
+	a.Lock()
+	tk, err := a.validateAddRm(token, tk, time.Now())
+	a.Unlock()
+	return tk, err
+}
+
+// Adds validated token to authManager.tkList (if not there yet). Removes if expired or revoked.
+// Must be called under lock.
+func (a *authManager) validateAddRm(token string, tk *tok.Token, now time.Time) (*tok.Token, error) {
+	switch {
+	case a.revokedTokens[token], tk.KeyID != "" && a.revokedKeys[tk.KeyID]: // (including revoked while parsing)
+		delete(a.tkList, token)
+		return nil, fmt.Errorf("%v: %s", tok.ErrTokenRevoked, tk)
+	case tk.Expires.Before(now):
 		delete(a.tkList, token)
 		return nil, fmt.Errorf("%v: %s", tok.ErrTokenExpired, tk)
 	}
+	a.tkList[token] = tk
 	return tk, nil
 }
 
+// either HS256 (shared secret) or RS256/ES256 (public keys)
+func (a *authManager) parse(token string) (*tok.Token, error) {
+	if cmn.GCO.Get().Auth.JWKS == "" {
+		return tok.ParseToken(token, a.secret, nil)
+	}
+	return tok.ParseToken(token, a.secret, a.jwks.pubKey)
+}
+
+// Validates S3 access key ID and returns the corresponding secret access key
+// and a regular (bearer) token that carries the same permissions.
+// (S3 access keys require the shared secret - see tok.AccessKeySecret)
+func (a *authManager) validateAccessKey(accessKeyID string) (secretKey, bearer string, err error) {
+	if a.secret == "" {
+		return "", "", fmt.Errorf("%v: S3 access keys require auth secret", tok.ErrInvalidToken)
+	}
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.revokedTokens[accessKeyID]; ok {
+		return "", "", tok.ErrTokenRevoked
+	}
+	now := time.Now()
+	if bearer = a.accessKeys[accessKeyID]; bearer != "" {
+		if tk, ok := a.tkList[bearer]; ok && !tk.Expires.Before(now) {
+			return tok.AccessKeySecret(accessKeyID, a.secret), bearer, nil
+		}
+		delete(a.tkList, bearer)
+		delete(a.accessKeys, accessKeyID)
+	}
+
+	tk, err := tok.DecryptToken(accessKeyID, a.secret)
+	if err != nil || !tk.IsAccessKey {
+		return "", "", tok.ErrInvalidToken
+	}
+	if tk.Expires.Before(now) {
+		return "", "", fmt.Errorf("%v: %s", tok.ErrTokenExpired, tk)
+	}
+	tk.IsAccessKey = false
+	if bearer, err = tk.JWT(tok.SecretKey(a.secret)); err != nil {
+		return "", "", err
+	}
+	a.tkList[bearer] = tk
+	a.accessKeys[accessKeyID] = bearer
+	return tok.AccessKeySecret(accessKeyID, a.secret), bearer, nil
+}
+
 ///////////////
 // tokenList //
 ///////////////
@@ -190,6 +286,10 @@ func (p *proxy) validateSecret(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if p.authn.secret == "" {
+		// verifying with public keys only (config auth.jwks) - nothing to compare
+		return
+	}
 	cksumVal := cos.ChecksumB2S(cos.UnsafeB(p.authn.secret), cos.ChecksumSHA256)
 	if cksumVal != cluConf.Secret {
 		p.writeErrf(w, r, "%s: invalid secret sha256(%q)", p, cos.SHead(cluConf.Secret))
@@ -245,10 +345,17 @@ func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	return
 }
 
+func (p *proxy) checkAccessObj(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, ace apc.AccessAttrs) (err error) {
+	if err = p.accessObj(r.Header, bck, objName, ace); err != nil {
+		p.writeErr(w, r, err, aceErrToCode(err))
+	}
+	return
+}
+
 func aceErrToCode(err error) (status int) {
 	switch {
 	case err == nil:
-	case errors.Is(err, tok.ErrNoToken) || errors.Is(err, tok.ErrInvalidToken):
+	case errors.Is(err, tok.ErrNoToken) || errors.Is(err, tok.ErrInvalidToken) || errors.Is(err, tok.ErrAccessKey):
 		status = http.StatusUnauthorized
 	default:
 		status = http.StatusForbidden
@@ -256,7 +363,20 @@ func aceErrToCode(err error) (status int) {
 	return status
 }
 
-func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) error {
+	return p.accessScope(hdr, bck, ace, nil)
+}
+
+// (single object)
+func (p *proxy) accessObj(hdr http.Header, bck *meta.Bck, objName string, ace apc.AccessAttrs) error {
+	if !cmn.Rom.AuthEnabled() {
+		return p.accessScope(hdr, bck, ace, nil)
+	}
+	return p.accessScope(hdr, bck, ace, &aceScope{objName: objName})
+}
+
+// same as above with object-level scope, if any (prefix-scoped bucket ACLs)
+func (p *proxy) accessScope(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs, scope *aceScope) (err error) {
 	var (
 		tk     *tok.Token
 		bucket *cmn.Bck
@@ -277,7 +397,12 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 		if bck != nil {
 			bucket = bck.Bucket()
 		}
-		if err := tk.CheckPermissions(uid, bucket, ace); err != nil {
+		if bucket != nil && scope != nil {
+			err = scope.check(tk, uid, bucket, ace)
+		} else {
+			err = tk.CheckPermissions(uid, bucket, ace)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -299,3 +424,79 @@ func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err
 	}
 	return bck.Allow(ace)
 }
+
+//////////////
+// aceScope //
+//////////////
+
+// object-level scope of a data access request (see prefix-scoped authn.BckACL):
+// single object, list of objects, or all objects under a given prefix (empty prefix: entire bucket)
+type aceScope struct {
+	lsFilter tok.LsFilter // list-objects (result): accessible subset, or nil when all objects under prefix are accessible
+	objName  string
+	prefix   string
+	names    []string
+	ls       bool // list-objects: partial access is permitted (see lsFilter)
+}
+
+// list-objects
+func newLsScope(prefix string) *aceScope {
+	if !cmn.Rom.AuthEnabled() {
+		return nil
+	}
+	return &aceScope{prefix: prefix, ls: true}
+}
+
+// multi-object list, range, or prefix (see apc.ListRange)
+func newLrScope(msg *apc.ActMsg) *aceScope {
+	if !cmn.Rom.AuthEnabled() {
+		return nil
+	}
+	lrmsg := &apc.ListRange{}
+	if err := cos.MorphMarshal(msg.Value, lrmsg); err != nil {
+		return nil // (the caller will fail to parse the message as well; meanwhile, bucket-level check applies)
+	}
+	if lrmsg.IsList() {
+		return &aceScope{names: lrmsg.ObjNames}
+	}
+	// compare with lrit._inipr
+	pt, err := cos.NewParsedTemplate(lrmsg.Template)
+	if err != nil && err != cos.ErrEmptyTemplate {
+		return nil
+	}
+	return &aceScope{prefix: pt.Prefix}
+}
+
+func (scope *aceScope) check(tk *tok.Token, uid string, bck *cmn.Bck, ace apc.AccessAttrs) (err error) {
+	switch {
+	case scope.objName != "":
+		err = tk.CheckObjPermissions(uid, bck, scope.objName, ace)
+	case len(scope.names) > 0:
+		for _, name := range scope.names {
+			if err = tk.CheckObjPermissions(uid, bck, name, ace); err != nil {
+				break
+			}
+		}
+	case scope.ls:
+		scope.lsFilter, err = tk.ListFilter(uid, bck, scope.prefix, ace)
+	default:
+		err = tk.CheckPrefixPermissions(uid, bck, scope.prefix, ace)
+	}
+	return err
+}
+
+// filter list-objects results in place
+func (scope *aceScope) filter(entries cmn.LsoEntries) cmn.LsoEntries {
+	if scope == nil || scope.lsFilter == nil {
+		return entries
+	}
+	var j int
+	for _, en := range entries {
+		if scope.lsFilter(en.Name, en.IsAnyFlagSet(apc.EntryIsDir)) {
+			entries[j] = en
+			j++
+		}
+	}
+	clear(entries[j:])
+	return entries[:j]
+}
@@ -0,0 +1,173 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const testScopeCluID = "test-clu-id"
+
+func setAuthEnabled(enabled bool) {
+	config := cmn.GCO.BeginUpdate()
+	config.Auth.Enabled = enabled
+	cmn.GCO.CommitUpdate(config)
+	cmn.Rom.Set(&config.ClusterConfig)
+}
+
+// RW under team-a/ (except team-a/private/), RO elsewhere (cluster ACL)
+func newScopeToken() *tok.Token {
+	bck := cmn.Bck{Name: "datasets", Provider: apc.AIS, Ns: cmn.Ns{UUID: testScopeCluID}}
+	return &tok.Token{
+		UserID:      "user-a",
+		ClusterACLs: []*authn.CluACL{{ID: testScopeCluID, Access: apc.AccessRO}},
+		BucketACLs: []*authn.BckACL{
+			{Bck: bck, Prefix: "team-a/", Access: apc.AccessRW},
+			{Bck: bck, Prefix: "team-a/private/", Access: apc.AccessNone},
+		},
+	}
+}
+
+func TestNewLrScope(t *testing.T) {
+	setAuthEnabled(true)
+	defer setAuthEnabled(false)
+
+	tests := []struct {
+		value  any
+		prefix string
+		names  []string
+		isNil  bool
+	}{
+		{value: &apc.ListRange{ObjNames: []string{"a", "b"}}, names: []string{"a", "b"}},
+		{value: &apc.ListRange{Template: "team-a/shard-{0..9}.tar"}, prefix: "team-a/shard-"},
+		{value: &apc.ListRange{Template: "team-a/"}, prefix: "team-a/"},
+		{value: &apc.ListRange{}, prefix: ""}, // entire bucket
+		{value: "not-a-list-range", isNil: true},
+	}
+	for i, test := range tests {
+		scope := newLrScope(&apc.ActMsg{Action: apc.ActDeleteObjects, Value: test.value})
+		if test.isNil {
+			tassert.Errorf(t, scope == nil, "%d: expected nil scope, got %+v", i, scope)
+			continue
+		}
+		tassert.Fatalf(t, scope != nil, "%d: expected scope", i)
+		tassert.Errorf(t, scope.prefix == test.prefix, "%d: expected prefix %q, got %q", i, test.prefix, scope.prefix)
+		tassert.Errorf(t, len(scope.names) == len(test.names), "%d: expected names %v, got %v", i, test.names, scope.names)
+	}
+
+	setAuthEnabled(false)
+	scope := newLrScope(&apc.ActMsg{Action: apc.ActDeleteObjects, Value: &apc.ListRange{Template: "team-a/"}})
+	tassert.Errorf(t, scope == nil, "expected nil scope when auth is disabled")
+}
+
+func TestAceScopeCheck(t *testing.T) {
+	var (
+		tk  = newScopeToken()
+		bck = &cmn.Bck{Name: "datasets", Provider: apc.AIS}
+	)
+	tests := []struct {
+		scope *aceScope
+		ace   apc.AccessAttrs
+		ok    bool
+	}{
+		{&aceScope{objName: "team-a/obj"}, apc.AcePUT, true},
+		{&aceScope{objName: "team-b/obj"}, apc.AcePUT, false},
+		{&aceScope{objName: "team-b/obj"}, apc.AceGET, true},
+		{&aceScope{objName: "team-a/private/obj"}, apc.AceGET, false},
+		{&aceScope{names: []string{"team-a/x", "team-a/y"}}, apc.AceObjDELETE, true},
+		{&aceScope{names: []string{"team-a/x", "team-b/y"}}, apc.AceObjDELETE, false},
+		{&aceScope{prefix: "team-a/sub/"}, apc.AceObjDELETE, true},
+		{&aceScope{prefix: "team-a/"}, apc.AceObjDELETE, false}, // (team-a/private/)
+		{&aceScope{prefix: ""}, apc.AceObjDELETE, false},
+		{&aceScope{prefix: ""}, apc.AceGET, false}, // (team-a/private/)
+		{&aceScope{prefix: "team-b/"}, apc.AceGET, true},
+	}
+	for i, test := range tests {
+		err := test.scope.check(tk, testScopeCluID, bck, test.ace)
+		tassert.Errorf(t, (err == nil) == test.ok, "%d: %+v (%s): expected ok=%t, got %v",
+			i, test.scope, test.ace.Describe(false), test.ok, err)
+	}
+}
+
+func TestAceScopeFilter(t *testing.T) {
+	var (
+		tk  = newScopeToken()
+		bck = &cmn.Bck{Name: "datasets", Provider: apc.AIS}
+	)
+	tk.ClusterACLs = nil // access to team-a/ only
+
+	// accessible subset
+	scope := &aceScope{prefix: "", ls: true}
+	tassert.CheckFatal(t, scope.check(tk, testScopeCluID, bck, apc.AceObjLIST))
+	tassert.Fatalf(t, scope.lsFilter != nil, "expected list filter")
+
+	entries := cmn.LsoEntries{
+		{Name: "team-a/", Flags: apc.EntryIsDir},
+		{Name: "team-a/obj"},
+		{Name: "team-a/private/obj"},
+		{Name: "team-b/", Flags: apc.EntryIsDir},
+		{Name: "team-b/obj"},
+		{Name: "top-level-obj"},
+	}
+	entries = scope.filter(entries)
+	tassert.Fatalf(t, len(entries) == 2, "expected 2 entries, got %d", len(entries))
+	tassert.Errorf(t, entries[0].Name == "team-a/" && entries[1].Name == "team-a/obj", "unexpected %s, %s",
+		entries[0].Name, entries[1].Name)
+
+	// no filtering: entire prefix is accessible, or no scope at all
+	scope = &aceScope{prefix: "team-a/sub/", ls: true}
+	tassert.CheckFatal(t, scope.check(tk, testScopeCluID, bck, apc.AceObjLIST))
+	entries = cmn.LsoEntries{{Name: "team-a/sub/obj"}, {Name: "team-a/sub/obj2"}}
+	tassert.Errorf(t, len(scope.filter(entries)) == 2, "expected no filtering")
+	tassert.Errorf(t, len((*aceScope)(nil).filter(entries)) == 2, "expected no filtering (nil scope)")
+
+	// nothing accessible
+	scope = &aceScope{prefix: "team-b/", ls: true}
+	tassert.Errorf(t, scope.check(tk, testScopeCluID, bck, apc.AceObjLIST) != nil, "expected team-b/ to deny")
+}
+
+func TestRevokedAPIKey(t *testing.T) {
+	const secret = "test-secret"
+	var (
+		a       = newAuthManager(cmn.GCO.Get())
+		signer  = tok.SecretKey(secret)
+		expires = time.Now().Add(time.Hour)
+		acls    = []*authn.CluACL{{ID: testScopeCluID, Access: apc.AccessRW}}
+	)
+	a.secret = secret
+	key, err := tok.APIKeyJWT(expires, "ci-bot", "key-1", nil, acls, false, signer)
+	tassert.CheckFatal(t, err)
+	other, err := tok.APIKeyJWT(expires, "ci-bot", "key-2", nil, acls, false, signer)
+	tassert.CheckFatal(t, err)
+	_, err = a.validateToken(key) // (cached)
+	tassert.CheckFatal(t, err)
+
+	// AuthN revokes by key ID (see cmd/authn revokeAPIKey)
+	revoked, err := tok.APIKeyJWT(expires, "ci-bot", "key-1", nil, nil, false, signer)
+	tassert.CheckFatal(t, err)
+	all := a.updateRevokedList(&tokenList{Tokens: []string{revoked}})
+	tassert.Fatalf(t, all != nil && len(all.Tokens) == 1, "expected one revoked token, got %+v", all)
+
+	_, err = a.validateToken(key)
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), tok.ErrTokenRevoked.Error()), "expected revoked API key, got %v", err)
+	_, err = a.validateToken(other)
+	tassert.CheckError(t, err)
+
+	// expired revocation
+	expired, err := tok.APIKeyJWT(time.Now().Add(-time.Minute), "ci-bot", "key-2", nil, nil, false, signer)
+	tassert.CheckFatal(t, err)
+	a.updateRevokedList(&tokenList{Tokens: []string{expired}})
+	tassert.Errorf(t, !a.revokedKeys["key-2"], "not expecting expired revocation to be kept")
+	_, err = a.validateToken(other)
+	tassert.CheckError(t, err)
+}
@@ -38,6 +38,10 @@ type bctx struct {
 	reqBody []byte          // request body of original request
 	perms   apc.AccessAttrs // apc.AceGET, apc.AcePATCH etc.
 
+	// object-level access scope (prefix-scoped bucket ACLs), if any
+	objName string
+	scope   *aceScope
+
 	// 5 user or caller-provided control flags followed by
 	// 3 result flags
 	skipBackend    bool // initialize bucket via `bck.InitNoBackend`
@@ -176,7 +180,12 @@ rerr:
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (ecode int, err error) {
-	err = bctx.p.access(bctx.r.Header, bck, bctx.perms)
+	if bctx.objName != "" {
+		err = bctx.p.accessObj(bctx.r.Header, bck, bctx.objName, bctx.perms)
+	} else {
+		err = bctx.p.accessScope(bctx.r.Header, bck, bctx.perms, bctx.scope)
+	}
+	auditScope(bctx.w, bck, bctx.objName, bctx.scope)
 	ecode = aceErrToCode(err)
 	return ecode, err
 }
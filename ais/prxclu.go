@@ -82,6 +82,10 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatReplStats:
+		p.qcluReplStats(w, r, what, query)
+	case apc.WhatAudit:
+		p.qcluAudit(w, r, what, query)
 	case apc.WhatBackends:
 		config := cmn.GCO.Get()
 		out := make([]string, 0, len(config.Backend.Providers))
@@ -308,6 +312,14 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+func (p *proxy) qcluReplStats(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	targetStats, erred := p._queryTs(w, r, query)
+	if targetStats == nil || erred {
+		return
+	}
+	p.writeJSON(w, r, targetStats, what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -823,6 +835,12 @@ func (p *proxy) _joinedPre(ctx *smapModifier, clone *smapX) error {
 	if !clone.isPrimary(p.si) {
 		return newErrNotPrimary(p.si, clone, fmt.Sprintf("cannot add %s", ctx.nsi))
 	}
+	if ctx.nsi.IsTarget() && ctx.nsi.Domain == "" {
+		// keep failure domain that was set at runtime (see setFdomain)
+		if osi := clone.GetTarget(ctx.nsi.ID()); osi != nil {
+			ctx.nsi.Domain = osi.Domain
+		}
+	}
 	clone.putNode(ctx.nsi, ctx.flags, true /*silent*/)
 	if ctx.nsi.IsProxy() {
 		clone.staffIC()
@@ -1041,6 +1059,8 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 		p.rmNode(w, r, msg)
 	case apc.ActStopMaintenance:
 		p.stopMaintenance(w, r, msg)
+	case apc.ActSetFdomain:
+		p.setFdomain(w, r, msg)
 
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
@@ -1701,6 +1721,58 @@ func (p *proxy) stopMaintenance(w http.ResponseWriter, r *http.Request, msg *apc
 	}
 }
 
+// label (or unlabel) target with failure domain; note that the resulting change
+// in EC placement applies to new writes - existing objects can be brought
+// in compliance via rebalance or ec-reencode
+func (p *proxy) setFdomain(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var (
+		domain string
+		smap   = p.owner.smap.get()
+	)
+	if msg.Value != nil {
+		if err := cos.MorphMarshal(msg.Value, &domain); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+	}
+	if err := meta.ValidateFdomain(domain); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	tsi := smap.GetTarget(msg.Name)
+	if tsi == nil {
+		p.writeErr(w, r, &errNodeNotFound{p.si, smap, msg.Action, msg.Name}, http.StatusNotFound)
+		return
+	}
+	if tsi.Domain == domain {
+		return // nothing to do
+	}
+	nlog.Infoln(p.String(), msg.Action, tsi.StringEx(), "[", tsi.Domain, "=>", domain, "]")
+	ctx := &smapModifier{
+		pre:    p._setFdomainPre,
+		final:  p._syncFinal,
+		sid:    tsi.ID(),
+		domain: domain,
+		msg:    msg,
+	}
+	if err := p.owner.smap.modify(ctx); err != nil {
+		p.writeErr(w, r, err, ctx.status)
+	}
+}
+
+func (p *proxy) _setFdomainPre(ctx *smapModifier, clone *smapX) error {
+	if !clone.isPrimary(p.si) {
+		return newErrNotPrimary(p.si, clone, "cannot set failure domain of "+meta.Tname(ctx.sid))
+	}
+	tsi := clone.GetTarget(ctx.sid)
+	if tsi == nil {
+		ctx.status = http.StatusNotFound
+		return &errNodeNotFound{p.si, clone, ctx.msg.Action, ctx.sid}
+	}
+	tsi.Domain = ctx.domain
+	return nil
+}
+
 func (p *proxy) cluputItems(w http.ResponseWriter, r *http.Request, items []string) {
 	action := items[0]
 	if p.forwardCP(w, r, &apc.ActMsg{Action: action}, "") {
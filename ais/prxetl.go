@@ -518,7 +518,7 @@ func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request, msg etl.InitMsg)
 }
 
 func (p *proxy) etlExists(etlName string) error {
-	if !k8s.IsK8s() {
+	if !etl.Enabled() {
 		return k8s.ErrK8sRequired
 	}
 	if err := k8s.ValidateEtlName(etlName); err != nil {
@@ -0,0 +1,133 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Public keys to verify RS256 and ES256 signed tokens (config.Auth.JWKS):
+// - fetched from AuthN (GET /v1/jwks) or loaded from a local JWKS file
+// - cached and refreshed lazily: periodically, and when a token is signed with an unknown key ID
+//   (the latter is how a newly added AuthN key becomes valid during key rotation)
+// - a key removed from the JWKS stops verifying upon the next refresh
+
+const (
+	jwksMaxAge  = 10 * time.Minute // refresh at least that often
+	jwksMinAge  = 10 * time.Second // and not more often than that (unknown key ID, failure to fetch)
+	jwksTimeout = 10 * time.Second
+)
+
+type jwksCache struct {
+	jwks   *authn.JWKS
+	client *http.Client
+	src    string // config.Auth.JWKS at the time of loading
+	loaded int64  // mono-time
+	failed int64  // ditto
+	mu     sync.Mutex
+}
+
+var errNoJWKS = errors.New("public keys (config auth.jwks) not configured")
+
+// (tok.PubKeyFunc)
+func (c *jwksCache) pubKey(kid, alg string) (crypto.PublicKey, error) {
+	config := cmn.GCO.Get()
+	src := config.Auth.JWKS
+	if src == "" {
+		return nil, errNoJWKS
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var (
+		jwk   *authn.JWK
+		now   = mono.NanoTime()
+		since = time.Duration(now - c.loaded)
+	)
+	if c.jwks != nil && c.src == src {
+		jwk = c.jwks.Find(kid)
+	}
+	if (c.src != src || since > jwksMaxAge || (jwk == nil && since > jwksMinAge)) && time.Duration(now-c.failed) > jwksMinAge {
+		if err := c.load(src, config); err != nil {
+			c.failed = now
+			if jwk == nil {
+				return nil, err
+			}
+			nlog.Warningln("failed to refresh public keys (using cached):", err)
+		} else {
+			jwk = c.jwks.Find(kid)
+		}
+	}
+	if jwk == nil {
+		return nil, fmt.Errorf("%v: unknown key ID %q", tok.ErrInvalidToken, kid)
+	}
+	return tok.JWKPublicKey(jwk, alg)
+}
+
+// under lock
+func (c *jwksCache) load(src string, config *cmn.Config) (err error) {
+	var b []byte
+	if src != c.src {
+		c.client = nil
+	}
+	if cos.IsHT(src) || cos.IsHTTPS(src) {
+		b, err = c.fetch(src, config)
+	} else {
+		b, err = os.ReadFile(src)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load JWKS from %q: %w", src, err)
+	}
+	jwks := &authn.JWKS{}
+	if err := jsoniter.Unmarshal(b, jwks); err != nil {
+		return fmt.Errorf("invalid JWKS from %q: %w", src, err)
+	}
+	if len(jwks.Keys) == 0 {
+		nlog.Warningln("JWKS from", src, "contains no keys")
+	}
+	c.jwks, c.src, c.loaded = jwks, src, mono.NanoTime()
+	return nil
+}
+
+func (c *jwksCache) fetch(url string, config *cmn.Config) ([]byte, error) {
+	if c.client == nil {
+		cargs := cmn.TransportArgs{Timeout: jwksTimeout}
+		if cos.IsHTTPS(url) {
+			// same trust as the cluster's own (HTTPS) configuration
+			sargs := cmn.TLSArgs{ClientCA: config.Net.HTTP.ClientCA, SkipVerify: config.Net.HTTP.SkipVerifyCrt}
+			c.client = cmn.NewClientTLS(cargs, sargs, false /*intra-cluster*/)
+		} else {
+			c.client = cmn.NewClient(cargs)
+		}
+	}
+	resp, err := c.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	b, err := cos.ReadAllN(resp.Body, resp.ContentLength)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", resp.Status, cos.BHead(b))
+	}
+	return b, nil
+}
@@ -0,0 +1,185 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// AuthN's GET /v1/jwks
+type testJWKS struct {
+	keys    []authn.JWK
+	fetched atomic.Int32
+	fail    atomic.Bool
+	block   chan struct{} // when non-nil, blocks fetching
+	mu      sync.Mutex
+}
+
+func (s *testJWKS) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.fetched.Add(1)
+	s.mu.Lock()
+	block := s.block
+	b := cos.MustMarshal(&authn.JWKS{Keys: s.keys})
+	s.mu.Unlock()
+	if block != nil {
+		<-block
+	}
+	if s.fail.Load() {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Write(b)
+}
+
+func (s *testJWKS) set(keys ...*tok.SigningKey) {
+	s.mu.Lock()
+	s.keys = s.keys[:0]
+	for _, sk := range keys {
+		s.keys = append(s.keys, sk.JWK())
+	}
+	s.mu.Unlock()
+}
+
+func newTestSigningKey(t *testing.T, kid string) *tok.SigningKey {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	sk, err := tok.NewSigningKey(kid, priv)
+	tassert.CheckFatal(t, err)
+	return sk
+}
+
+func setJWKS(src string) {
+	config := cmn.GCO.BeginUpdate()
+	config.Auth.JWKS = src
+	cmn.GCO.CommitUpdate(config)
+}
+
+// (a different user ID for each new token - to bypass the cache of validated tokens)
+func newTestJWT(t *testing.T, sk *tok.SigningKey, uid string) string {
+	token, err := tok.AdminJWT(time.Now().Add(time.Hour), uid, sk)
+	tassert.CheckFatal(t, err)
+	return token
+}
+
+// age cached public keys
+func ageJWKS(a *authManager, age time.Duration) {
+	a.jwks.mu.Lock()
+	a.jwks.loaded = mono.NanoTime() - int64(age)
+	a.jwks.failed = 0
+	a.jwks.mu.Unlock()
+}
+
+func TestJWKSRotation(t *testing.T) {
+	var (
+		srv  = &testJWKS{}
+		hsrv = httptest.NewServer(srv)
+		key1 = newTestSigningKey(t, "key1")
+		key2 = newTestSigningKey(t, "key2")
+		a    = newAuthManager(cmn.GCO.Get())
+	)
+	defer hsrv.Close()
+	setJWKS(hsrv.URL)
+	defer setJWKS("")
+
+	srv.set(key1)
+	_, err := a.validateToken(newTestJWT(t, key1, "user1"))
+	tassert.CheckFatal(t, err)
+	_, err = a.validateToken(newTestJWT(t, key1, "user2"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, srv.fetched.Load() == 1, "expecting cached public keys, fetched %d times", srv.fetched.Load())
+
+	// new key: unknown key ID, too soon to refresh
+	srv.set(key1, key2)
+	_, err = a.validateToken(newTestJWT(t, key2, "user3"))
+	tassert.Errorf(t, err != nil, "expecting unknown key ID (not refreshing more often than %v)", jwksMinAge)
+	tassert.Errorf(t, srv.fetched.Load() == 1, "expecting no refresh, fetched %d times", srv.fetched.Load())
+
+	// unknown key ID triggers refresh
+	ageJWKS(a, jwksMinAge+time.Second)
+	_, err = a.validateToken(newTestJWT(t, key2, "user4"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, srv.fetched.Load() == 2, "expecting refresh, fetched %d times", srv.fetched.Load())
+	_, err = a.validateToken(newTestJWT(t, key1, "user5"))
+	tassert.CheckError(t, err)
+
+	// removed key stops verifying upon periodic refresh
+	srv.set(key2)
+	ageJWKS(a, jwksMaxAge+time.Second)
+	_, err = a.validateToken(newTestJWT(t, key1, "user6"))
+	tassert.Errorf(t, err != nil, "expecting removed key1 to stop verifying")
+	tassert.Errorf(t, srv.fetched.Load() == 3, "expecting periodic refresh, fetched %d times", srv.fetched.Load())
+
+	// failure to refresh: keep using cached keys
+	srv.fail.Store(true)
+	ageJWKS(a, jwksMaxAge+time.Second)
+	_, err = a.validateToken(newTestJWT(t, key2, "user7"))
+	tassert.CheckError(t, err)
+	_, err = a.validateToken(newTestJWT(t, key2, "user8"))
+	tassert.CheckError(t, err)
+	tassert.Errorf(t, srv.fetched.Load() == 4, "expecting no retry sooner than %v, fetched %d times", jwksMinAge, srv.fetched.Load())
+}
+
+// validating cached tokens must not wait for public keys
+func TestJWKSFetchUnlocked(t *testing.T) {
+	var (
+		srv  = &testJWKS{}
+		hsrv = httptest.NewServer(srv)
+		key1 = newTestSigningKey(t, "key1")
+		key2 = newTestSigningKey(t, "key2")
+		a    = newAuthManager(cmn.GCO.Get())
+	)
+	defer hsrv.Close()
+	setJWKS(hsrv.URL)
+	defer setJWKS("")
+
+	srv.set(key1, key2)
+	cached := newTestJWT(t, key1, "user1")
+	_, err := a.validateToken(cached)
+	tassert.CheckFatal(t, err)
+
+	block := make(chan struct{})
+	srv.mu.Lock()
+	srv.block = block
+	srv.mu.Unlock()
+	ageJWKS(a, jwksMaxAge+time.Second)
+
+	fetching := make(chan error, 1)
+	go func() {
+		_, err := a.validateToken(newTestJWT(t, key2, "user2"))
+		fetching <- err
+	}()
+	for srv.fetched.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	validated := make(chan error, 1)
+	go func() {
+		_, err := a.validateToken(cached)
+		validated <- err
+	}()
+	select {
+	case err := <-validated:
+		tassert.CheckError(t, err)
+	case <-time.After(jwksTimeout / 2):
+		t.Error("validating cached token blocked by fetching public keys")
+	}
+	close(block)
+	tassert.CheckError(t, <-fetching)
+}
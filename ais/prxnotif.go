@@ -25,6 +25,7 @@ import (
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
 	"github.com/NVIDIA/aistore/xact/xreg"
 
 	jsoniter "github.com/json-iterator/go"
@@ -52,6 +53,13 @@ type (
 
 		tempnl []nl.Listener
 
+		// resumable xactions that are (temporarily) not found at their respective targets:
+		// (xaction ID + target ID) => mono-time first seen absent (see xact/xckpt)
+		absent struct {
+			m  map[string]int64
+			mu sync.Mutex
+		}
+
 		smapVer int64
 		mu      sync.Mutex
 	}
@@ -97,6 +105,7 @@ func (n *notifs) init(p *proxy) {
 	n.finished = make([]nl.Listener, 16)
 
 	n.tempnl = make([]nl.Listener, 0, 16)
+	n.absent.m = make(map[string]int64, 4)
 
 	hk.Reg(notifsName+hk.NameSuffix, n.housekeep, hk.PruneActiveIval)
 	n.p.Sowner().Listeners().Reg(n)
@@ -300,6 +309,13 @@ func (n *notifs) done(nl nl.Listener) {
 		return
 	}
 	n.fin.add(nl, false /*locked*/)
+	if xact.IsResumable(nl.Kind()) {
+		n.absent.mu.Lock()
+		for sid := range nl.Notifiers() {
+			delete(n.absent.m, nl.UUID()+sid)
+		}
+		n.absent.mu.Unlock()
+	}
 
 	if nl.Aborted() {
 		smap := n.p.owner.smap.get()
@@ -423,6 +439,7 @@ func (n *notifs) bcastGetStats(nl nl.Listener, dur time.Duration) {
 			}
 			nl.SetStats(res.si.ID(), stats)
 			nl.Unlock()
+			n.present(nl, res.si)
 			continue
 		}
 		// err
@@ -431,13 +448,26 @@ func (n *notifs) bcastGetStats(nl nl.Listener, dur time.Duration) {
 				// likely didn't start yet - skipping
 				continue
 			}
+			if n.suspended(nl, res.si) {
+				continue
+			}
 			err := fmt.Errorf("%s: %s not found at %s", n.p.si, nl, res.si.StringEx())
 			nl.Lock()
 			done = done || n.markFinished(nl, res.si, err, true) // NOTE: not-found at one ==> all done
 			nl.Unlock()
-		} else if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			continue
+		}
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
 			nlog.Errorln(n.p.String(), nl.String(), "node", res.si.StringEx(), res.unwrap())
 		}
+		// resumable xaction at an unreachable target: same timeout as (above) not-found
+		if xact.IsResumable(nl.Kind()) && !n.suspended(nl, res.si) {
+			err := fmt.Errorf("%s: %s at %s: node unreachable for over %v: %v", n.p.si, nl, res.si.StringEx(),
+				xckpt.ResumeTimeout, res.unwrap())
+			nl.Lock()
+			done = done || n.markFinished(nl, res.si, err, true)
+			nl.Unlock()
+		}
 	}
 	freeBcastRes(results)
 	if done {
@@ -445,6 +475,48 @@ func (n *notifs) bcastGetStats(nl nl.Listener, dur time.Duration) {
 	}
 }
 
+// resumable xaction may be suspended at a given target (e.g., restarting)
+// and then, within xckpt.ResumeTimeout, resumed under the same ID
+func (n *notifs) suspended(nl nl.Listener, tsi *meta.Snode) bool {
+	if !xact.IsResumable(nl.Kind()) {
+		return false
+	}
+	key := nl.UUID() + tsi.ID()
+	n.absent.mu.Lock()
+	started, ok := n.absent.m[key]
+	if !ok {
+		started = mono.NanoTime()
+		n.absent.m[key] = started
+	}
+	n.absent.mu.Unlock()
+	if elapsed := mono.Since(started); elapsed < xckpt.ResumeTimeout {
+		if !ok {
+			nlog.Warningln(n.p.String()+":", nl.String(), "not found (or unreachable) at", tsi.StringEx(), "- waiting for it to resume")
+		}
+		return true
+	}
+	return false
+}
+
+// resumable xaction may resume when (and if) the target rejoins - unless the latter is removed
+// from the cluster, is being decommissioned, or stays out for longer than xckpt.ResumeTimeout
+func (n *notifs) mayResume(nl nl.Listener, smap *smapX, sid string) bool {
+	tsi := smap.GetNode(sid)
+	if tsi == nil || tsi.Flags.IsSet(meta.SnodeDecomm) {
+		return false
+	}
+	return n.suspended(nl, tsi)
+}
+
+func (n *notifs) present(nl nl.Listener, tsi *meta.Snode) {
+	if !xact.IsResumable(nl.Kind()) {
+		return
+	}
+	n.absent.mu.Lock()
+	delete(n.absent.m, nl.UUID()+tsi.ID())
+	n.absent.mu.Unlock()
+}
+
 func (n *notifs) getOwner(uuid string) (o string, exists bool) {
 	var nl nl.Listener
 	if nl = n.entry(uuid); nl != nil {
@@ -501,6 +573,11 @@ repeat:
 			delete(remnl, uuid)
 			goto repeat
 		}
+		if xact.IsResumable(nl.Kind()) && n.mayResume(nl, smap, sid) {
+			nlog.Infof("Warning: %s: %s is out, not aborting resumable xaction", nl.String(), sid)
+			delete(remnl, uuid)
+			goto repeat
+		}
 		err := &errNodeNotFound{n.p.si, smap, "abort " + nl.String() + " via 'smap-changed':", sid}
 		nl.Lock()
 		nl.AddErr(err)
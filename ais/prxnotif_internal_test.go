@@ -14,11 +14,13 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -83,6 +85,7 @@ var _ = Describe("Notifications xaction test", func() {
 				nls: newListeners(),
 				fin: newListeners(),
 			}
+			n.absent.m = make(map[string]int64, 4)
 			smap := &smapX{Smap: meta.Smap{Version: 1}}
 			n.p.htrun.owner.smap = newSmapOwner(cmn.GCO.Get())
 			n.p.htrun.owner.smap.put(smap)
@@ -245,6 +248,65 @@ var _ = Describe("Notifications xaction test", func() {
 			Expect(nl.Finished()).To(BeTrue())
 			Expect(nl.Aborted()).To(BeTrue())
 		})
+
+		Describe("resumable xaction", func() {
+			var smapWith = func(flags cos.BitFlags) {
+				smap := n.p.owner.smap.get()
+				smap.Tmap = getNodeMap(target1ID, target2ID)
+				smap.Tmap[target2ID].Flags = flags
+				smap.Version++
+				n.p.owner.smap.put(smap)
+			}
+
+			BeforeEach(func() {
+				nl = xact.NewXactNL(xid, apc.ActBlobDl, &smap.Smap, getNodeMap(target1ID, target2ID))
+				n.add(nl)
+			})
+
+			It("should not abort when target is temporarily out", func() {
+				smapWith(meta.SnodeMaint)
+				n.ListenSmapChanged()
+				Expect(nl.Finished()).To(BeFalse())
+				Expect(nl.Aborted()).To(BeFalse())
+				Expect(n.absent.m).To(HaveKey(xid + target2ID))
+			})
+
+			It("should abort when target is decommissioned", func() {
+				smapWith(meta.SnodeDecomm)
+				n.ListenSmapChanged()
+				Expect(nl.Finished()).To(BeTrue())
+				Expect(nl.Aborted()).To(BeTrue())
+			})
+
+			It("should abort when target is removed", func() {
+				smap := n.p.owner.smap.get()
+				smap.Tmap = getNodeMap(target1ID)
+				smap.Version++
+				n.p.owner.smap.put(smap)
+				n.ListenSmapChanged()
+				Expect(nl.Finished()).To(BeTrue())
+				Expect(nl.Aborted()).To(BeTrue())
+			})
+
+			It("should abort when target fails to resume in time", func() {
+				n.absent.m[xid+target2ID] = mono.NanoTime() - int64(xckpt.ResumeTimeout) - 1
+				smapWith(meta.SnodeMaint)
+				n.ListenSmapChanged()
+				Expect(nl.Finished()).To(BeTrue())
+				Expect(nl.Aborted()).To(BeTrue())
+			})
+
+			It("should keep track of absent (and unreachable) targets", func() {
+				tsi := nl.Notifiers()[target2ID]
+				Expect(n.suspended(nl, tsi)).To(BeTrue())
+				Expect(n.suspended(nl, tsi)).To(BeTrue())
+				n.present(nl, tsi)
+				Expect(n.absent.m).NotTo(HaveKey(xid + target2ID))
+
+				n.absent.m[xid+target2ID] = mono.NanoTime() - int64(xckpt.ResumeTimeout) - 1
+				Expect(n.suspended(nl, tsi)).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("handler", func() {
@@ -6,11 +6,14 @@ package ais
 
 import (
 	"bytes"
+	"cmp"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,6 +43,14 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		nlog.Infoln("s3Handler", p.String(), r.Method, r.URL)
 	}
 
+	// native SigV4 (with AuthN enabled) - must be done prior to modifying the request;
+	// skipped when client-signed requests are meant to be passed through to S3 (see feat.S3PresignedRequest)
+	if cmn.Rom.AuthEnabled() && !cmn.Rom.Features().IsSet(feat.S3PresignedRequest) && s3.IsSigV4(r) {
+		if !p.sigv4(w, r) {
+			return
+		}
+	}
+
 	// TODO: Fix the hack, https://github.com/tensorflow/tensorflow/issues/41798
 	cos.ReparseQuery(r)
 	apiItems, err := p.parseURL(w, r, apc.URLPathS3.L, 0, true)
@@ -79,8 +90,24 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			_, policy    = q[s3.QparamPolicy]
 			_, cors      = q[s3.QparamCORS]
 			_, acl       = q[s3.QparamACL]
+			_, notif     = q[s3.QparamNotification]
 		)
-		if lifecycle || policy || cors || acl {
+		if lifecycle && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckLifecycleS3(w, r, apiItems[0])
+			return
+		}
+		if cors && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckCORSS3(w, r, apiItems[0])
+			return
+		}
+		if notif && len(apiItems) == 1 {
+			// perms: apc.AceBckHEAD
+			p.getBckNotifS3(w, r, apiItems[0])
+			return
+		}
+		if lifecycle || policy || cors || acl || notif {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
@@ -91,6 +118,11 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.getBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamVersions) {
+				// perms: apc.AceObjLIST
+				p.listVersionsS3(w, r, apiItems[0], q)
+				return
+			}
 			// perms: apc.AceObjLIST
 			p.listObjectsS3(w, r, apiItems[0], q)
 			return
@@ -110,6 +142,21 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamLifecycle) {
+				// perms: apc.AcePATCH
+				p.putBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamCORS) {
+				// perms: apc.AcePATCH
+				p.putBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamNotification) {
+				// perms: apc.AcePATCH
+				p.putBckNotifS3(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceCreateBucket
 			p.putBckS3(w, r, apiItems[0])
 			return
@@ -144,15 +191,28 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.delMultipleObjs(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamLifecycle) {
+				// perms: apc.AcePATCH
+				p.delBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamCORS) {
+				// perms: apc.AcePATCH
+				p.delBckCORSS3(w, r, apiItems[0])
+				return
+			}
 			// perms: apc.AceDestroyBucket
 			p.delBckS3(w, r, apiItems[0])
 			return
 		}
 		// perms: apc.AceObjDELETE
 		p.delObjS3(w, r, apiItems)
+	case http.MethodOptions:
+		// CORS preflight (no perms)
+		p.optionsS3(w, r, apiItems)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead,
-			http.MethodPost, http.MethodPut)
+			http.MethodOptions, http.MethodPost, http.MethodPut)
 	}
 }
 
@@ -228,11 +288,11 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AcePUT); err != nil {
+	objName := s3.ObjName(parts)
+	if err := p.accessObj(r.Header, bck, objName, apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
-	objName := s3.ObjName(parts)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -246,7 +306,7 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 	}
 	started := time.Now()
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraData, netPub)
-	p.s3Redirect(w, r, si, redirectURL, bck.Name)
+	p.s3Redirect(w, r, si, redirectURL, bck)
 }
 
 // DELETE /s3/i<bucket-name>?delete
@@ -256,10 +316,6 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	decoder := xml.NewDecoder(r.Body)
 	lst := &s3.Delete{}
 	if err := decoder.Decode(lst); err != nil {
@@ -267,6 +323,9 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		return
 	}
 	if len(lst.Object) == 0 {
+		if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+		}
 		return
 	}
 
@@ -278,6 +337,10 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		lrMsg.ObjNames = append(lrMsg.ObjNames, obj.Key)
 	}
 	msg.Value = lrMsg
+	if err := p.accessScope(r.Header, bck, apc.AceObjDELETE, &aceScope{names: lrMsg.ObjNames}); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
 
 	// marshal+unmarshal to convince `p.listrange` to treat `listMsg` as `map[string]interface`
 	var (
@@ -342,10 +405,6 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjLIST); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	amsg := &apc.ActMsg{Action: apc.ActList}
 
 	// currently, always forwarding
@@ -373,6 +432,12 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// - "encoding-type"
 	s3.FillLsoMsg(q, lsmsg)
 
+	scope := newLsScope(lsmsg.Prefix)
+	if err := p.accessScope(r.Header, bck, apc.AceObjLIST, scope); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+
 	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header)
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infoln("lsoS3", bck.Cname(""), len(lst.Entries), err)
@@ -381,6 +446,7 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	lst.Entries = scope.filter(lst.Entries) // prefix-scoped ACLs
 
 	// NOTE:
 	// - the following few lines of code translate (using additional memory) list-objects
@@ -438,6 +504,105 @@ func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg,
 	return lst, nil
 }
 
+// GET /s3/<bucket-name>?versions (ListObjectVersions)
+// - current versions: list-objects (see listObjectsS3 above)
+// - noncurrent versions of ais:// objects: from all targets (see target.listVersions)
+// - supported: "prefix"; not supported: pagination ("key-marker", "version-id-marker"), "delimiter"
+func (p *proxy) listVersionsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	amsg := &apc.ActMsg{Action: apc.ActList}
+	if p.forwardCP(w, r, amsg, lsotag+" "+bck.String()) {
+		return
+	}
+
+	lsmsg := &apc.LsoMsg{TimeFormat: time.RFC3339, Prefix: q.Get(s3.QparamPrefix)}
+	scope := newLsScope(lsmsg.Prefix)
+	if err := p.accessScope(r.Header, bck, apc.AceObjLIST, scope); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsCustom, apc.GetPropsVersion)
+	amsg.Value = lsmsg
+
+	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	var noncurrent cmn.LsoEntries
+	if bck.IsAIS() && bck.Props.Versioning.Enabled {
+		if noncurrent, err = p.lsNoncurrent(bck, lsmsg); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+	}
+	lst.Entries, noncurrent = scope.filter(lst.Entries), scope.filter(noncurrent) // prefix-scoped ACLs
+	if cmn.Rom.FastV(5, cos.SmoduleS3) {
+		nlog.Infoln("lsvS3", bck.Cname(""), len(lst.Entries), len(noncurrent))
+	}
+
+	// merge: by name, and then the most recent version first
+	slices.SortFunc(noncurrent, func(a, b *cmn.LsoEnt) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		va, _ := strconv.ParseUint(a.Version, 10, 64)
+		vb, _ := strconv.ParseUint(b.Version, 10, 64)
+		return cmp.Compare(vb, va)
+	})
+	resp := s3.NewListVersionsResult(bucket, lsmsg.Prefix)
+	i := 0
+	for _, en := range lst.Entries {
+		if en.IsAnyFlagSet(apc.EntryIsDir) {
+			continue
+		}
+		for ; i < len(noncurrent) && noncurrent[i].Name < en.Name; i++ {
+			resp.Add(noncurrent[i], lsmsg, false)
+		}
+		resp.Add(en, lsmsg, true)
+	}
+	for ; i < len(noncurrent); i++ {
+		resp.Add(noncurrent[i], lsmsg, false)
+	}
+
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+func (p *proxy) lsNoncurrent(bck *meta.Bck, lsmsg *apc.LsoMsg) (entries cmn.LsoEntries, err error) {
+	var (
+		q    = bck.AddToQuery(make(url.Values, 2))
+		args = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  q,
+		Body:   cos.MustMarshal(p.newAmsgActVal(apc.ActListVersions, lsmsg)),
+	}
+	args.timeout = apc.DefaultTimeout
+	args.smap = p.owner.smap.get()
+	args.cresv = cresjGeneric[cmn.LsoRes]{}
+
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err = res.toErr()
+			break
+		}
+		entries = append(entries, res.v.(*cmn.LsoRes).Entries...)
+	}
+	freeBcastRes(results)
+	return entries, err
+}
+
 // PUT /s3/<bucket-name>/<object-name>
 func (p *proxy) putObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	if r.Header.Get(cos.S3HdrObjSrc) == "" {
@@ -462,7 +627,8 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bckSrc == nil {
 		return
 	}
-	if err := p.access(r.Header, bckSrc, apc.AceGET); err != nil {
+	objName := strings.Trim(parts[1], "/")
+	if err := p.accessObj(r.Header, bckSrc, objName, apc.AceGET); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -471,12 +637,11 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bckDst == nil {
 		return
 	}
-	if err := bckDst.Allow(apc.AcePUT); err != nil {
+	if err := p.accessObj(r.Header, bckDst, s3.ObjName(items), apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
 
-	objName := strings.Trim(parts[1], "/")
 	smap := p.owner.smap.get()
 	si, err := smap.HrwName2T(bckSrc.MakeUname(objName))
 	if err != nil {
@@ -488,7 +653,7 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	}
 	started := time.Now()
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
-	p.s3Redirect(w, r, si, redirectURL, bckDst.Name)
+	p.s3Redirect(w, r, si, redirectURL, bckDst)
 }
 
 // PUT /s3/<bucket-name>/<object-name> - with empty `cos.S3HdrObjSrc`
@@ -498,15 +663,15 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AcePUT); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
 	objName := s3.ObjName(items)
+	if err := p.accessObj(r.Header, bck, objName, apc.AcePUT); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -524,7 +689,7 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	started := time.Now()
 
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraData, netPub)
-	p.s3Redirect(w, r, si, redirectURL, bck.Name)
+	p.s3Redirect(w, r, si, redirectURL, bck)
 }
 
 // GET /s3/<bucket-name>/<object-name>
@@ -533,11 +698,11 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceGET); err != nil {
-		s3.WriteErr(w, r, err, http.StatusForbidden)
-		return
-	}
 	if listMultipart {
+		if err := p.access(r.Header, bck, apc.AceGET); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+			return
+		}
 		p.listMultipart(w, r, bck, q)
 		return
 	}
@@ -546,6 +711,10 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 		return
 	}
 	objName := s3.ObjName(items)
+	if err := p.accessObj(r.Header, bck, objName, apc.AceGET); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -563,7 +732,7 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	started := time.Now()
 
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraData, netPub)
-	p.s3Redirect(w, r, si, redirectURL, bck.Name)
+	p.s3Redirect(w, r, si, redirectURL, bck)
 }
 
 // GET /s3/<bucket-name>/<object-name> with `s3.QparamMptUploads`
@@ -577,7 +746,7 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 		}
 		started := time.Now()
 		redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
-		p.s3Redirect(w, r, si, redirectURL, bck.Name)
+		p.s3Redirect(w, r, si, redirectURL, bck)
 		return
 	}
 	// bcast & aggregate
@@ -623,11 +792,11 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjHEAD); err != nil {
+	objName := s3.ObjName(items)
+	if err := p.accessObj(r.Header, bck, objName, apc.AceObjHEAD); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
-	objName := s3.ObjName(items)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -655,11 +824,15 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
+	perms := apc.AceObjDELETE
+	if r.URL.Query().Has(s3.QparamTagging) {
+		perms = apc.AcePUT // DeleteObjectTagging
+	}
+	objName := s3.ObjName(items)
+	if err := p.accessObj(r.Header, bck, objName, perms); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
-	objName := s3.ObjName(items)
 	if err := cmn.ValidOname(objName); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -676,7 +849,7 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	}
 	started := time.Now()
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
-	p.s3Redirect(w, r, si, redirectURL, bck.Name)
+	p.s3Redirect(w, r, si, redirectURL, bck)
 }
 
 // GET /s3/<bucket-name>?versioning
@@ -693,7 +866,259 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// GET /s3/<bucket-name>?lifecycle
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(&bck.Props.Lifecycle)
+	if len(resp.Rules) == 0 {
+		err := fmt.Errorf("bucket %s has no lifecycle configuration", bck.Cname(""))
+		s3.WriteErrCode(w, r, err, http.StatusNotFound, s3.ErrCodeNoSuchLifecycle)
+		return
+	}
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?lifecycle
+// (replaces existing lifecycle configuration, if any)
+func (p *proxy) putBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	lc := &s3.LifecycleConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(lc); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := lc.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p._setLifecycleS3(w, r, msg, bck, conf)
+}
+
+// DELETE /s3/<bucket-name>?lifecycle
+func (p *proxy) delBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if len(bck.Props.Lifecycle.Rules) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if p._setLifecycleS3(w, r, msg, bck, &cmn.LifecycleConf{}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (p *proxy) _setLifecycleS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck, conf *cmn.LifecycleConf) bool {
+	propsToUpdate := cmn.BpropsToSet{
+		Lifecycle: &cmn.LifecycleConfToSet{Rules: &conf.Rules, Enabled: &conf.Enabled},
+	}
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// GET /s3/<bucket-name>?notification
+// (as per S3, empty configuration when there's none)
+func (p *proxy) getBckNotifS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	resp := s3.NewNotificationConfiguration(&bck.Props.Events)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?notification
+// (replaces existing event notifications, if any; empty configuration removes them)
+func (p *proxy) putBckNotifS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	nc := &s3.NotificationConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(nc); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := nc.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	propsToUpdate := cmn.BpropsToSet{
+		Events: &cmn.EventsConfToSet{Rules: &conf.Rules, Enabled: &conf.Enabled},
+	}
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+	}
+}
+
+// GET /s3/<bucket-name>?cors
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if !bck.Props.CORS.IsActive() {
+		err := fmt.Errorf("bucket %s has no CORS configuration", bck.Cname(""))
+		s3.WriteErrCode(w, r, err, http.StatusNotFound, s3.ErrCodeNoSuchCORS)
+		return
+	}
+	resp := s3.NewCORSConfiguration(&bck.Props.CORS)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?cors
+// (replaces existing CORS configuration, if any)
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	cc := &s3.CORSConfiguration{}
+	if err := xml.NewDecoder(r.Body).Decode(cc); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	conf, err := cc.ToNative()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p._setCORSS3(w, r, msg, bck, conf)
+}
+
+// DELETE /s3/<bucket-name>?cors
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck := p.initByNameOnly(w, r, bucket)
+	if bck == nil {
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AcePATCH); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if !bck.Props.CORS.IsActive() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if p._setCORSS3(w, r, msg, bck, &cmn.CORSConf{}) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (p *proxy) _setCORSS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck, conf *cmn.CORSConf) bool {
+	propsToUpdate := cmn.BpropsToSet{
+		CORS: &cmn.CORSConfToSet{Rules: &conf.Rules},
+	}
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBprops(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// OPTIONS /s3/<bucket-name>[/<object-name>]
+func (p *proxy) optionsS3(w http.ResponseWriter, r *http.Request, items []string) {
+	if len(items) == 0 {
+		s3.WriteErr(w, r, errS3Req, 0)
+		return
+	}
+	bck := p.initByNameOnly(w, r, items[0] /*bucket*/)
+	if bck == nil {
+		return
+	}
+	if ecode, err := corsPreflight(w.Header(), r.Header, bck.Cname(""), bck.Props); err != nil {
+		s3.WriteErr(w, r, err, ecode)
+	}
+}
+
+// GET /s3/<bucket-name>?policy|acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, ecode, err := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, ecode)
@@ -749,7 +1174,7 @@ func (p *proxy) initByNameOnly(w http.ResponseWriter, r *http.Request, bucket st
 
 // either reverse-proxy call _or_ HTTP-redirect to a designated node
 // see also: docs/s3compat.md
-func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, redirectURL, bucket string) {
+func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, redirectURL string, bck *meta.Bck) {
 	if cmn.Rom.Features().IsSet(feat.S3ReverseProxy) {
 		// [intra-cluster communications]
 		// instead of regular HTTP redirect (below) reverse-proxy S3 API call to a designated target
@@ -761,11 +1186,13 @@ func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snod
 	h.Set(cos.HdrLocation, redirectURL)
 	h.Set(cos.HdrContentType, "text/xml; charset=utf-8")
 	h.Set(cos.HdrServer, s3.AISServer)
+	corsActual(h, r.Header, r.Method, bck.Props) // (when reverse-proxying, the target does it)
 
 	var (
-		ep = extractEndpoint(redirectURL)
-		ll = max(256, 175+len(ep)+len(bucket)-27)
-		bb = bytes.NewBuffer(make([]byte, ll)) // TODO: consider using smm (small-size allocator) - here and elsewhere
+		bucket = bck.Name
+		ep     = extractEndpoint(redirectURL)
+		ll     = max(256, 175+len(ep)+len(bucket)-27)
+		bb     = bytes.NewBuffer(make([]byte, ll)) // TODO: consider using smm (small-size allocator) - here and elsewhere
 	)
 	bb.Reset()
 	bb.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>")
@@ -216,6 +216,9 @@ func (p *proxy) createBucket(msg *apc.ActMsg, bck *meta.Bck, remoteHdr http.Head
 		if bck.IsHT() {
 			return cmn.NewErrNotImpl("create", "bucket for HTTP provider")
 		}
+		if bck.IsFile() {
+			return cmn.NewErrNotImpl("create", bck.Provider+" bucket (directory must exist)")
+		}
 		// can do remote ais though
 		if !bck.IsRemoteAIS() {
 			return cmn.NewErrUnsupp("create", bck.Provider+":// bucket")
@@ -441,7 +444,9 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 	// NOTE: setting up IC listening prior to committing (and confirming xid) here and elsewhere
 	if ctx.needReMirror || ctx.needReEC {
 		action := apc.ActMakeNCopies
-		if ctx.needReEC {
+		if ctx.needReencode {
+			action = apc.ActECReencode
+		} else if ctx.needReEC {
 			action = apc.ActECEncode
 		}
 		nl := xact.NewXactNL(c.uuid, action, &c.smap.Smap, nil, bck.Bucket())
@@ -470,6 +475,7 @@ func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 	}
 	ctx.needReMirror = _reMirror(bprops, ctx.setProps)
 	targetCnt, ctx.needReEC = _reEC(bprops, ctx.setProps, bck, p.owner.smap.get())
+	ctx.needReencode = ctx.needReEC && bprops.EC.Enabled
 	debug.Assert(!ctx.needReEC || ctx.setProps.Validate(targetCnt) == nil)
 	clone.set(bck, ctx.setProps)
 	return nil
@@ -810,14 +816,15 @@ func (p *proxy) validateECConf(bck *meta.Bck, confToSet *cmn.ECConfToSet, currCo
 	if currConf.Enabled {
 		err := fmt.Errorf("%s: EC is already enabled on the bucket %s", p, bck.Cname(""))
 		if newConf.DataSlices != currConf.DataSlices || newConf.ParitySlices != currConf.ParitySlices {
-			// Changing data or parity slice count on the fly is unsupported
-			return err
+			// to change (D, P) of an erasure coded bucket, update bucket props - that will
+			// trigger ec-reencode
+			return fmt.Errorf("%v - to change (D, P), use set-bprops (and see %q)", err, apc.ActECReencode)
 		}
 		nlog.Warningf("%v: old %+v, new %+v", err, currConf, newConf)
 	}
 
 	smap := p.owner.smap.get()
-	numTs := smap.CountActiveTs()
+	numTs := smap.CountActiveDomains() // (same as the number of active targets when there are no failure domains)
 	return newConf.ValidateAsProps(numTs)
 }
 
@@ -1118,10 +1125,11 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 		}
 	}
 	if bprops.EC.Enabled && nprops.EC.Enabled {
-		sameSlices := bprops.EC.DataSlices == nprops.EC.DataSlices && bprops.EC.ParitySlices == nprops.EC.ParitySlices
-		sameLimit := bprops.EC.ObjSizeLimit == nprops.EC.ObjSizeLimit
-		if !sameSlices || (!sameLimit && !propsToUpdate.Force) {
-			err := fmt.Errorf("%s: once enabled, EC configuration can be only disabled but cannot change", p.si)
+		// changing (D, P) and/or profiles triggers online re-encoding (ec-reencode);
+		// changing the replicate-vs-encode size limit additionally requires force
+		if bprops.EC.ObjSizeLimit != nprops.EC.ObjSizeLimit && !propsToUpdate.Force {
+			err := fmt.Errorf("%s: changing EC objsize_limit of an erasure coded bucket %s requires force (re-encodes existing objects)",
+				p.si, bck.Cname(""))
 			return nil, err
 		}
 	} else if nprops.EC.Enabled {
@@ -12,8 +12,11 @@ import (
 const (
 	// AWS URL params
 	QparamVersioning        = "versioning"
+	QparamVersions          = "versions" // ListObjectVersions
 	QparamLifecycle         = "lifecycle"
 	QparamCORS              = "cors"
+	QparamNotification      = "notification"
+	QparamTagging           = "tagging"
 	QparamPolicy            = "policy"
 	QparamACL               = "acl"
 	QparamMultiDelete       = "delete"
@@ -0,0 +1,76 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket CORS configuration: S3 XML <=> cmn.CORSConf
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+
+const ErrCodeNoSuchCORS = "NoSuchCORSConfiguration"
+
+type (
+	CORSConfiguration struct {
+		XMLName xml.Name   `xml:"CORSConfiguration"`
+		Ns      string     `xml:"xmlns,attr,omitempty"`
+		Rules   []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+		ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+func NewCORSConfiguration(conf *cmn.CORSConf) *CORSConfiguration {
+	cc := &CORSConfiguration{Ns: s3Namespace, Rules: make([]CORSRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		rule := &conf.Rules[i]
+		cc.Rules = append(cc.Rules, CORSRule{
+			ID:             rule.ID,
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		})
+	}
+	return cc
+}
+
+func (cc *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(cc)
+	debug.AssertNoErr(err)
+}
+
+func (cc *CORSConfiguration) ToNative() (*cmn.CORSConf, error) {
+	if len(cc.Rules) == 0 {
+		return nil, errors.New("CORS configuration must contain at least one rule")
+	}
+	conf := &cmn.CORSConf{Rules: make([]cmn.CORSRule, 0, len(cc.Rules))}
+	for i := range cc.Rules {
+		in := &cc.Rules[i]
+		conf.Rules = append(conf.Rules, cmn.CORSRule{
+			ID:             in.ID,
+			AllowedOrigins: in.AllowedOrigins,
+			AllowedMethods: in.AllowedMethods,
+			AllowedHeaders: in.AllowedHeaders,
+			ExposeHeaders:  in.ExposeHeaders,
+			MaxAgeSeconds:  in.MaxAgeSeconds,
+		})
+	}
+	return conf, conf.ValidateAsProps()
+}
@@ -0,0 +1,83 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const corsXML = `<CORSConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <CORSRule>
+    <ID>web</ID>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>put</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>600</MaxAgeSeconds>
+  </CORSRule>
+  <CORSRule>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>HEAD</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+var _ = Describe("CORS", func() {
+	It("should convert S3 CORS configuration to native", func() {
+		cc := &s3.CORSConfiguration{}
+		Expect(xml.Unmarshal([]byte(corsXML), cc)).To(Succeed())
+
+		conf, err := cc.ToNative()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.Rules).To(HaveLen(2))
+		Expect(conf.Rules[0].ID).To(Equal("web"))
+		Expect(conf.Rules[0].AllowedMethods).To(Equal([]string{"GET", "PUT"}))
+		Expect(conf.Rules[0].MaxAgeSeconds).To(Equal(600))
+		Expect(conf.Rules[1].AnyOrigin()).To(BeTrue())
+
+		cc2 := s3.NewCORSConfiguration(conf)
+		b, err := xml.Marshal(cc2)
+		Expect(err).NotTo(HaveOccurred())
+		cc3 := &s3.CORSConfiguration{}
+		Expect(xml.Unmarshal(b, cc3)).To(Succeed())
+		Expect(cc3.Rules).To(Equal(cc2.Rules))
+	})
+
+	It("should reject invalid configurations", func() {
+		for _, in := range []string{
+			`<CORSConfiguration></CORSConfiguration>`,
+			`<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`,
+			`<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin></CORSRule></CORSConfiguration>`,
+			`<CORSConfiguration><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>PATCH</AllowedMethod></CORSRule></CORSConfiguration>`,
+			`<CORSConfiguration><CORSRule><AllowedOrigin>*.*.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>`,
+		} {
+			cc := &s3.CORSConfiguration{}
+			Expect(xml.Unmarshal([]byte(in), cc)).To(Succeed())
+			_, err := cc.ToNative()
+			Expect(err).To(HaveOccurred(), in)
+		}
+	})
+
+	It("should match origin, method, and request headers", func() {
+		conf := &cmn.CORSConf{Rules: []cmn.CORSRule{
+			{ID: "a", AllowedOrigins: []string{"https://*.example.com"}, AllowedMethods: []string{"GET", "PUT"}, AllowedHeaders: []string{"X-Amz-*"}},
+			{ID: "b", AllowedOrigins: []string{"*"}, AllowedMethods: []string{"HEAD"}},
+		}}
+		Expect(conf.ValidateAsProps()).To(Succeed())
+
+		Expect(conf.Match("https://www.example.com", "GET", nil).ID).To(Equal("a"))
+		Expect(conf.Match("https://www.example.com", "PUT", []string{"x-amz-meta-foo"}).ID).To(Equal("a"))
+		Expect(conf.Match("https://www.example.com", "PUT", []string{"authorization"})).To(BeNil())
+		Expect(conf.Match("http://www.example.com", "GET", nil)).To(BeNil())
+		Expect(conf.Match("http://other.org", "HEAD", nil).ID).To(Equal("b"))
+		Expect(conf.Match("http://other.org", "DELETE", nil)).To(BeNil())
+	})
+})
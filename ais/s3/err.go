@@ -65,6 +65,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case cmn.IsErrPrecondition(err):
+		out.Code = "PreconditionFailed"
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
@@ -92,3 +94,11 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		cmn.FreeHterr(in)
 	}
 }
+
+// with explicitly specified S3 error code, e.g. "NoSuchLifecycleConfiguration"
+func WriteErrCode(w http.ResponseWriter, r *http.Request, err error, ecode int, code string) {
+	in := cmn.InitErrHTTP(r, err, ecode)
+	in.TypeCode = code
+	WriteErr(w, r, in, 0)
+	cmn.FreeHterr(in)
+}
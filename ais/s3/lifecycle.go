@@ -0,0 +1,153 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket lifecycle configuration: S3 XML <=> cmn.LifecycleConf
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+// - supported: expiration in days, filter by prefix and/or tags
+// - not supported: transitions, expiration by date, noncurrent versions
+// - native-only ("evict") rules are not representable in S3 and are omitted
+
+const (
+	lcStatusEnabled  = "Enabled"
+	lcStatusDisabled = "Disabled"
+
+	ErrCodeNoSuchLifecycle = "NoSuchLifecycleConfiguration"
+)
+
+type (
+	LifecycleConfiguration struct {
+		XMLName xml.Name        `xml:"LifecycleConfiguration"`
+		Ns      string          `xml:"xmlns,attr,omitempty"`
+		Rules   []LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		ID         string               `xml:"ID,omitempty"`
+		Prefix     string               `xml:"Prefix,omitempty"` // legacy (deprecated) top-level prefix
+		Filter     *LifecycleFilter     `xml:"Filter,omitempty"`
+		Status     string               `xml:"Status"`
+		Expiration *LifecycleExpiration `xml:"Expiration,omitempty"`
+	}
+	LifecycleFilter struct {
+		Tag    *Tag          `xml:"Tag,omitempty"`
+		And    *LifecycleAnd `xml:"And,omitempty"`
+		Prefix string        `xml:"Prefix,omitempty"`
+	}
+	LifecycleAnd struct {
+		Prefix string `xml:"Prefix,omitempty"`
+		Tags   []Tag  `xml:"Tag"`
+	}
+	LifecycleExpiration struct {
+		Date string `xml:"Date,omitempty"`
+		Days int    `xml:"Days,omitempty"`
+	}
+)
+
+const day = 24 * time.Hour
+
+func NewLifecycleConfiguration(conf *cmn.LifecycleConf) *LifecycleConfiguration {
+	lc := &LifecycleConfiguration{Ns: s3Namespace, Rules: make([]LifecycleRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		rule := &conf.Rules[i]
+		if rule.Action == cmn.LcActEvict {
+			continue
+		}
+		out := LifecycleRule{
+			ID:         rule.ID,
+			Status:     lcStatusEnabled,
+			Filter:     &LifecycleFilter{},
+			Expiration: &LifecycleExpiration{Days: max(int(cos.DivRoundU64(uint64(rule.Age), uint64(day))), 1)},
+		}
+		if rule.Disabled || !conf.Enabled {
+			out.Status = lcStatusDisabled
+		}
+		switch {
+		case len(rule.Tags) == 0:
+			out.Filter.Prefix = rule.Prefix
+		case len(rule.Tags) == 1 && rule.Prefix == "":
+			for k, v := range rule.Tags {
+				out.Filter.Tag = &Tag{Key: k, Value: v}
+			}
+		default:
+			and := &LifecycleAnd{Prefix: rule.Prefix, Tags: make([]Tag, 0, len(rule.Tags))}
+			for k, v := range rule.Tags {
+				and.Tags = append(and.Tags, Tag{Key: k, Value: v})
+			}
+			out.Filter.And = and
+		}
+		lc.Rules = append(lc.Rules, out)
+	}
+	return lc
+}
+
+func (lc *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(lc)
+	debug.AssertNoErr(err)
+}
+
+// convert to native; the resulting lifecycle is enabled if at least one rule is
+func (lc *LifecycleConfiguration) ToNative() (*cmn.LifecycleConf, error) {
+	if len(lc.Rules) == 0 {
+		return nil, errors.New("lifecycle configuration must contain at least one rule")
+	}
+	conf := &cmn.LifecycleConf{Rules: make([]cmn.LifecycleRule, 0, len(lc.Rules))}
+	for i := range lc.Rules {
+		in := &lc.Rules[i]
+		rule := cmn.LifecycleRule{ID: in.ID, Prefix: in.Prefix, Action: cmn.LcActDelete}
+		if rule.ID == "" {
+			rule.ID = "rule-" + strconv.Itoa(i+1)
+		}
+		switch in.Status {
+		case lcStatusEnabled:
+			conf.Enabled = true
+		case lcStatusDisabled:
+			rule.Disabled = true
+		default:
+			return nil, fmt.Errorf("lifecycle rule %q: invalid status %q", rule.ID, in.Status)
+		}
+		if in.Expiration == nil || in.Expiration.Days <= 0 {
+			if in.Expiration != nil && in.Expiration.Date != "" {
+				return nil, cmn.NewErrUnsupp("set lifecycle", "expiration by date (rule "+rule.ID+")")
+			}
+			return nil, fmt.Errorf("lifecycle rule %q: expecting expiration in days", rule.ID)
+		}
+		rule.Age = cos.Duration(time.Duration(in.Expiration.Days) * day)
+		if f := in.Filter; f != nil {
+			if f.Prefix != "" {
+				rule.Prefix = f.Prefix
+			}
+			if f.Tag != nil {
+				rule.Tags = cos.StrKVs{f.Tag.Key: f.Tag.Value}
+			}
+			if f.And != nil {
+				if f.And.Prefix != "" {
+					rule.Prefix = f.And.Prefix
+				}
+				if len(f.And.Tags) > 0 {
+					rule.Tags = make(cos.StrKVs, len(f.And.Tags))
+					for _, tag := range f.And.Tags {
+						rule.Tags[tag.Key] = tag.Value
+					}
+				}
+			}
+		}
+		conf.Rules = append(conf.Rules, rule)
+	}
+	return conf, conf.ValidateAsProps()
+}
@@ -0,0 +1,106 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const lcXML = `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Rule>
+    <ID>tmp</ID>
+    <Filter><Prefix>tmp/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>3</Days></Expiration>
+  </Rule>
+  <Rule>
+    <ID>scratch</ID>
+    <Filter><And><Prefix>train/</Prefix><Tag><Key>split</Key><Value>scratch</Value></Tag></And></Filter>
+    <Status>Disabled</Status>
+    <Expiration><Days>1</Days></Expiration>
+  </Rule>
+</LifecycleConfiguration>`
+
+var _ = Describe("Lifecycle", func() {
+	It("should convert S3 lifecycle configuration to native", func() {
+		lc := &s3.LifecycleConfiguration{}
+		Expect(xml.Unmarshal([]byte(lcXML), lc)).To(Succeed())
+
+		conf, err := lc.ToNative()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.Enabled).To(BeTrue())
+		Expect(conf.Rules).To(HaveLen(2))
+
+		Expect(conf.Rules[0].ID).To(Equal("tmp"))
+		Expect(conf.Rules[0].Prefix).To(Equal("tmp/"))
+		Expect(conf.Rules[0].Action).To(Equal(cmn.LcActDelete))
+		Expect(conf.Rules[0].Age.D()).To(Equal(72 * time.Hour))
+
+		Expect(conf.Rules[1].Disabled).To(BeTrue())
+		Expect(conf.Rules[1].Prefix).To(Equal("train/"))
+		Expect(conf.Rules[1].Tags).To(Equal(cos.StrKVs{"split": "scratch"}))
+	})
+
+	It("should convert native lifecycle to S3 and back", func() {
+		conf := &cmn.LifecycleConf{
+			Enabled: true,
+			Rules: []cmn.LifecycleRule{
+				{ID: "a", Prefix: "logs/", Age: cos.Duration(48 * time.Hour)},
+				{ID: "b", Tags: cos.StrKVs{"k": "v"}, Age: cos.Duration(24 * time.Hour)},
+				{ID: "c", Action: cmn.LcActEvict, Age: cos.Duration(time.Hour)},
+			},
+		}
+		lc := s3.NewLifecycleConfiguration(conf)
+		Expect(lc.Rules).To(HaveLen(2)) // evict omitted
+		Expect(lc.Rules[0].Expiration.Days).To(Equal(2))
+		Expect(lc.Rules[1].Filter.Tag.Key).To(Equal("k"))
+
+		b, err := xml.Marshal(lc)
+		Expect(err).NotTo(HaveOccurred())
+		lc2 := &s3.LifecycleConfiguration{}
+		Expect(xml.Unmarshal(b, lc2)).To(Succeed())
+		conf2, err := lc2.ToNative()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf2.Rules[0].Prefix).To(Equal("logs/"))
+		Expect(conf2.Rules[1].Tags).To(Equal(cos.StrKVs{"k": "v"}))
+	})
+
+	It("should reject invalid configurations", func() {
+		for _, in := range []string{
+			`<LifecycleConfiguration></LifecycleConfiguration>`,
+			`<LifecycleConfiguration><Rule><ID>x</ID><Status>Enabled</Status></Rule></LifecycleConfiguration>`,
+			`<LifecycleConfiguration><Rule><ID>x</ID><Status>On</Status><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`,
+			`<LifecycleConfiguration><Rule><ID>x</ID><Status>Enabled</Status><Expiration><Date>2030-01-01T00:00:00Z</Date></Expiration></Rule></LifecycleConfiguration>`,
+		} {
+			lc := &s3.LifecycleConfiguration{}
+			Expect(xml.Unmarshal([]byte(in), lc)).To(Succeed())
+			_, err := lc.ToNative()
+			Expect(err).To(HaveOccurred(), in)
+		}
+	})
+})
+
+var _ = Describe("LifecycleRule", func() {
+	It("should match by age, prefix, and tags", func() {
+		rule := &cmn.LifecycleRule{ID: "r", Prefix: "a/", Tags: cos.StrKVs{"k": "v"}, Age: cos.Duration(time.Hour)}
+		md := cos.StrKVs{"k": "v"}
+		getTag := func(k string) (string, bool) { v, ok := md[k]; return v, ok }
+
+		Expect(rule.Match("a/obj", int64(2*time.Hour), getTag)).To(BeTrue())
+		Expect(rule.Match("a/obj", int64(time.Minute), getTag)).To(BeFalse())
+		Expect(rule.Match("b/obj", int64(2*time.Hour), getTag)).To(BeFalse())
+
+		md["k"] = "other"
+		Expect(rule.Match("a/obj", int64(2*time.Hour), getTag)).To(BeFalse())
+	})
+})
@@ -63,18 +63,64 @@ func InitUpload(id, bckName, objName string, metadata map[string]string) {
 // Add part to an active upload.
 // Some clients may omit size and md5. Only partNum is must-have.
 // md5 and fqn is filled by a target after successful saving the data to a workfile.
+// Uploading the same part number again replaces the previous one (and removes its workfile).
 func AddPart(id string, npart *MptPart) (err error) {
+	var prev *MptPart
 	mu.Lock()
 	mpt, ok := ups[id]
 	if !ok {
 		err = fmt.Errorf("upload %q not found (%s, %d)", id, npart.FQN, npart.Num)
+	} else if i := mpt.partIdx(npart.Num); i >= 0 {
+		prev = mpt.parts[i]
+		mpt.parts[i] = npart
 	} else {
 		mpt.parts = append(mpt.parts, npart)
 	}
 	mu.Unlock()
+
+	if prev != nil && prev.FQN != "" && prev.FQN != npart.FQN {
+		if nerr := cos.RemoveFile(prev.FQN); nerr != nil {
+			nlog.Errorln("failed to remove replaced part [", prev.FQN, id, nerr, "]")
+		}
+	}
 	return
 }
 
+// (native API) upload must exist and belong to the specified object
+func CheckUpload(id, bckName, objName string) (err error) {
+	mu.RLock()
+	_, err = getUpload(id, bckName, objName)
+	mu.RUnlock()
+	return err
+}
+
+// (native API) parts of the active upload sorted by part number;
+// given non-empty `nums`, returns the corresponding subset (and fails if any is missing)
+func GetParts(id, bckName, objName string, nums []int32) ([]*MptPart, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	mpt, err := getUpload(id, bckName, objName)
+	if err != nil {
+		return nil, err
+	}
+	if len(nums) == 0 {
+		nparts := make([]*MptPart, len(mpt.parts))
+		copy(nparts, mpt.parts)
+		sort.Slice(nparts, func(i, j int) bool { return nparts[i].Num < nparts[j].Num })
+		return nparts, nil
+	}
+	nparts := make([]*MptPart, 0, len(nums))
+	for _, num := range nums {
+		part := mpt.getPart(num)
+		if part == nil {
+			return nil, fmt.Errorf("upload %q: part %d not found", id, num)
+		}
+		nparts = append(nparts, part)
+	}
+	sort.Slice(nparts, func(i, j int) bool { return nparts[i].Num < nparts[j].Num })
+	return nparts, nil
+}
+
 // TODO: compare non-zero sizes (note: s3cmd sends 0) and part.ETag as well, if specified
 func CheckParts(id string, parts []types.CompletedPart) ([]*MptPart, error) {
 	mu.RLock()
@@ -101,6 +147,15 @@ func CheckParts(id string, parts []types.CompletedPart) ([]*MptPart, error) {
 	return nparts, nil
 }
 
+// is called under lock
+func getUpload(id, bckName, objName string) (*mpt, error) {
+	mpt, ok := ups[id]
+	if !ok || mpt.bckName != bckName || mpt.objName != objName {
+		return nil, cos.NewErrNotFound(core.T, "upload "+id+" ("+bckName+"/"+objName+")")
+	}
+	return mpt, nil
+}
+
 func ParsePartNum(s string) (int32, error) {
 	partNum, err := strconv.ParseInt(s, 10, 32)
 	if err != nil {
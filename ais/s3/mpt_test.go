@@ -0,0 +1,129 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Multipart upload", func() {
+	const (
+		bckName = "mpt-bck"
+		objName = "mpt-obj"
+	)
+	var dir string
+
+	BeforeEach(func() {
+		cos.InitShortID(0)
+		dir = GinkgoT().TempDir()
+	})
+
+	// part workfile
+	addPart := func(id string, num int32, size int64) *s3.MptPart {
+		fqn := filepath.Join(dir, id+"."+strconv.Itoa(int(num))+"."+cos.GenTie())
+		Expect(os.WriteFile(fqn, make([]byte, size), 0o644)).To(Succeed())
+		part := &s3.MptPart{Num: num, Size: size, MD5: cos.GenTie(), FQN: fqn}
+		Expect(s3.AddPart(id, part)).To(Succeed())
+		return part
+	}
+	nums := func(parts []*s3.MptPart) []int32 {
+		out := make([]int32, 0, len(parts))
+		for _, part := range parts {
+			out = append(out, part.Num)
+		}
+		return out
+	}
+
+	It("should replace re-uploaded part", func() {
+		id := cos.GenUUID()
+		s3.InitUpload(id, bckName, objName, nil)
+		defer s3.CleanupUpload(id, "", true)
+
+		addPart(id, 3, 30)
+		first := addPart(id, 1, 10)
+		addPart(id, 2, 20)
+		second := addPart(id, 1, 15) // (e.g., upon client retry)
+
+		parts, err := s3.GetParts(id, bckName, objName, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nums(parts)).To(Equal([]int32{1, 2, 3}))
+		Expect(parts[0]).To(Equal(second))
+		Expect(first.FQN).NotTo(BeAnExistingFile())
+		Expect(second.FQN).To(BeAnExistingFile())
+
+		size, err := s3.ObjSize(id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(size).To(Equal(int64(15 + 20 + 30)))
+	})
+
+	It("should check upload ownership and parts to complete", func() {
+		id := cos.GenUUID()
+		s3.InitUpload(id, bckName, objName, nil)
+		defer s3.CleanupUpload(id, "", true)
+		addPart(id, 1, 10)
+		addPart(id, 2, 10)
+		addPart(id, 4, 10)
+
+		Expect(s3.CheckUpload(id, bckName, objName)).To(Succeed())
+		Expect(s3.CheckUpload(id, bckName, "other-obj")).NotTo(Succeed())
+		Expect(s3.CheckUpload(id, "other-bck", objName)).NotTo(Succeed())
+		Expect(s3.CheckUpload(cos.GenUUID(), bckName, objName)).NotTo(Succeed())
+		Expect(s3.AddPart(cos.GenUUID(), &s3.MptPart{Num: 1})).NotTo(Succeed())
+
+		// subset, in any order
+		parts, err := s3.GetParts(id, bckName, objName, []int32{4, 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nums(parts)).To(Equal([]int32{1, 4}))
+
+		// missing part
+		_, err = s3.GetParts(id, bckName, objName, []int32{1, 3})
+		Expect(err).To(HaveOccurred())
+		_, err = s3.GetParts(id, bckName, "other-obj", nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should remove parts upon completion", func() {
+		id := cos.GenUUID()
+		s3.InitUpload(id, bckName, objName, map[string]string{"k": "v"})
+		parts := []*s3.MptPart{addPart(id, 1, 10), addPart(id, 2, 10)}
+		Expect(s3.GetUploadMetadata(id)).To(HaveKeyWithValue("k", "v"))
+
+		fqn := filepath.Join(dir, objName)
+		Expect(os.WriteFile(fqn, make([]byte, 20), 0o644)).To(Succeed())
+		Expect(s3.CleanupUpload(id, fqn, false /*aborted*/)).To(BeTrue())
+
+		for _, part := range parts {
+			Expect(part.FQN).NotTo(BeAnExistingFile())
+		}
+		Expect(fqn).To(BeAnExistingFile())
+		Expect(s3.CheckUpload(id, bckName, objName)).NotTo(Succeed())
+		Expect(s3.CleanupUpload(id, fqn, false)).To(BeFalse()) // (completed once)
+	})
+
+	It("should remove parts upon abort", func() {
+		id := cos.GenUUID()
+		s3.InitUpload(id, bckName, objName, nil)
+		parts := []*s3.MptPart{addPart(id, 1, 10), addPart(id, 2, 10), addPart(id, 2, 5)}
+
+		Expect(parts[1].FQN).NotTo(BeAnExistingFile()) // replaced
+
+		Expect(s3.CleanupUpload(id, "", true /*aborted*/)).To(BeTrue())
+		for _, part := range parts {
+			Expect(part.FQN).NotTo(BeAnExistingFile())
+		}
+
+		_, err := s3.ObjSize(id)
+		Expect(err).To(HaveOccurred())
+		Expect(s3.AddPart(id, &s3.MptPart{Num: 3})).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,195 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket notification configuration: S3 XML <=> cmn.EventsConf
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketNotificationConfiguration.html
+// - topic, queue, and cloud-function configurations are treated the same way:
+//   the destination must be either an HTTP(S) URL (webhook) or `NotifLogARN` (target-local event log)
+// - filter: key name prefix and/or suffix
+// - events: see `s3Events` below; AIS-specific rename and evict events use "ais:" prefix
+// - PUT with no configurations removes (all) event notifications
+
+const NotifLogARN = "arn:ais:log"
+
+const (
+	fltPrefix = "prefix"
+	fltSuffix = "suffix"
+)
+
+type (
+	NotificationConfiguration struct {
+		XMLName   xml.Name             `xml:"NotificationConfiguration"`
+		Ns        string               `xml:"xmlns,attr,omitempty"`
+		Topics    []NotificationTarget `xml:"TopicConfiguration"`
+		Queues    []NotificationTarget `xml:"QueueConfiguration"`
+		Functions []NotificationTarget `xml:"CloudFunctionConfiguration"`
+	}
+	NotificationTarget struct {
+		ID       string              `xml:"Id,omitempty"`
+		Topic    string              `xml:"Topic,omitempty"`
+		Queue    string              `xml:"Queue,omitempty"`
+		Function string              `xml:"CloudFunction,omitempty"`
+		Filter   *NotificationFilter `xml:"Filter,omitempty"`
+		Events   []string            `xml:"Event"`
+	}
+	NotificationFilter struct {
+		S3Key struct {
+			Rules []FilterRule `xml:"FilterRule"`
+		} `xml:"S3Key"`
+	}
+	FilterRule struct {
+		Name  string `xml:"Name"`
+		Value string `xml:"Value"`
+	}
+)
+
+// S3 event type => native; the first S3 event listed for a given native type is the one
+// used to convert native => S3
+var s3Events = []struct {
+	s3, native string
+}{
+	{"s3:ObjectCreated:*", cmn.EvObjCreated},
+	{"s3:ObjectCreated:Put", cmn.EvObjCreated},
+	{"s3:ObjectCreated:Post", cmn.EvObjCreated},
+	{"s3:ObjectCreated:Copy", cmn.EvObjCreated},
+	{"s3:ObjectCreated:CompleteMultipartUpload", cmn.EvObjCreated},
+	{"s3:ObjectRemoved:*", cmn.EvObjDeleted},
+	{"s3:ObjectRemoved:Delete", cmn.EvObjDeleted},
+	{"s3:ObjectRestore:Completed", cmn.EvObjColdGet},
+	{"s3:ObjectRestore:*", cmn.EvObjColdGet},
+	{"ais:ObjectRenamed", cmn.EvObjRenamed},
+	{"ais:ObjectEvicted", cmn.EvObjEvicted},
+}
+
+func NewNotificationConfiguration(conf *cmn.EventsConf) *NotificationConfiguration {
+	nc := &NotificationConfiguration{Ns: s3Namespace}
+	if !conf.Enabled {
+		return nc
+	}
+	for i := range conf.Rules {
+		rule := &conf.Rules[i]
+		out := NotificationTarget{ID: rule.ID, Events: make([]string, 0, len(rule.Events))}
+		for _, ev := range rule.Events {
+			for _, e := range s3Events {
+				if e.native == ev {
+					out.Events = append(out.Events, e.s3)
+					break
+				}
+			}
+		}
+		if rule.Prefix != "" || rule.Suffix != "" {
+			out.Filter = &NotificationFilter{}
+			if rule.Prefix != "" {
+				out.Filter.S3Key.Rules = append(out.Filter.S3Key.Rules, FilterRule{Name: fltPrefix, Value: rule.Prefix})
+			}
+			if rule.Suffix != "" {
+				out.Filter.S3Key.Rules = append(out.Filter.S3Key.Rules, FilterRule{Name: fltSuffix, Value: rule.Suffix})
+			}
+		}
+		if rule.Webhook != "" {
+			out.Topic = rule.Webhook
+			nc.Topics = append(nc.Topics, out)
+		}
+		if rule.Log {
+			if rule.Webhook != "" {
+				out.ID = rule.ID + "-log" // (one S3 configuration per destination)
+			}
+			out.Topic = NotifLogARN
+			nc.Topics = append(nc.Topics, out)
+		}
+	}
+	return nc
+}
+
+func (nc *NotificationConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(nc)
+	debug.AssertNoErr(err)
+}
+
+// convert to native; empty configuration (no rules) disables event notifications
+func (nc *NotificationConfiguration) ToNative() (*cmn.EventsConf, error) {
+	all := make([]*NotificationTarget, 0, len(nc.Topics)+len(nc.Queues)+len(nc.Functions))
+	for i := range nc.Topics {
+		all = append(all, &nc.Topics[i])
+	}
+	for i := range nc.Queues {
+		all = append(all, &nc.Queues[i])
+	}
+	for i := range nc.Functions {
+		all = append(all, &nc.Functions[i])
+	}
+	conf := &cmn.EventsConf{Rules: make([]cmn.EventRule, 0, len(all)), Enabled: len(all) > 0}
+	for i, in := range all {
+		rule := cmn.EventRule{ID: in.ID}
+		if rule.ID == "" {
+			rule.ID = "notification-" + strconv.Itoa(i+1)
+		}
+
+		// destination
+		dst := in.Topic + in.Queue + in.Function
+		switch {
+		case dst == NotifLogARN:
+			rule.Log = true
+		case strings.HasPrefix(dst, "http://") || strings.HasPrefix(dst, "https://"):
+			rule.Webhook = dst
+		default:
+			return nil, fmt.Errorf("notification %q: unsupported destination %q (expecting HTTP(S) URL or %q)",
+				rule.ID, dst, NotifLogARN)
+		}
+
+		// events
+		for _, ev := range in.Events {
+			native, err := eventToNative(ev)
+			if err != nil {
+				return nil, fmt.Errorf("notification %q: %v", rule.ID, err)
+			}
+			if !cos.StringInSlice(native, rule.Events) {
+				rule.Events = append(rule.Events, native)
+			}
+		}
+
+		// filter
+		if in.Filter != nil {
+			for _, flt := range in.Filter.S3Key.Rules {
+				switch strings.ToLower(flt.Name) {
+				case fltPrefix:
+					rule.Prefix = flt.Value
+				case fltSuffix:
+					rule.Suffix = flt.Value
+				default:
+					return nil, fmt.Errorf("notification %q: invalid filter rule name %q", rule.ID, flt.Name)
+				}
+			}
+		}
+		conf.Rules = append(conf.Rules, rule)
+	}
+	if err := conf.ValidateAsProps(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func eventToNative(ev string) (string, error) {
+	for _, e := range s3Events {
+		if e.s3 == ev {
+			return e.native, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported event type %q", ev)
+}
@@ -0,0 +1,108 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const ncXML = `<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <TopicConfiguration>
+    <Id>images</Id>
+    <Topic>https://indexer.example.com/events</Topic>
+    <Event>s3:ObjectCreated:Put</Event>
+    <Event>s3:ObjectCreated:Copy</Event>
+    <Event>s3:ObjectRemoved:*</Event>
+    <Filter><S3Key>
+      <FilterRule><Name>prefix</Name><Value>img/</Value></FilterRule>
+      <FilterRule><Name>Suffix</Name><Value>.jpg</Value></FilterRule>
+    </S3Key></Filter>
+  </TopicConfiguration>
+  <QueueConfiguration>
+    <Queue>arn:ais:log</Queue>
+    <Event>ais:ObjectEvicted</Event>
+    <Event>s3:ObjectRestore:Completed</Event>
+  </QueueConfiguration>
+</NotificationConfiguration>`
+
+var _ = Describe("Notification", func() {
+	It("should convert S3 notification configuration to native", func() {
+		nc := &s3.NotificationConfiguration{}
+		Expect(xml.Unmarshal([]byte(ncXML), nc)).To(Succeed())
+
+		conf, err := nc.ToNative()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.Enabled).To(BeTrue())
+		Expect(conf.Rules).To(HaveLen(2))
+
+		Expect(conf.Rules[0].ID).To(Equal("images"))
+		Expect(conf.Rules[0].Webhook).To(Equal("https://indexer.example.com/events"))
+		Expect(conf.Rules[0].Events).To(Equal([]string{cmn.EvObjCreated, cmn.EvObjDeleted}))
+		Expect(conf.Rules[0].Prefix).To(Equal("img/"))
+		Expect(conf.Rules[0].Suffix).To(Equal(".jpg"))
+
+		Expect(conf.Rules[1].ID).To(Equal("notification-2"))
+		Expect(conf.Rules[1].Log).To(BeTrue())
+		Expect(conf.Rules[1].Events).To(Equal([]string{cmn.EvObjEvicted, cmn.EvObjColdGet}))
+	})
+
+	It("should convert native configuration to S3 and back", func() {
+		conf := &cmn.EventsConf{
+			Enabled: true,
+			Rules: []cmn.EventRule{
+				{ID: "a", Events: cmn.EvObjAll, Prefix: "logs/", Webhook: "http://localhost:9999", Log: true},
+			},
+		}
+		nc := s3.NewNotificationConfiguration(conf)
+		Expect(nc.Topics).To(HaveLen(2)) // one per destination
+		Expect(nc.Topics[1].ID).To(Equal("a-log"))
+		Expect(nc.Topics[1].Topic).To(Equal(s3.NotifLogARN))
+
+		b, err := xml.Marshal(nc)
+		Expect(err).NotTo(HaveOccurred())
+		nc2 := &s3.NotificationConfiguration{}
+		Expect(xml.Unmarshal(b, nc2)).To(Succeed())
+		conf2, err := nc2.ToNative()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf2.Rules).To(HaveLen(2))
+		Expect(conf2.Rules[0].Webhook).To(Equal("http://localhost:9999"))
+		Expect(conf2.Rules[0].Events).To(ConsistOf(cmn.EvObjAll))
+		Expect(conf2.Rules[1].Log).To(BeTrue())
+		Expect(conf2.Rules[1].Prefix).To(Equal("logs/"))
+
+		// disabled => empty
+		conf.Enabled = false
+		Expect(s3.NewNotificationConfiguration(conf).Topics).To(BeEmpty())
+	})
+
+	It("should treat empty configuration as removal", func() {
+		nc := &s3.NotificationConfiguration{}
+		Expect(xml.Unmarshal([]byte(`<NotificationConfiguration/>`), nc)).To(Succeed())
+		conf, err := nc.ToNative()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.Enabled).To(BeFalse())
+		Expect(conf.Rules).To(BeEmpty())
+	})
+
+	It("should reject invalid configurations", func() {
+		for _, in := range []string{
+			`<NotificationConfiguration><TopicConfiguration><Topic>arn:aws:sns:us-east-1:123:topic</Topic><Event>s3:ObjectCreated:*</Event></TopicConfiguration></NotificationConfiguration>`,
+			`<NotificationConfiguration><TopicConfiguration><Topic>http://h</Topic><Event>s3:ReducedRedundancyLostObject</Event></TopicConfiguration></NotificationConfiguration>`,
+			`<NotificationConfiguration><TopicConfiguration><Topic>http://h</Topic></TopicConfiguration></NotificationConfiguration>`,
+			`<NotificationConfiguration><TopicConfiguration><Topic>http://h</Topic><Event>s3:ObjectCreated:*</Event><Filter><S3Key><FilterRule><Name>regex</Name><Value>x</Value></FilterRule></S3Key></Filter></TopicConfiguration></NotificationConfiguration>`,
+		} {
+			nc := &s3.NotificationConfiguration{}
+			Expect(xml.Unmarshal([]byte(in), nc)).To(Succeed())
+			_, err := nc.ToNative()
+			Expect(err).To(HaveOccurred(), in)
+		}
+	})
+})
@@ -0,0 +1,303 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Native AWS Signature Version 4 (SigV4) verification:
+// - header-based: `Authorization: AWS4-HMAC-SHA256 Credential=..., SignedHeaders=..., Signature=...`
+// - presigned (query string): X-Amz-Algorithm, X-Amz-Credential, X-Amz-Date, X-Amz-Expires, ...
+// - the payload itself is not hashed: the signature covers `x-amz-content-sha256` as provided by the client
+// - see https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
+
+const (
+	sigv4Terminator    = "aws4_request"
+	sigv4TimeFormat    = "20060102T150405Z"
+	sigv4DateFormat    = "20060102"
+	sigv4UnsignedBody  = "UNSIGNED-PAYLOAD"
+	sigv4MaxSkew       = 15 * time.Minute
+	sigv4MaxExpiration = 7 * 24 * time.Hour
+
+	hdrAmzDate = "X-Amz-Date"
+
+	qparamAlgorithm     = "X-Amz-Algorithm"
+	qparamDate          = "X-Amz-Date"
+	qparamExpires       = "X-Amz-Expires"
+	qparamSignedHeaders = "X-Amz-SignedHeaders"
+	qparamSignature     = "X-Amz-Signature"
+)
+
+// S3 error codes
+const (
+	ErrCodeInvalidAccessKeyID = "InvalidAccessKeyId"
+	ErrCodeSignatureMismatch  = "SignatureDoesNotMatch"
+	ErrCodeAuthMalformed      = "AuthorizationHeaderMalformed"
+	ErrCodeTimeTooSkewed      = "RequestTimeTooSkewed"
+	ErrCodeAccessDenied       = "AccessDenied"
+)
+
+type (
+	SigV4 struct {
+		Date          time.Time     // X-Amz-Date
+		AccessKeyID   string        // (the part of credential that precedes the scope)
+		Scope         string        // <yyyymmdd>/<region>/<service>/aws4_request
+		Signature     string        // hex
+		SignedHeaders []string      // lowercase, sorted
+		Expires       time.Duration // presigned only
+		presigned     bool
+	}
+	ErrSigV4 struct {
+		Code   string // S3 error code
+		msg    string
+		Status int
+	}
+)
+
+func IsSigV4(r *http.Request) bool {
+	if strings.HasPrefix(r.Header.Get(apc.HdrAuthorization), signatureV4) {
+		return true
+	}
+	return strings.Contains(r.URL.RawQuery, qparamAlgorithm) && r.URL.Query().Get(qparamAlgorithm) == signatureV4
+}
+
+func ParseSigV4(r *http.Request) (*SigV4, error) {
+	var (
+		sig    = &SigV4{}
+		cred   string
+		signed string
+		date   string
+	)
+	if auth := r.Header.Get(apc.HdrAuthorization); strings.HasPrefix(auth, signatureV4) {
+		for _, kv := range strings.Split(strings.TrimPrefix(auth, signatureV4), ",") {
+			k, v, ok := strings.Cut(strings.TrimSpace(kv), "=")
+			if !ok {
+				return nil, errMalformed("invalid Authorization header component %q", kv)
+			}
+			switch k {
+			case "Credential":
+				cred = v
+			case "SignedHeaders":
+				signed = v
+			case "Signature":
+				sig.Signature = v
+			}
+		}
+		date = r.Header.Get(hdrAmzDate)
+	} else {
+		q := r.URL.Query()
+		if q.Get(qparamAlgorithm) != signatureV4 {
+			return nil, errMalformed("expecting %s=%s", qparamAlgorithm, signatureV4)
+		}
+		sig.presigned = true
+		cred, signed, date = q.Get(HeaderCredentials), q.Get(qparamSignedHeaders), q.Get(qparamDate)
+		sig.Signature = q.Get(qparamSignature)
+		secs, err := strconv.ParseInt(q.Get(qparamExpires), 10, 64)
+		if err != nil || secs <= 0 {
+			return nil, errMalformed("invalid %s %q", qparamExpires, q.Get(qparamExpires))
+		}
+		sig.Expires = time.Duration(secs) * time.Second
+		if sig.Expires > sigv4MaxExpiration {
+			return nil, errMalformed("%s must be less than a week (%v)", qparamExpires, sigv4MaxExpiration)
+		}
+	}
+	if cred == "" || signed == "" || sig.Signature == "" || date == "" {
+		return nil, errMalformed("missing credential, signed headers, signature, or date")
+	}
+
+	// credential: <access-key-id>/<yyyymmdd>/<region>/<service>/aws4_request
+	parts := strings.Split(cred, "/")
+	l := len(parts)
+	if l < 5 || parts[l-1] != sigv4Terminator {
+		return nil, errMalformed("invalid credential %q", cred)
+	}
+	sig.AccessKeyID = strings.Join(parts[:l-4], "/")
+	sig.Scope = strings.Join(parts[l-4:], "/")
+
+	tm, err := time.Parse(sigv4TimeFormat, date)
+	if err != nil {
+		return nil, errMalformed("invalid date %q", date)
+	}
+	sig.Date = tm
+	if parts[l-4] != tm.Format(sigv4DateFormat) {
+		return nil, errMalformed("credential scope date %q does not match %q", parts[l-4], date)
+	}
+
+	sig.SignedHeaders = strings.Split(signed, ";")
+	if !sort.StringsAreSorted(sig.SignedHeaders) || !cos.StringInSlice("host", sig.SignedHeaders) {
+		return nil, errMalformed("invalid signed headers %q", signed)
+	}
+	return sig, nil
+}
+
+// verify the signature using the secret access key that corresponds to `sig.AccessKeyID`
+func (sig *SigV4) Verify(r *http.Request, secretKey string, now time.Time) error {
+	if sig.presigned {
+		if now.After(sig.Date.Add(sig.Expires)) {
+			return &ErrSigV4{Code: ErrCodeAccessDenied, Status: http.StatusForbidden, msg: "request has expired"}
+		}
+		if sig.Date.Sub(now) > sigv4MaxSkew {
+			return errSkewed(sig.Date, now)
+		}
+	} else if d := now.Sub(sig.Date); d > sigv4MaxSkew || d < -sigv4MaxSkew {
+		return errSkewed(sig.Date, now)
+	}
+
+	payloadHash := sigv4UnsignedBody
+	if !sig.presigned {
+		if payloadHash = r.Header.Get(cos.S3HdrContentSHA256); payloadHash == "" {
+			return errMalformed("missing %s header", cos.S3HdrContentSHA256)
+		}
+	}
+
+	var (
+		creq = sig.canonicalRequest(r, payloadHash)
+		hash = sha256.Sum256(cos.UnsafeB(creq))
+		sts  = signatureV4 + "\n" + sig.Date.Format(sigv4TimeFormat) + "\n" + sig.Scope + "\n" + hex.EncodeToString(hash[:])
+	)
+	// signing key: HMAC chain over the scope
+	key := []byte("AWS4" + secretKey)
+	for _, s := range strings.Split(sig.Scope, "/") {
+		key = hmacSHA256(key, s)
+	}
+	expected := hex.EncodeToString(hmacSHA256(key, sts))
+	if !hmac.Equal(cos.UnsafeB(expected), cos.UnsafeB(sig.Signature)) {
+		return &ErrSigV4{
+			Code:   ErrCodeSignatureMismatch,
+			Status: http.StatusForbidden,
+			msg:    "the request signature does not match the signature computed with the provided access key",
+		}
+	}
+	return nil
+}
+
+func (sig *SigV4) canonicalRequest(r *http.Request, payloadHash string) string {
+	var sb strings.Builder
+	sb.Grow(256)
+	sb.WriteString(r.Method)
+	sb.WriteByte('\n')
+	sb.WriteString(sigv4EncodePath(r.URL.Path))
+	sb.WriteByte('\n')
+
+	// query: sorted by key, then by value; excluding the signature itself
+	q := r.URL.Query()
+	q.Del(qparamSignature)
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var n int
+	for _, k := range keys {
+		vals := q[k]
+		sort.Strings(vals)
+		for _, v := range vals {
+			if n > 0 {
+				sb.WriteByte('&')
+			}
+			sb.WriteString(sigv4Encode(k, true))
+			sb.WriteByte('=')
+			sb.WriteString(sigv4Encode(v, true))
+			n++
+		}
+	}
+	sb.WriteByte('\n')
+
+	for _, h := range sig.SignedHeaders {
+		sb.WriteString(h)
+		sb.WriteByte(':')
+		switch h {
+		case "host":
+			sb.WriteString(r.Host)
+		case "content-length":
+			sb.WriteString(strconv.FormatInt(r.ContentLength, 10))
+		default:
+			vals := r.Header.Values(h)
+			for i, v := range vals {
+				if i > 0 {
+					sb.WriteByte(',')
+				}
+				sb.WriteString(strings.Join(strings.Fields(v), " "))
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte('\n')
+	sb.WriteString(strings.Join(sig.SignedHeaders, ";"))
+	sb.WriteByte('\n')
+	sb.WriteString(payloadHash)
+	return sb.String()
+}
+
+//
+// helpers
+//
+
+// URI-encode each path segment (S3 does not double-encode)
+func sigv4EncodePath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return sigv4Encode(path, false)
+}
+
+// AWS flavor of URI encoding: all bytes except unreserved characters are percent-encoded
+func sigv4Encode(s string, encodeSlash bool) string {
+	var sb strings.Builder
+	sb.Grow(len(s) + 8)
+	for i := range len(s) {
+		c := s[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteString(strings.ToUpper(hex.EncodeToString([]byte{c})))
+		}
+	}
+	return sb.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(cos.UnsafeB(data))
+	return h.Sum(nil)
+}
+
+//////////////
+// ErrSigV4 //
+//////////////
+
+func (e *ErrSigV4) Error() string { return e.msg }
+
+func NewErrInvalidAccessKey(msg string) *ErrSigV4 {
+	return &ErrSigV4{Code: ErrCodeInvalidAccessKeyID, Status: http.StatusForbidden, msg: msg}
+}
+
+func errMalformed(format string, a ...any) *ErrSigV4 {
+	return &ErrSigV4{Code: ErrCodeAuthMalformed, Status: http.StatusBadRequest, msg: "SigV4: " + fmt.Sprintf(format, a...)}
+}
+
+func errSkewed(date, now time.Time) *ErrSigV4 {
+	return &ErrSigV4{
+		Code:   ErrCodeTimeTooSkewed,
+		Status: http.StatusForbidden,
+		msg:    "the difference between the request time (" + date.Format(sigv4TimeFormat) + ") and the server's time (" + now.UTC().Format(sigv4TimeFormat) + ") is too large",
+	}
+}
@@ -0,0 +1,67 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object tagging: S3 XML <=> URL-encoded tags in LOM custom metadata (cmn.TagsObjMD)
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectTagging.html
+
+const ErrCodeInvalidTag = "InvalidTag"
+
+type (
+	Tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		TagSet  TagSet   `xml:"TagSet"`
+	}
+	TagSet struct {
+		Tags []Tag `xml:"Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+)
+
+func NewTagging(tags cos.StrKVs) *Tagging {
+	tg := &Tagging{Ns: s3Namespace, TagSet: TagSet{Tags: make([]Tag, 0, len(tags))}}
+	for k, v := range tags {
+		tg.TagSet.Tags = append(tg.TagSet.Tags, Tag{Key: k, Value: v})
+	}
+	sort.Slice(tg.TagSet.Tags, func(i, j int) bool { return tg.TagSet.Tags[i].Key < tg.TagSet.Tags[j].Key })
+	return tg
+}
+
+func (tg *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(tg)
+	debug.AssertNoErr(err)
+}
+
+// returns validated, URL-encoded tags
+func (tg *Tagging) ToNative() (string, error) {
+	tags := make(cos.StrKVs, len(tg.TagSet.Tags))
+	for _, tag := range tg.TagSet.Tags {
+		if _, ok := tags[tag.Key]; ok {
+			return "", fmt.Errorf("invalid object tags: duplicate key %q", tag.Key)
+		}
+		tags[tag.Key] = tag.Value
+	}
+	if err := cmn.ValidateObjTags(tags); err != nil {
+		return "", err
+	}
+	return cmn.ObjTags2S(tags), nil
+}
@@ -0,0 +1,61 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3_test
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const taggingXML = `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <TagSet>
+    <Tag><Key>split</Key><Value>train</Value></Tag>
+    <Tag><Key>quality score</Key><Value>0.97</Value></Tag>
+  </TagSet>
+</Tagging>`
+
+var _ = Describe("Tagging", func() {
+	It("should convert S3 tagging to URL-encoded tags and back", func() {
+		tg := &s3.Tagging{}
+		Expect(xml.Unmarshal([]byte(taggingXML), tg)).To(Succeed())
+
+		tags, err := tg.ToNative()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(Equal("quality+score=0.97&split=train"))
+
+		kvs, err := cmn.ParseObjTags(tags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvs).To(Equal(cos.StrKVs{"split": "train", "quality score": "0.97"}))
+
+		tg2 := s3.NewTagging(kvs)
+		Expect(tg2.TagSet.Tags).To(HaveLen(2))
+		Expect(tg2.TagSet.Tags[0].Key).To(Equal("quality score"))
+	})
+
+	It("should reject invalid tags", func() {
+		dup := &s3.Tagging{TagSet: s3.TagSet{Tags: []s3.Tag{{Key: "a", Value: "1"}, {Key: "a", Value: "2"}}}}
+		_, err := dup.ToNative()
+		Expect(err).To(HaveOccurred())
+
+		many := &s3.Tagging{}
+		for i := range cmn.MaxObjTags + 1 {
+			many.TagSet.Tags = append(many.TagSet.Tags, s3.Tag{Key: "k" + strconv.Itoa(i)})
+		}
+		_, err = many.ToNative()
+		Expect(err).To(HaveOccurred())
+
+		_, err = cmn.ParseObjTags("a=1&a=2")
+		Expect(err).To(HaveOccurred())
+		_, err = cmn.ParseObjTags("=1")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -51,6 +51,26 @@ type (
 		Prefix string `xml:"Prefix"`
 	}
 
+	// List object versions response
+	ListVersionsResult struct {
+		Name        string         `xml:"Name"`
+		Ns          string         `xml:"xmlns,attr"`
+		Prefix      string         `xml:"Prefix"`
+		KeyMarker   string         `xml:"KeyMarker"`
+		Versions    []*VersionInfo `xml:"Version"`
+		MaxKeys     int            `xml:"MaxKeys"`
+		IsTruncated bool           `xml:"IsTruncated"` // (currently, always false)
+	}
+	VersionInfo struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Class        string `xml:"StorageClass"`
+		Size         int64  `xml:"Size"`
+		IsLatest     bool   `xml:"IsLatest"`
+	}
+
 	// Response for object copy request
 	CopyObjectResult struct {
 		LastModified string `xml:"LastModified"` // e.g. <LastModified>2009-10-12T17:50:30.000Z</LastModified>
@@ -196,6 +216,38 @@ func (r *ListObjectResult) FromLsoResult(lst *cmn.LsoRes, lsmsg *apc.LsoMsg) {
 	}
 }
 
+func NewListVersionsResult(bucket, prefix string) *ListVersionsResult {
+	return &ListVersionsResult{
+		Name:    bucket,
+		Ns:      s3Namespace,
+		Prefix:  prefix,
+		MaxKeys: apc.MaxPageSizeAWS,
+	}
+}
+
+func (r *ListVersionsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// "null" is S3 version ID of objects that have no version
+func (r *ListVersionsResult) Add(entry *cmn.LsoEnt, lsmsg *apc.LsoMsg, latest bool) {
+	oi := entryToS3(entry, lsmsg)
+	vi := &VersionInfo{
+		Key:          oi.Key,
+		VersionID:    entry.Version,
+		LastModified: oi.LastModified,
+		ETag:         oi.ETag,
+		Size:         oi.Size,
+		IsLatest:     latest,
+	}
+	if vi.VersionID == "" {
+		vi.VersionID = "null"
+	}
+	r.Versions = append(r.Versions, vi)
+}
+
 func SetS3Headers(hdr http.Header, lom *core.LOM) {
 	// 1. ETag (must be a quoted string: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag)
 	if etag := hdr.Get(cos.HdrETag); etag != "" {
@@ -222,14 +274,25 @@ func SetS3Headers(hdr http.Header, lom *core.LOM) {
 		hdr.Set(cos.HdrLastModified, atime.Format(http.TimeFormat))
 	}
 
-	// 3. x-amz-version-id
+	// 3. x-amz-version-id (ais:// buckets: only when retaining noncurrent versions)
 	if hdr.Get(cos.S3VersionHeader) == "" {
 		if v, ok := lom.GetCustomKey(cmn.VersionObjMD); ok {
 			hdr.Set(cos.S3VersionHeader, v)
+		} else if lom.Bck().IsAIS() && lom.VersionConf().MaxVersions > 0 {
+			if v := lom.Version(true); v != "" {
+				hdr.Set(cos.S3VersionHeader, v)
+			}
+		}
+	}
+
+	// 4. x-amz-tagging-count
+	if v, ok := lom.GetCustomKey(cmn.TagsObjMD); ok && v != "" {
+		if tags, err := cmn.ParseObjTags(v); err == nil && len(tags) > 0 {
+			hdr.Set(cos.S3HdrTaggingCount, strconv.Itoa(len(tags)))
 		}
 	}
 
-	// 4. finally, user metadata (X-Amz-Meta-...)
+	// 5. finally, user metadata (X-Amz-Meta-...)
 	for k, v := range lom.GetCustomMD() {
 		if strings.HasPrefix(k, HeaderMetaPrefix) {
 			hdr.Set(k, v)
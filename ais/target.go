@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
@@ -36,8 +37,12 @@ import (
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/ext/dsort"
 	"github.com/NVIDIA/aistore/ext/etl"
+	"github.com/NVIDIA/aistore/ext/evnotif"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/fs/lsidx"
+	"github.com/NVIDIA/aistore/fs/replq"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/reb"
@@ -45,6 +50,7 @@ import (
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/volume"
+	"github.com/NVIDIA/aistore/xact/xckpt"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	"github.com/NVIDIA/aistore/xact/xs"
 )
@@ -185,6 +191,8 @@ func (t *target) initBuiltTagged(config *cmn.Config, startingUp bool) error {
 			bp, err = backend.NewOCI(t, tstats, startingUp)
 		case apc.HT:
 			bp, err = backend.NewHT(t, config, tstats, startingUp)
+		case apc.File:
+			bp, err = backend.NewFile(t, config, tstats, startingUp)
 		case apc.AIS:
 			continue
 		default:
@@ -236,6 +244,17 @@ func (t *target) initBuiltTagged(config *cmn.Config, startingUp bool) error {
 	return nil
 }
 
+// failure domain: environment takes precedence over local config
+func (t *target) initFdomain(config *cmn.Config) {
+	domain := cos.Right(config.FailureDomain, os.Getenv(env.AisFailureDomain))
+	if err := meta.ValidateFdomain(domain); err != nil {
+		cos.ExitLog(err)
+	}
+	if t.si.Domain = domain; domain != "" {
+		nlog.Infoln(t.String(), "failure domain:", domain)
+	}
+}
+
 func (t *target) aisbp() *backend.AISbp {
 	bendp := t.bps[apc.AIS]
 	return bendp.(*backend.AISbp)
@@ -263,6 +282,7 @@ func (t *target) init(config *cmn.Config) {
 		// later on during startup sequence - and not finding _this_ target in it
 	}
 	t.si.Init(tid, apc.Target)
+	t.initFdomain(config)
 
 	debug.Assert(t.si.IDDigest != 0)
 	cos.InitShortID(t.si.IDDigest)
@@ -385,9 +405,10 @@ func (t *target) Run() error {
 		nlog.Errorln("")
 	}
 
-	// register object type and workfile type
+	// register object, workfile, and object-version content types
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -454,8 +475,15 @@ func (t *target) Run() error {
 
 	ec.Init()
 	mirror.Init()
+	lsidx.Init(fs.MarkerExists(fname.NodeRestartedPrev))
+	replq.Init()
+	xckpt.Init()
+	evnotif.Init(t.SID(), config.LogDir, t.statsT)
 
 	xreg.RegWithHK()
+	hk.Reg(apc.ActLifecycle+hk.NameSuffix, t.lifecycle, hk.LifecycleIval)
+	hk.Reg(apc.ActReplicate+hk.NameSuffix, t.replicate, hk.ReplIval)
+	hk.Reg("resume-xactions"+hk.NameSuffix, t.resumeX, hk.ResumeIval)
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -467,8 +495,11 @@ func (t *target) Run() error {
 
 	err = t.htrun.run(config)
 
-	etl.StopAll() // stop all running ETLs if any
-	cos.Close(db) // close kv db
+	etl.StopAll()      // stop all running ETLs if any
+	cos.Close(db)      // close kv db
+	lsidx.PersistAll() // list-objects indexes, if any
+	replq.CloseAll()   // replication change logs, ditto
+	evnotif.Term()     // event notifications
 
 	// gracefully
 	fs.RemoveMarker(fname.NodeRestartedPrev, t.statsT)
@@ -766,8 +797,9 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 			return lom, err
 		}
 	}
+	corsActual(w.Header(), r.Header, r.Method, lom.Bprops())
 
-	// two special flows
+	// special flows: ETL, blob download, and noncurrent version
 	if dpq.etl.name != "" {
 		t.getFromETL(w, r, dpq, lom)
 		return lom, nil
@@ -792,6 +824,11 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		}
 		return lom, err
 	}
+	if dpq.versionID != "" {
+		if done, err := t.getVersion(w, r, dpq, lom); done {
+			return lom, err
+		}
+	}
 
 	// GET: regular | archive | range
 	goi := allocGOI()
@@ -813,6 +850,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.w = w
 		goi.ctx = context.Background()
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
+		goi.cond = newCondReq(r.Header)
 		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
 	}
 	if dpq.isArch() {
@@ -839,13 +877,16 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	if ecode, err := goi.getObject(); err != nil {
 		// stats
 		vlabs := map[string]string{stats.VlabBucket: bck.Cname("")}
-		if goi.isIOErr {
+		switch {
+		case cmn.IsErrPrecondition(err):
+			// failed precondition (412): not counting
+		case goi.isIOErr:
 			t.statsT.IncWith(stats.ErrGetCount, vlabs)
 			t.statsT.IncWith(stats.IOErrGetCount, vlabs)
 			if cmn.Rom.FastV(4, cos.SmoduleAIS) {
 				nlog.Warningln("io-error [", err, "]", goi.lom.String())
 			}
-		} else {
+		default:
 			t.statsT.IncWith(stats.ErrGetCount, vlabs)
 		}
 
@@ -866,7 +907,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 
 func _validateWarmGet(lom *core.LOM, latestVer bool /*apc.QparamLatestVer*/) bool {
 	switch {
-	case !lom.Bck().IsCloud() && !lom.Bck().IsRemoteAIS():
+	case !lom.Bck().IsCloud() && !lom.Bck().IsRemoteAIS() && !lom.Bck().IsFile():
 		return false
 	case !latestVer:
 		return lom.VersionConf().ValidateWarmGet || lom.VersionConf().Sync // bucket prop
@@ -916,6 +957,7 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	corsActual(w.Header(), r.Header, r.Method, lom.Bprops())
 
 	// do
 	var (
@@ -953,6 +995,8 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 		t.statsT.IncWith(stats.ErrAppendCount, vlabs)
+	case apireq.dpq.mpt.id != "": // apc.QparamMptUploadID
+		ecode, err = t.mptPutPart(w, r, lom, apireq.dpq)
 	default:
 		ecode, err = t.putObject(w, r, apireq.dpq, lom, t2tput, config)
 	}
@@ -1012,11 +1056,17 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	ecode, err := t.DeleteObject(lom, evict)
-	if err == nil && ecode == 0 {
+	var (
+		ecode int
+		err   error
+	)
+	if ver := apireq.query.Get(apc.QparamVersionID); ver != "" && !evict {
+		ecode, err = t.delVersion(lom, ver)
+	} else if ecode, err = t.DeleteObject(lom, evict); err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
-	} else {
+	}
+	if err != nil || ecode != 0 {
 		if ecode == http.StatusNotFound {
 			t.writeErrSilentf(w, r, http.StatusNotFound, "%s doesn't exist", lom.Cname())
 		} else {
@@ -1073,8 +1123,9 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 			break
 		}
 		args := &core.BlobParams{
-			Lom: lom,
-			Msg: &blobMsg,
+			Lom:       lom,
+			Msg:       &blobMsg,
+			Resumable: true,
 		}
 		if xid, _, err = t.blobdl(args, nil /*oa*/); xid != "" {
 			debug.AssertNoErr(err)
@@ -1082,6 +1133,18 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 
 			// lom is eventually freed by x-blob
 		}
+	case apc.ActMptCreate, apc.ActMptListParts, apc.ActMptComplete, apc.ActMptAbort:
+		lom = core.AllocLOM(apireq.items[1])
+		if err = lom.InitBck(apireq.bck.Bucket()); err != nil {
+			break
+		}
+		var ecode int
+		ecode, err = t.mpt(w, r, lom, msg)
+		core.FreeLOM(lom)
+		if err != nil {
+			t.writeErr(w, r, err, ecode)
+		}
+		return
 	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
@@ -1131,6 +1194,8 @@ func (t *target) objHead(r *http.Request, whdr http.Header, q url.Values, bck *m
 		}
 		return 0, err
 	}
+	corsActual(whdr, r.Header, r.Method, lom.Bprops())
+	waitDelayed(lom)
 	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil {
 		if apc.IsFltNoProps(fltPresence) {
 			return 0, nil
@@ -1184,6 +1249,7 @@ func (t *target) objHead(r *http.Request, whdr http.Header, q url.Values, bck *m
 				hasEC = true
 				op.EC.DataSlices = md.Data
 				op.EC.ParitySlices = md.Parity
+				op.EC.Profile = md.Profile
 				op.EC.IsECCopy = md.IsCopy
 				op.EC.Generation = md.Generation
 			}
@@ -1271,6 +1337,10 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "set-custom", msg.Value, err)
 		return
 	}
+	if _, ok := custom[cmn.TagsObjMD]; ok {
+		t.writeErrf(w, r, "%s: custom metadata key %q is reserved (object tags)", t.si, cmn.TagsObjMD)
+		return
+	}
 
 	lom := core.AllocLOM(apireq.items[1] /*objName*/)
 	defer core.FreeLOM(lom)
@@ -1288,6 +1358,9 @@ func (t *target) httpobjpatch(w http.ResponseWriter, r *http.Request, apireq *ap
 	}
 	delOldSetNew := cos.IsParseBool(apireq.query.Get(apc.QparamNewCustom))
 	if delOldSetNew {
+		if tags, ok := lom.GetCustomKey(cmn.TagsObjMD); ok {
+			custom[cmn.TagsObjMD] = tags // (keep)
+		}
 		lom.SetCustomMD(custom)
 	} else {
 		for key, val := range custom {
@@ -1366,11 +1439,21 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 		}
 	}
 
-	// stats
+	t.delstats(lom, evict, code, err, isback)
+	return code, err
+}
+
+func (t *target) delstats(lom *core.LOM, evict bool, code int, err error, isback bool) {
 	vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 	switch {
 	case err == nil:
 		t.statsT.IncWith(stats.DeleteCount, vlabs)
+		if !evict {
+			t.replDel(lom)
+			objEvent(lom, cmn.EvObjDeleted)
+		} else {
+			objEvent(lom, cmn.EvObjEvicted)
+		}
 	case cos.IsNotExist(err, code) || cmn.IsErrObjNought(err):
 		if !evict {
 			t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
@@ -1383,7 +1466,6 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 			t.statsT.IncWith(stats.IOErrDeleteCount, vlabs)
 		}
 	}
-	return code, err
 }
 
 // NOTE: s3 will return err=nil with OK status to indicate (not deleting) non-existing object (see also aws.go)
@@ -1476,6 +1558,9 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 	lom.Lock(true)
 	if err := lom.RemoveObj(); err != nil {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
+	} else {
+		t.replDel(lom)
+		evnotif.Emit(lom.Bck(), cmn.EvObjRenamed, msg.Name, lom.ObjName /*src*/, lom.Version(), lom.Lsize(true))
 	}
 	lom.Unlock(true)
 	return nil
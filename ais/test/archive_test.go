@@ -12,6 +12,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -66,6 +67,9 @@ func TestGetFromArch(t *testing.T) {
 				{
 					ext: archive.ExtTarLz4, nested: false, autodetect: false, mime: false,
 				},
+				{
+					ext: archive.ExtTarZst, nested: false, autodetect: false, mime: false,
+				},
 				{
 					ext: archive.ExtTar, nested: true, autodetect: true, mime: false,
 				},
@@ -78,6 +82,9 @@ func TestGetFromArch(t *testing.T) {
 				{
 					ext: archive.ExtTarLz4, nested: true, autodetect: true, mime: false,
 				},
+				{
+					ext: archive.ExtTarZst, nested: true, autodetect: true, mime: false,
+				},
 				{
 					ext: archive.ExtTar, nested: true, autodetect: true, mime: true,
 				},
@@ -90,6 +97,9 @@ func TestGetFromArch(t *testing.T) {
 				{
 					ext: archive.ExtTarLz4, nested: true, autodetect: true, mime: true,
 				},
+				{
+					ext: archive.ExtTarZst, nested: true, autodetect: true, mime: true,
+				},
 			}
 		)
 		if testing.Short() {
@@ -191,6 +201,65 @@ func TestGetFromArch(t *testing.T) {
 	})
 }
 
+// list (and paginate) inside a given shard via prefix that crosses shard boundary
+func TestListInsideArch(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		errCh      = make(chan error, 1)
+		numFiles   = 300
+		pageSize   = 32
+		fileSize   = 1024
+		names      = make([]string, numFiles)
+		archName   = "/tmp/" + cos.GenTie() + archive.ExtTar
+		objName    = "shard" + archive.ExtTar
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	for i := range numFiles {
+		names[i] = fmt.Sprintf("%c/%04d.txt", 'a'+i%3, i)
+	}
+	err := tarch.CreateArchRandomFiles(archName, tar.FormatUnknown, archive.ExtTar, numFiles, fileSize,
+		false /*dup*/, false /*random dir*/, nil /*record ext*/, names)
+	tassert.CheckFatal(t, err)
+	defer os.Remove(archName)
+
+	reader, err := readers.NewExistingFile(archName, cos.ChecksumNone)
+	tassert.CheckFatal(t, err)
+	tools.Put(proxyURL, bck, objName, reader, errCh)
+	tassert.SelectErr(t, errCh, "put", true)
+
+	var (
+		listed int
+		pages  int
+		prev   string
+		msg    = &apc.LsoMsg{Prefix: objName + "/b/", PageSize: int64(pageSize)}
+	)
+	msg.SetFlag(apc.LsArchDir)
+	msg.AddProps(apc.GetPropsName, apc.GetPropsSize)
+	for {
+		lst, err := api.ListObjectsPage(baseParams, bck, msg, api.ListArgs{})
+		tassert.CheckFatal(t, err)
+		pages++
+		for _, en := range lst.Entries {
+			tassert.Errorf(t, strings.HasPrefix(en.Name, msg.Prefix), "%q: unexpected name outside %q", en.Name, msg.Prefix)
+			tassert.Errorf(t, en.IsAnyFlagSet(apc.EntryInArch), "%q: expecting archived file", en.Name)
+			tassert.Errorf(t, en.Size == int64(fileSize), "%q: size %d != %d", en.Name, en.Size, fileSize)
+			tassert.Errorf(t, en.Offset > 0 && en.Offset%512 == 0, "%q: invalid tar offset %d", en.Name, en.Offset)
+			tassert.Errorf(t, en.Name > prev, "%q: not sorted (prev %q)", en.Name, prev)
+			prev = en.Name
+		}
+		listed += len(lst.Entries)
+		if lst.ContinuationToken == "" {
+			break
+		}
+	}
+	tlog.Logf("listed %d files in %d pages\n", listed, pages)
+	tassert.Fatalf(t, listed == numFiles/3, "expected %d files, got %d", numFiles/3, listed)
+	tassert.Fatalf(t, pages >= numFiles/3/pageSize, "expected pagination (%d pages)", pages)
+}
+
 // archive multple obj-s with an option to append if exists
 func TestArchMultiObj(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
@@ -268,6 +337,9 @@ func testArch(t *testing.T, bck *meta.Bck) {
 			{
 				ext: archive.ExtTarLz4, list: false,
 			},
+			{
+				ext: archive.ExtTarZst, list: true, inclSrcBckName: true,
+			},
 		}
 	)
 	if testing.Short() {
@@ -494,6 +566,9 @@ func TestAppendToArch(t *testing.T) {
 			{
 				ext: archive.ExtTarLz4, multi: true,
 			},
+			{
+				ext: archive.ExtTarZst, multi: true,
+			},
 		}
 	)
 	if !testing.Short() { // test-long, and see one other Skip below
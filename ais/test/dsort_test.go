@@ -1143,7 +1143,7 @@ func TestDsortMinMemCompression(t *testing.T) {
 	t.Skipf("skipping %s", t.Name()) // TODO -- FIXME: remove completely
 
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
-	for _, ext := range []string{archive.ExtTarGz, archive.ExtTarLz4, archive.ExtZip} {
+	for _, ext := range []string{archive.ExtTarGz, archive.ExtTarLz4, archive.ExtTarZst, archive.ExtZip} {
 		for _, maxMem := range []string{"10%", "1%"} {
 			t.Run(ext+"/mem="+maxMem, func(t *testing.T) {
 				minMemCompression(t, ext, maxMem)
@@ -1917,7 +1917,7 @@ func TestDsortMissingShards(t *testing.T) {
 
 func TestDsortDuplications(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
-	for _, ext := range []string{archive.ExtTar, archive.ExtTarLz4, archive.ExtTarGz, archive.ExtZip} { // all supported formats
+	for _, ext := range []string{archive.ExtTar, archive.ExtTarLz4, archive.ExtTarZst, archive.ExtTarGz, archive.ExtZip} { // all supported formats
 		t.Run(ext, func(t *testing.T) {
 			runDsortTest(
 				t, dsortTestSpec{
@@ -114,6 +114,26 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		t.statsT.AddWith(
 			cos.NamedVal64{Name: stats.ListLatency, Value: delta, VarLabs: vlabs},
 		)
+	case apc.ActListVersions:
+		if len(apiItems) == 0 {
+			t.writeErrURL(w, r)
+			return
+		}
+		bck, err := newBckFromQ(apiItems[0], nil, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := bck.Init(t.owner.bmd); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		var lsmsg apc.LsoMsg
+		if err := cos.MorphMarshal(msg.Value, &lsmsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		t.listVersions(w, r, bck, &lsmsg)
 	case apc.ActSummaryBck:
 		var bucket, phase string // txn
 		if len(apiItems) == 0 {
@@ -216,7 +236,7 @@ func (t *target) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Q
 func (t *target) blist(qbck *cmn.QueryBcks, config *cmn.Config) (bcks cmn.Bcks, ecode int, err error) {
 	// validate
 	debug.Assert(!qbck.IsAIS())
-	if qbck.IsCloud() { // must be configured
+	if qbck.IsCloud() || qbck.IsFile() { // must be configured
 		if config.Backend.Get(qbck.Provider) == nil {
 			return nil, 0, &cmn.ErrMissingBackend{Provider: qbck.Provider}
 		}
@@ -261,6 +281,13 @@ func (t *target) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.B
 		}
 	}
 
+	if lsmsg.Filter != nil {
+		if err := lsmsg.Filter.Validate(); err != nil {
+			t.writeErr(w, r, err)
+			return false
+		}
+	}
+
 	var (
 		xctn core.Xact
 		rns  = xreg.RenewLso(bck, lsmsg.UUID, lsmsg, r.Header)
@@ -0,0 +1,158 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Conditional requests (native and S3 API):
+// - If-Match, If-None-Match (including `If-None-Match: *` to create-only), If-Unmodified-Since
+// - If-Modified-Since (GET only)
+// - entity tags are compared with the object's ETag (if available), checksum value, and version
+// - last-modified time is the one that S3 API reports (see s3.SetS3Headers)
+// - evaluated under the object's lock: wlock (PUT) and rlock (GET)
+// - failed precondition results in 412, not-modified GET - in 304
+// - see https://www.rfc-editor.org/rfc/rfc9110#section-13.2.2 for the order of evaluation
+
+type condReq struct {
+	ifModSince   time.Time
+	ifUnmodSince time.Time
+	ifMatch      string
+	ifNoneMatch  string
+}
+
+// returns nil when the request is not conditional
+func newCondReq(hdr http.Header) (c *condReq) {
+	var (
+		ifMatch     = hdr.Get(cos.HdrIfMatch)
+		ifNoneMatch = hdr.Get(cos.HdrIfNoneMatch)
+		ifModSince  = hdr.Get(cos.HdrIfModifiedSince)
+		ifUnmod     = hdr.Get(cos.HdrIfUnmodifiedSince)
+	)
+	if ifMatch == "" && ifNoneMatch == "" && ifModSince == "" && ifUnmod == "" {
+		return nil
+	}
+	c = &condReq{ifMatch: ifMatch, ifNoneMatch: ifNoneMatch}
+
+	// invalid dates are ignored (RFC 9110, 13.1.3 and 13.1.4)
+	if ifModSince != "" {
+		c.ifModSince, _ = http.ParseTime(ifModSince)
+	}
+	if ifUnmod != "" {
+		c.ifUnmodSince, _ = http.ParseTime(ifUnmod)
+	}
+	return c
+}
+
+// `exists` false: no such object (PUT only)
+func (c *condReq) eval(lom *core.LOM, exists, get bool) (int, error) {
+	switch {
+	case c.ifMatch != "":
+		if !exists {
+			return http.StatusNotFound, cos.NewErrNotFound(core.T, lom.Cname())
+		}
+		if !c.match(lom, c.ifMatch, false /*weak*/) {
+			return c.failed(cos.HdrIfMatch, lom, false)
+		}
+	case !c.ifUnmodSince.IsZero() && exists:
+		if lastModified(lom).After(c.ifUnmodSince) {
+			return c.failed(cos.HdrIfUnmodifiedSince, lom, false)
+		}
+	}
+	switch {
+	case c.ifNoneMatch != "":
+		if exists && c.match(lom, c.ifNoneMatch, true /*weak*/) {
+			return c.failed(cos.HdrIfNoneMatch, lom, get)
+		}
+	case !c.ifModSince.IsZero() && get:
+		if !lastModified(lom).After(c.ifModSince) {
+			return c.failed(cos.HdrIfModifiedSince, lom, true)
+		}
+	}
+	return 0, nil
+}
+
+func (*condReq) failed(hdr string, lom *core.LOM, notModified bool) (int, error) {
+	err := cmn.NewErrPrecondition(hdr, lom.Cname(), notModified)
+	return err.Status(), err
+}
+
+// comma-separated list of entity tags, or "*"
+func (*condReq) match(lom *core.LOM, tags string, weak bool) bool {
+	if strings.TrimSpace(tags) == "*" {
+		return true
+	}
+	var (
+		etag, _ = lom.GetCustomKey(cmn.ETag)
+		cksum   = lom.Checksum()
+		version = lom.Version(true)
+	)
+	etag = strings.Trim(etag, `"`)
+	for _, tag := range strings.Split(tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue // If-Match uses strong comparison
+			}
+			tag = tag[2:]
+		}
+		tag = strings.Trim(tag, `"`)
+		switch {
+		case tag == "":
+		case tag == etag, tag == version:
+			return true
+		case !cksum.IsEmpty() && tag == cksum.Val():
+			return true
+		}
+	}
+	return false
+}
+
+// (HTTP dates have 1s resolution)
+func lastModified(lom *core.LOM) time.Time {
+	if v, ok := lom.GetCustomKey(cos.HdrLastModified); ok {
+		if tm, err := http.ParseTime(v); err == nil {
+			return tm
+		}
+	}
+	return lom.Atime().Truncate(time.Second)
+}
+
+//
+// PUT and GET
+//
+
+// evaluate preconditions against the current in-cluster object, if exists
+func (poi *putOI) evalCond(locked bool) (int, error) {
+	cur := core.AllocLOM(poi.lom.ObjName)
+	defer core.FreeLOM(cur)
+	if err := cur.InitBck(poi.lom.Bucket()); err != nil {
+		return 0, err
+	}
+	err := cur.Load(false /*cache it*/, locked)
+	if err != nil && !cos.IsNotExist(err, 0) {
+		return http.StatusInternalServerError, err
+	}
+	return poi.cond.eval(cur, err == nil, false /*get*/)
+}
+
+// 304 with no body
+func (goi *getOI) notModified() {
+	hdr := goi.w.Header()
+	if goi.dpq.isS3 {
+		s3.SetS3Headers(hdr, goi.lom)
+	} else {
+		cmn.ToHeader(goi.lom.ObjAttrs(), hdr, 0)
+	}
+	goi.w.WriteHeader(http.StatusNotModified)
+}
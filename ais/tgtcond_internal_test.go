@@ -0,0 +1,105 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/readers"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCondReqEval(t *testing.T) {
+	lom := core.AllocLOM("cond-eval")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+
+	mtime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	lom.SetCksum(cos.NewCksum(cos.ChecksumMD5, "a103a20a4e8a207fe7ba25eeb2634c96"))
+	lom.SetVersion("3")
+	lom.SetCustomKey(cos.HdrLastModified, mtime.Format(http.TimeFormat))
+
+	tests := []struct {
+		hdr    map[string]string
+		exists bool
+		get    bool
+		ecode  int
+	}{
+		{hdr: map[string]string{cos.HdrIfMatch: `"a103a20a4e8a207fe7ba25eeb2634c96"`}, exists: true},
+		{hdr: map[string]string{cos.HdrIfMatch: `"xyz", "3"`}, exists: true},
+		{hdr: map[string]string{cos.HdrIfMatch: `W/"3"`}, exists: true, ecode: http.StatusPreconditionFailed},
+		{hdr: map[string]string{cos.HdrIfMatch: `"xyz"`}, exists: true, get: true, ecode: http.StatusPreconditionFailed},
+		{hdr: map[string]string{cos.HdrIfMatch: "*"}, exists: false, ecode: http.StatusNotFound},
+		{hdr: map[string]string{cos.HdrIfNoneMatch: "*"}, exists: false},
+		{hdr: map[string]string{cos.HdrIfNoneMatch: "*"}, exists: true, ecode: http.StatusPreconditionFailed},
+		{hdr: map[string]string{cos.HdrIfNoneMatch: `W/"3"`}, exists: true, get: true, ecode: http.StatusNotModified},
+		{hdr: map[string]string{cos.HdrIfNoneMatch: `"xyz"`}, exists: true, get: true},
+		{hdr: map[string]string{cos.HdrIfModifiedSince: mtime.Format(http.TimeFormat)}, exists: true, get: true, ecode: http.StatusNotModified},
+		{hdr: map[string]string{cos.HdrIfModifiedSince: mtime.Add(-time.Second).Format(http.TimeFormat)}, exists: true, get: true},
+		{hdr: map[string]string{cos.HdrIfModifiedSince: mtime.Format(http.TimeFormat)}, exists: true}, // ignored by PUT
+		{hdr: map[string]string{cos.HdrIfModifiedSince: "invalid date"}, exists: true, get: true},
+		{hdr: map[string]string{cos.HdrIfUnmodifiedSince: mtime.Format(http.TimeFormat)}, exists: true},
+		{hdr: map[string]string{cos.HdrIfUnmodifiedSince: mtime.Add(-time.Hour).Format(http.TimeFormat)}, exists: true, ecode: http.StatusPreconditionFailed},
+		// If-Match takes precedence over If-Unmodified-Since, and If-None-Match over If-Modified-Since
+		{
+			hdr:    map[string]string{cos.HdrIfMatch: `"3"`, cos.HdrIfUnmodifiedSince: mtime.Add(-time.Hour).Format(http.TimeFormat)},
+			exists: true,
+		},
+		{
+			hdr:    map[string]string{cos.HdrIfNoneMatch: `"xyz"`, cos.HdrIfModifiedSince: mtime.Format(http.TimeFormat)},
+			exists: true, get: true,
+		},
+	}
+	for i, test := range tests {
+		hdr := make(http.Header)
+		for k, v := range test.hdr {
+			hdr.Set(k, v)
+		}
+		cond := newCondReq(hdr)
+		tassert.Fatalf(t, cond != nil, "%d: expecting conditional request", i)
+		ecode, err := cond.eval(lom, test.exists, test.get)
+		tassert.Errorf(t, ecode == test.ecode, "%d: %v: expected %d, got %d (%v)", i, test.hdr, test.ecode, ecode, err)
+		tassert.Errorf(t, (err == nil) == (test.ecode == 0), "%d: %v: unexpected err %v", i, test.hdr, err)
+	}
+	tassert.Errorf(t, newCondReq(make(http.Header)) == nil, "expecting nil (not conditional)")
+}
+
+func TestCondReqPutCreateOnly(t *testing.T) {
+	lom := core.AllocLOM("cond-put")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+	defer lom.RemoveMain()
+
+	hdr := make(http.Header)
+	hdr.Set(cos.HdrIfNoneMatch, "*")
+	put := func() (int, error) {
+		r, _ := readers.NewRand(cos.KiB, cos.ChecksumNone)
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       core.T.(*target),
+			lom:     lom,
+			r:       r,
+			workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+			config:  cmn.GCO.Get(),
+			skipVC:  true,
+			cond:    newCondReq(hdr),
+		}
+		return poi.putObject()
+	}
+
+	_, err := put()
+	tassert.CheckFatal(t, err)
+
+	ecode, err := put()
+	tassert.Fatalf(t, cmn.IsErrPrecondition(err), "expecting precondition error, got %v", err)
+	tassert.Errorf(t, ecode == http.StatusPreconditionFailed, "expecting 412, got %d", ecode)
+}
@@ -20,12 +20,15 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/lsidx"
+	"github.com/NVIDIA/aistore/fs/replq"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/res"
@@ -210,6 +213,8 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 			bp, err = backend.NewAzure(t, t.statsT, false)
 		case apc.OCI:
 			bp, err = backend.NewOCI(t, t.statsT, false)
+		case apc.File:
+			bp, err = backend.NewFile(t, cmn.GCO.Get(), t.statsT, false)
 		}
 		if err != nil {
 			t.writeErr(w, r, err)
@@ -327,6 +332,8 @@ func (t *target) enableBackend(w http.ResponseWriter, r *http.Request, provider,
 			bp, err = backend.NewAzure(t, t.statsT, false /*starting up*/)
 		case apc.OCI:
 			bp, err = backend.NewOCI(t, t.statsT, false /*starting up*/)
+		case apc.File:
+			bp, err = backend.NewFile(t, cmn.GCO.Get(), t.statsT, false /*starting up*/)
 		}
 		if err != nil {
 			debug.AssertNoErr(err) // (unlikely)
@@ -482,6 +489,8 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		debug.Assert(ok)
 
 		t.writeJSON(w, r, aisbp.GetInfo(aisConf), httpdaeWhat)
+	case apc.WhatReplStats:
+		t.writeJSON(w, r, t.replStats(), httpdaeWhat)
 	default:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	}
@@ -864,6 +873,23 @@ func (f *delb) do(nbck *meta.Bck) bool {
 		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
 		xreg.DoAbort(flt, errors.New("apply-bmd"))
 	}
+	if f.obck.Props.Features.IsSet(feat.ListIndex) && !nbck.Props.Features.IsSet(feat.ListIndex) {
+		flt := xreg.Flt{Kind: apc.ActLsoIndex, Bck: nbck}
+		xreg.DoAbort(flt, errors.New("apply-bmd"))
+		lsidx.Drop(nbck.Bucket())
+	}
+	if orepl, nrepl := &f.obck.Props.Replication, &nbck.Props.Replication; orepl.Enabled {
+		switch {
+		case !nrepl.Enabled:
+			flt := xreg.Flt{Kind: apc.ActReplicate, Bck: nbck}
+			xreg.DoAbort(flt, errors.New("apply-bmd"))
+			replq.Drop(nbck.Bucket())
+		case orepl.Dst != nrepl.Dst || orepl.Prefix != nrepl.Prefix:
+			// (the next x-replicate will run with the updated config)
+			flt := xreg.Flt{Kind: apc.ActReplicate, Bck: nbck}
+			xreg.DoAbort(flt, errors.New("apply-bmd"))
+		}
+	}
 	return true // break
 }
 
@@ -872,6 +898,10 @@ func (t *target) _postBMD(newBMD *bucketMD, tag string, rmbcks []*meta.Bck) {
 	if len(rmbcks) > 0 {
 		wg := &sync.WaitGroup{}
 		core.LcacheClearBcks(wg, rmbcks...)
+		for _, bck := range rmbcks {
+			lsidx.Drop(bck.Bucket())
+			replq.Drop(bck.Bucket())
+		}
 
 		errV := fmt.Errorf("[post-bmd] %s %s: remove bucket%s", tag, newBMD, cos.Plural(len(rmbcks)))
 		xreg.AbortAllBuckets(errV, rmbcks...)
@@ -1451,6 +1481,9 @@ func (t *target) decommission(action string, opts *apc.ActValRmNode) {
 	t.regstate.mu.Unlock()
 
 	nlog.Infof("%s: %s %v", t, action, opts)
+
+	// abort (as opposed to suspending - see Stop) all xactions, including resumable ones
+	xreg.AbortAll(fmt.Errorf("%s: %s", t, action))
 	fs.Decommission(!opts.RmUserData /*ais metadata only*/)
 	cleanupConfigDir(t.Name(), opts.KeepInitialConfig)
 
@@ -1517,10 +1550,13 @@ func (t *target) Stop(err error) {
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
+		dputs.wait() // write_policy.data = delayed
 		core.Term()
 		wg.Done()
 	}()
 
+	// resumable xactions get suspended (rather than aborted) to continue after restart
+	t.suspendX()
 	xreg.AbortAll(err)
 
 	t.htrun.stop(wg, g.netServ.pub.s != nil && !isErrNoUnregister(err) /*rm from Smap*/)
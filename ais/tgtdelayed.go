@@ -0,0 +1,224 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"maps"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// `write_policy.data = delayed`:
+// - PUT receives (and checksums) the object in memory (SGL) and responds right away
+// - the object then remains write-locked until flushed to its mountpath in the background
+// - regular GET (no range, archive, or conditions) of the object that is pending flush
+//   reads the SGL (see getDelayed); the object's version, if enabled, gets assigned upon flush
+// - all other requests (HEAD, DELETE, range GET, etc.) of the same object wait for the flush to complete
+// - applies to ais:// buckets only, and only to non-conditional PUTs of known size up to `maxDelayedSize`;
+//   all other PUTs, as well as PUTs under memory pressure, are written immediately
+// - flushing respects `feat.FsyncPUT`
+
+const (
+	maxDelayedSize    = 4 * cos.MiB
+	maxDelayedPending = 1024
+)
+
+type (
+	delayedPUTs struct {
+		objs    map[string]*dput // pending flush, by uname
+		wg      sync.WaitGroup
+		pending atomic.Int64
+		mu      sync.RWMutex
+	}
+	dput struct {
+		sgl *memsys.SGL
+		lom *core.LOM      // metadata (snapshot) to respond with
+		rwg sync.WaitGroup // readers
+	}
+)
+
+var dputs = delayedPUTs{objs: make(map[string]*dput, 64)}
+
+// to write into SGL via putOI.write()
+type sglw struct{ *memsys.SGL }
+
+func (sglw) Sync() error  { return nil }
+func (sglw) Close() error { return nil }
+
+// (called upon target termination)
+func (d *delayedPUTs) wait() {
+	if n := d.pending.Load(); n > 0 {
+		nlog.Infoln("flushing", n, "delayed PUT(s)")
+	}
+	d.wg.Wait()
+}
+
+func (d *delayedPUTs) add(uname string, dp *dput) {
+	d.mu.Lock()
+	d.objs[uname] = dp
+	d.mu.Unlock()
+}
+
+// (must be called under the object's wlock - see flush)
+func (d *delayedPUTs) del(uname string, dp *dput) {
+	d.mu.Lock()
+	if d.objs[uname] == dp {
+		delete(d.objs, uname)
+	}
+	d.mu.Unlock()
+}
+
+// returns the pending object, if any; the caller must call rwg.Done when done reading
+func (d *delayedPUTs) get(uname string) (dp *dput) {
+	d.mu.RLock()
+	if dp = d.objs[uname]; dp != nil {
+		dp.rwg.Add(1)
+	}
+	d.mu.RUnlock()
+	return dp
+}
+
+// wait for the pending flush, if any
+func waitDelayed(lom *core.LOM) {
+	if lom.Bprops().WritePolicy.Data == apc.WriteDelayed {
+		lom.Lock(false)
+		lom.Unlock(false)
+	}
+}
+
+func (poi *putOI) delayed() bool {
+	lom := poi.lom
+	if lom.Bprops().WritePolicy.Data != apc.WriteDelayed || !lom.Bck().IsAIS() {
+		return false
+	}
+	if poi.owt != cmn.OwtPut || poi.t2t || poi.cond != nil || poi.size <= 0 || poi.size > maxDelayedSize {
+		return false
+	}
+	if poi.t.gmm.Pressure() >= memsys.PressureHigh {
+		return false
+	}
+	if dputs.pending.Inc() > maxDelayedPending {
+		dputs.pending.Dec()
+		return false
+	}
+	return true
+}
+
+// hand over the SGL (and a copy of the LOM) to the background flusher
+func (poi *putOI) delay() {
+	lom := poi.lom
+	lom.Lock(true) // unlocked by flush() - see above
+
+	bg := allocPOI()
+	{
+		*bg = *poi
+		bg.lom = lom.CloneMD(lom.FQN)
+		bg.oreq, bg.r, bg.resphdr = nil, nil, nil
+	}
+	dp := &dput{sgl: poi.sgl, lom: lom.CloneMD(lom.FQN)}
+	{
+		dp.lom.SetCustomMD(maps.Clone(lom.GetCustomMD()))
+		dp.lom.SetAtimeUnix(poi.atime)
+	}
+	dputs.add(lom.Uname(), dp)
+	poi.sgl = nil
+	dputs.wg.Add(1)
+	go bg.flush(dp)
+}
+
+func (poi *putOI) flush(dp *dput) {
+	lom := poi.lom
+	err := poi._flush()
+	dputs.del(lom.Uname(), dp)
+	lom.Unlock(true)
+	dp.rwg.Wait()
+	core.FreeLOM(dp.lom)
+
+	if err != nil {
+		nlog.Errorln("failed to flush delayed PUT [", poi.loghdr(), err, "]")
+		poi.t.statsT.IncWith(stats.ErrPutCount, poi._vlabs(true /*detailed*/))
+	} else if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln("flushed delayed PUT [", poi.loghdr(), "]")
+	}
+	poi.sgl.Free()
+	core.FreeLOM(lom)
+	freePOI(poi)
+
+	dputs.pending.Dec()
+	dputs.wg.Done()
+}
+
+func (poi *putOI) _flush() error {
+	lom := poi.lom
+	lmfh, err := lom.CreateWork(poi.workFQN)
+	if err != nil {
+		return err
+	}
+	if _, err = poi.sgl.WriteTo(lmfh); err == nil && lom.IsFeatureSet(feat.FsyncPUT) {
+		err = lmfh.Sync()
+	}
+	if errC := lmfh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		if errV := cos.RemoveFile(poi.workFQN); errV != nil && !os.IsNotExist(errV) {
+			nlog.Errorf(fmtNested, poi.t, err, "remove", poi.workFQN, errV)
+		}
+		return err
+	}
+	_, err = poi.finalize()
+	return err
+}
+
+// read-after-write: serve regular GET from the SGL that is pending flush;
+// returns false when there's nothing pending (or the GET is not regular)
+func (goi *getOI) getDelayed() (bool, error) {
+	if dputs.pending.Load() == 0 || goi.lom.Bprops().WritePolicy.Data != apc.WriteDelayed {
+		return false, nil
+	}
+	if goi.ranges.Range != "" || goi.dpq.isArch() || goi.dpq.isGFN || goi.cond != nil || goi.latestVer {
+		return false, nil
+	}
+	dp := dputs.get(goi.lom.Uname())
+	if dp == nil {
+		return false, nil
+	}
+	defer dp.rwg.Done()
+
+	var (
+		whdr = goi.w.Header()
+		size = dp.sgl.Len()
+	)
+	whdr.Set(cos.HdrContentType, cos.ContentBinary)
+	if goi.dpq.isS3 {
+		whdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+		s3.SetS3Headers(whdr, dp.lom)
+	} else {
+		cmn.ToHeader(dp.lom.ObjAttrs(), whdr, size, dp.lom.Checksum())
+	}
+	buf, slab := goi.t.gmm.AllocSize(min(size, memsys.DefaultBuf2Size))
+	written, err := cos.CopyBuffer(goi.w, memsys.NewReader(dp.sgl), buf)
+	slab.Free(buf)
+	if err != nil {
+		if cmn.Rom.FastV(5, cos.SmoduleAIS) {
+			nlog.Warningln("(transmit delayed)", goi.lom.Cname(), "err:", err)
+		}
+		return true, errGetTxBenign
+	}
+	goi.stats(written)
+	return true, nil
+}
@@ -0,0 +1,159 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/tools/trand"
+)
+
+func newDelayedLOM(t *testing.T, objName string) *core.LOM {
+	lom := core.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(&cmn.Bck{Name: testBucketDly, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+	t.Cleanup(func() {
+		lom.RemoveMain()
+		core.FreeLOM(lom)
+	})
+	return lom
+}
+
+func delayedGET(lom *core.LOM) (*httptest.ResponseRecorder, error) {
+	var (
+		rec = httptest.NewRecorder()
+		goi = &getOI{
+			t:     core.T.(*target),
+			lom:   lom,
+			dpq:   &dpq{},
+			req:   httptest.NewRequest(http.MethodGet, "/", http.NoBody),
+			w:     rec,
+			ctx:   context.Background(),
+			atime: time.Now().UnixNano(),
+			ltime: mono.NanoTime(),
+		}
+	)
+	_, err := goi.getObject()
+	return rec, err
+}
+
+func TestDelayedPutAck(t *testing.T) {
+	var (
+		tgt     = core.T.(*target)
+		lom     = newDelayedLOM(t, "delayed/ack")
+		payload = []byte(trand.String(cos.KiB))
+		req     = httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(payload))
+		poi     = &putOI{atime: time.Now().UnixNano(), t: tgt, lom: lom, config: cmn.GCO.Get(), restful: true, skipVC: true}
+	)
+	if tgt.gmm.Pressure() >= memsys.PressureHigh {
+		t.Skipf("memory pressure %d: PUT won't be delayed", tgt.gmm.Pressure())
+	}
+	req.Header.Set(cos.HdrContentLength, strconv.Itoa(len(payload)))
+	_, err := poi.do(make(http.Header), req, &dpq{})
+	tassert.CheckFatal(t, err)
+
+	// read-after-write (whether still pending or already flushed)
+	rec, err := delayedGET(lom)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(rec.Body.Bytes(), payload), "GET: expecting %d bytes of the PUT payload, got %d", len(payload), rec.Body.Len())
+
+	dputs.wait()
+	tassert.Errorf(t, dputs.pending.Load() == 0, "expecting nothing pending, got %d", dputs.pending.Load())
+	tassert.CheckFatal(t, lom.Load(false, false))
+	tassert.Errorf(t, lom.Lsize() == int64(len(payload)), "expecting flushed size %d, got %d", len(payload), lom.Lsize())
+	b, err := os.ReadFile(lom.FQN)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, bytes.Equal(b, payload), "flushed content differs from the PUT payload")
+}
+
+// GET must not wait for the flush (that holds the write lock)
+func TestDelayedReadPending(t *testing.T) {
+	var (
+		tgt     = core.T.(*target)
+		lom     = newDelayedLOM(t, "delayed/pending")
+		payload = []byte(trand.String(3 * cos.KiB))
+		sgl     = tgt.gmm.NewSGL(int64(len(payload)))
+	)
+	defer sgl.Free()
+	_, err := sgl.Write(payload)
+	tassert.CheckFatal(t, err)
+
+	lom.SetSize(int64(len(payload)))
+	lom.SetCksum(cos.NewCksum(cos.ChecksumOneXxh, "0123456789abcdef"))
+	dp := &dput{sgl: sgl, lom: lom.CloneMD(lom.FQN)}
+	lom.Lock(true) // (as if being flushed)
+	dputs.pending.Inc()
+	dputs.add(lom.Uname(), dp)
+
+	done := make(chan *httptest.ResponseRecorder, 1)
+	go func() {
+		rec, err := delayedGET(lom)
+		tassert.Errorf(t, err == nil, "GET: %v", err)
+		done <- rec
+	}()
+	select {
+	case rec := <-done:
+		tassert.Errorf(t, bytes.Equal(rec.Body.Bytes(), payload), "expecting %d bytes, got %d", len(payload), rec.Body.Len())
+		tassert.Errorf(t, rec.Header().Get(apc.HdrObjCksumVal) == "0123456789abcdef", "expecting checksum header, got %q",
+			rec.Header().Get(apc.HdrObjCksumVal))
+		tassert.Errorf(t, rec.Header().Get(cos.HdrContentLength) == strconv.Itoa(len(payload)), "expecting content length %d, got %q",
+			len(payload), rec.Header().Get(cos.HdrContentLength))
+	case <-time.After(10 * time.Second):
+		t.Fatal("GET blocked by pending flush")
+	}
+
+	dputs.del(lom.Uname(), dp)
+	dputs.pending.Dec()
+	lom.Unlock(true)
+	dp.rwg.Wait()
+	core.FreeLOM(dp.lom)
+}
+
+func TestDelayedFlushError(t *testing.T) {
+	var (
+		tgt     = core.T.(*target)
+		lom     = newDelayedLOM(t, "delayed/flush-error")
+		payload = []byte(trand.String(cos.KiB))
+		notDir  = filepath.Join(t.TempDir(), "file")
+	)
+	tassert.CheckFatal(t, os.WriteFile(notDir, nil, 0o644))
+	poi := &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       tgt,
+		lom:     lom,
+		config:  cmn.GCO.Get(),
+		owt:     cmn.OwtPut,
+		workFQN: filepath.Join(notDir, "work"), // (fails to create)
+		sgl:     tgt.gmm.NewSGL(int64(len(payload))),
+	}
+	_, err := poi.sgl.Write(payload)
+	tassert.CheckFatal(t, err)
+	lom.SetSize(int64(len(payload)))
+	dputs.pending.Inc()
+
+	poi.delay()
+	dputs.wait()
+
+	tassert.Errorf(t, dputs.pending.Load() == 0, "expecting nothing pending, got %d", dputs.pending.Load())
+	tassert.Errorf(t, dputs.get(lom.Uname()) == nil, "expecting failed PUT to be unregistered")
+	tassert.Errorf(t, lom.TryLock(true), "expecting failed flush to release the lock")
+	lom.Unlock(true)
+	err = lom.Load(false, false)
+	tassert.Errorf(t, cos.IsNotExist(err, 0), "expecting not-found upon failure to flush, got %v", err)
+}
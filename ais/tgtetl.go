@@ -25,7 +25,7 @@ import (
 
 // [METHOD] /v1/etl
 func (t *target) etlHandler(w http.ResponseWriter, r *http.Request) {
-	if !k8s.IsK8s() {
+	if !etl.Enabled() {
 		t.writeErr(w, r, k8s.ErrK8sRequired, 0, Silent)
 		return
 	}
@@ -161,6 +161,7 @@ func (goi *getOI) _fini(revert string, fullSize, txSize int64) error {
 		nlog.InfoDepth(1, ftcg, "(ec)", lom, err)
 	}
 	goi.t.putMirror(lom)
+	objEvent(lom, cmn.EvObjColdGet)
 
 	// load
 	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
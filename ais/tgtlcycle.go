@@ -0,0 +1,38 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// housekeeping callback: periodically run x-lifecycle for each bucket
+// that has lifecycle enabled (see cmn.LifecycleConf)
+// - target-local, no cluster-wide UUID (compare with LRU)
+// - when previous run is still in progress, does nothing (xreg.WprUse)
+func (t *target) lifecycle(int64) time.Duration {
+	if !t.ClusterStarted() || t.regstate.disabled.Load() {
+		return hk.LifecycleIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		if !bck.Props.Lifecycle.IsActive() {
+			return false
+		}
+		rns := xreg.RenewLifecycle(cos.GenUUID(), bck)
+		if rns.Err != nil && !cmn.IsErrXactUsePrev(rns.Err) {
+			nlog.Errorln(t.String(), "failed to start lifecycle for", bck.Cname(""), "err:", rns.Err)
+		}
+		return false
+	})
+	return hk.LifecycleIval
+}
@@ -0,0 +1,269 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Native multipart upload (see apc.ActMptCreate et al.)
+// - ais:// buckets only (no remote backend)
+// - shares in-memory state (active uploads and their parts) with the S3 API (see ais/s3/mpt.go);
+//   the parts are stored as workfiles until the upload gets completed or aborted;
+//   target restart loses active uploads (the workfiles get removed as old work - see space/cleanup.go)
+// - resulting object is the same as the one produced by S3 CompleteMultipartUpload
+//   (same ETag, same xattr that allows to GET by part number)
+
+// POST /v1/objects/<bucket-name>/<object-name> (ActMsg.Name: upload ID)
+func (t *target) mpt(w http.ResponseWriter, r *http.Request, lom *core.LOM, msg *apc.ActMsg) (int, error) {
+	if msg.Action == apc.ActMptCreate {
+		return 0, t.mptCreate(w, lom)
+	}
+	uploadID := msg.Name
+	if uploadID == "" {
+		return 0, fmt.Errorf("%s %s: missing upload ID", msg.Action, lom.Cname())
+	}
+	switch msg.Action {
+	case apc.ActMptListParts:
+		return t.mptListParts(w, r, lom, uploadID)
+	case apc.ActMptComplete:
+		cmsg := &apc.MptCompleteMsg{}
+		if msg.Value != nil {
+			if err := cos.MorphMarshal(msg.Value, cmsg); err != nil {
+				return 0, fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, msg.Action, msg.Value, err)
+			}
+		}
+		return t.mptComplete(w, lom, uploadID, cmsg)
+	default:
+		debug.Assert(msg.Action == apc.ActMptAbort, msg.Action)
+		return t.mptAbort(lom, uploadID)
+	}
+}
+
+func (t *target) mptCreate(w http.ResponseWriter, lom *core.LOM) error {
+	bck := lom.Bck()
+	if bck.IsRemote() {
+		return cmn.NewErrUnsupp("start multipart upload in", bck.Cname("")+" (supported: ais:// buckets with no remote backend)")
+	}
+	uploadID := cos.GenUUID()
+	s3.InitUpload(uploadID, bck.Name, lom.ObjName, nil)
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String(), apc.ActMptCreate, lom.Cname(), uploadID)
+	}
+	writeXid(w, uploadID)
+	return nil
+}
+
+// PUT part: stored as a workfile named <upload-id>.<part-number>.<obj-name>
+func (t *target) mptPutPart(w http.ResponseWriter, r *http.Request, lom *core.LOM, dpq *dpq) (int, error) {
+	var (
+		startTime = mono.NanoTime()
+		uploadID  = dpq.mpt.id
+		bck       = lom.Bck()
+	)
+	partNum, err := s3.ParsePartNum(dpq.mpt.part)
+	if err != nil {
+		return 0, err
+	}
+	if partNum < 1 || partNum > apc.MptMaxParts {
+		return 0, fmt.Errorf("upload %q: invalid part number %d, must be between 1 and %d", uploadID, partNum, apc.MptMaxParts)
+	}
+	if err := s3.CheckUpload(uploadID, bck.Name, lom.ObjName); err != nil {
+		return http.StatusNotFound, err
+	}
+
+	prefix := uploadID + "." + strconv.FormatInt(int64(partNum), 10)
+	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, prefix)
+	partFh, err := lom.CreatePart(wfqn)
+	if err != nil {
+		return 0, err
+	}
+
+	// always md5 (see ETag); plus, optionally, client-provided checksum to validate
+	var (
+		cksumMD5  = cos.NewCksumHash(cos.ChecksumMD5)
+		cksumUser = &cos.CksumHash{}
+		recvCksum *cos.Cksum
+	)
+	if ty, val := r.Header.Get(apc.HdrObjCksumType), r.Header.Get(apc.HdrObjCksumVal); ty != "" && val != "" {
+		if err := cos.ValidateCksumType(ty); err != nil {
+			cos.Close(partFh)
+			_ = cos.RemoveFile(wfqn)
+			return 0, err
+		}
+		recvCksum = cos.NewCksum(ty, val)
+		if ty != cos.ChecksumMD5 {
+			cksumUser = cos.NewCksumHash(ty)
+		}
+	}
+
+	buf, slab := t.gmm.Alloc()
+	size, err := io.CopyBuffer(multiWriter(cksumMD5.H, cksumUser.H, partFh), r.Body, buf)
+	slab.Free(buf)
+	cos.Close(partFh)
+
+	if err == nil {
+		cksumMD5.Finalize()
+		if recvCksum != nil {
+			computed := &cksumMD5.Cksum
+			if cksumUser.H != nil {
+				cksumUser.Finalize()
+				computed = &cksumUser.Cksum
+			}
+			if !computed.Equal(recvCksum) {
+				detail := fmt.Sprintf("upload %q, %s, part %d", uploadID, lom, partNum)
+				err = cos.NewErrDataCksum(computed, recvCksum, detail)
+			}
+		}
+	}
+	if err == nil {
+		err = s3.AddPart(uploadID, &s3.MptPart{MD5: cksumMD5.Value(), FQN: wfqn, Size: size, Num: partNum})
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
+			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
+		}
+		return 0, err
+	}
+	w.Header().Set(apc.HdrObjCksumType, cos.ChecksumMD5)
+	w.Header().Set(apc.HdrObjCksumVal, cksumMD5.Value())
+
+	delta := mono.SinceNano(startTime)
+	vlabs := map[string]string{stats.VlabBucket: bck.Cname(""), stats.VlabXkind: ""}
+	t.statsT.AddWith(
+		cos.NamedVal64{Name: stats.PutSize, Value: size, VarLabs: vlabs},
+		cos.NamedVal64{Name: stats.PutLatency, Value: delta, VarLabs: vlabs},
+		cos.NamedVal64{Name: stats.PutLatencyTotal, Value: delta, VarLabs: vlabs},
+	)
+	return 0, nil
+}
+
+func (t *target) mptListParts(w http.ResponseWriter, r *http.Request, lom *core.LOM, uploadID string) (int, error) {
+	nparts, err := s3.GetParts(uploadID, lom.Bck().Name, lom.ObjName, nil)
+	if err != nil {
+		return http.StatusNotFound, err
+	}
+	parts := make([]apc.MptPart, 0, len(nparts))
+	for _, part := range nparts {
+		parts = append(parts, apc.MptPart{MD5: part.MD5, Size: part.Size, Num: part.Num})
+	}
+	t.writeJSON(w, r, parts, apc.ActMptListParts)
+	return 0, nil
+}
+
+// concatenate the parts (in ascending order) and finalize the object
+func (t *target) mptComplete(w http.ResponseWriter, lom *core.LOM, uploadID string, msg *apc.MptCompleteMsg) (int, error) {
+	var (
+		started = time.Now()
+		bck     = lom.Bck()
+	)
+	for i := 1; i < len(msg.Parts); i++ {
+		if msg.Parts[i] <= msg.Parts[i-1] {
+			return 0, fmt.Errorf("upload %q: part numbers must be strictly ascending (%v)", uploadID, msg.Parts)
+		}
+	}
+	nparts, err := s3.GetParts(uploadID, bck.Name, lom.ObjName, msg.Parts)
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	if len(nparts) == 0 {
+		return 0, fmt.Errorf("upload %q: no parts to complete %s", uploadID, lom.Cname())
+	}
+	var size int64
+	for _, part := range nparts {
+		size += part.Size
+	}
+
+	// <upload-id>.complete.<obj-name>
+	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, uploadID+".complete")
+	wfh, err := lom.CreateWork(wfqn)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		cksumType = lom.CksumConf().Type
+		cksum     = &cos.CksumHash{}
+	)
+	if cksumType != cos.ChecksumNone {
+		cksum = cos.NewCksumHash(cksumType)
+	}
+	buf, slab := t.gmm.Alloc()
+	concatMD5, written, err := _appendMpt(nparts, buf, multiWriter(cksum.H, wfh))
+	slab.Free(buf)
+
+	if err == nil && lom.IsFeatureSet(feat.FsyncPUT) {
+		err = wfh.Sync()
+	}
+	cos.Close(wfh)
+	if err == nil && written != size {
+		err = fmt.Errorf("upload %q %q: expected full size=%d, got %d", uploadID, lom.Cname(), size, written)
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
+			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
+		}
+		return 0, err
+	}
+
+	// same ETag as S3 (compare w/ completeMpt)
+	etag := `"` + cos.ChecksumB2S(cos.UnsafeB(concatMD5), cos.ChecksumMD5) + cmn.AwsMultipartDelim +
+		strconv.Itoa(len(nparts)) + `"`
+	if cksum.H != nil {
+		cksum.Finalize()
+		lom.SetCksum(cksum.Cksum.Clone())
+	}
+	lom.SetSize(size)
+	lom.SetCustomKey(cmn.ETag, etag)
+
+	poi := allocPOI()
+	{
+		poi.t = t
+		poi.atime = started.UnixNano()
+		poi.lom = lom
+		poi.workFQN = wfqn
+		poi.owt = cmn.OwtPut
+	}
+	ecode, err := poi.finalize()
+	freePOI(poi)
+	if err != nil {
+		return ecode, err // (the upload remains active and can be retried or aborted)
+	}
+
+	exists := s3.CleanupUpload(uploadID, lom.FQN, false /*aborted*/)
+	debug.Assert(exists)
+
+	w.Header().Set(cos.HdrETag, etag)
+	vlabs := map[string]string{stats.VlabBucket: bck.Cname(""), stats.VlabXkind: ""}
+	t.statsT.IncWith(stats.PutCount, vlabs)
+	return 0, nil
+}
+
+func (*target) mptAbort(lom *core.LOM, uploadID string) (int, error) {
+	if err := s3.CheckUpload(uploadID, lom.Bck().Name, lom.ObjName); err != nil {
+		return http.StatusNotFound, err
+	}
+	s3.CleanupUpload(uploadID, "", true /*aborted*/)
+	return 0, nil
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/ext/evnotif"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
@@ -57,6 +58,8 @@ type (
 		config     *cmn.Config   // (during this request)
 		resphdr    http.Header   // as implied
 		workFQN    string        // temp fqn to be renamed
+		sgl        *memsys.SGL   // in-memory content when `write_policy.data = delayed` (see tgtdelayed.go)
+		cond       *condReq      // conditional PUT: If-Match, If-None-Match, etc. (see tgtcond.go)
 		atime      int64         // access time.Now()
 		ltime      int64         // mono.NanoTime, to measure latency
 		rltime     int64         // mono.NanoTime, to measure remote bucket latency
@@ -77,6 +80,7 @@ type (
 		t          *target         // this
 		lom        *core.LOM       // obj
 		dpq        *dpq
+		cond       *condReq   // conditional GET: If-Match, If-Modified-Since, etc. (see tgtcond.go)
 		ranges     byteRanges // range read (see https://www.rfc-editor.org/rfc/rfc7233#section-2.1)
 		atime      int64      // access time.Now()
 		ltime      int64      // mono.NanoTime, to measure latency
@@ -155,6 +159,9 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 	if dpq.owt != "" {
 		poi.owt.FromS(dpq.owt)
 	}
+	if poi.owt == cmn.OwtPut && !poi.t2t {
+		poi.cond = newCondReq(r.Header)
+	}
 	if dpq.uuid != "" {
 		// resolve cluster-wide xact "behind" this PUT (promote via a single target won't show up)
 		xctn, err := xreg.GetXact(dpq.uuid)
@@ -171,7 +178,18 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 			poi.size = size
 		}
 	}
-	return poi.putObject()
+	if !poi.delayed() {
+		return poi.putObject()
+	}
+
+	// write_policy.data = delayed
+	poi.sgl = poi.t.gmm.NewSGL(poi.size)
+	ecode, err := poi.putObject()
+	if poi.sgl != nil { // not handed over
+		poi.sgl.Free()
+		dputs.pending.Dec()
+	}
+	return ecode, err
 }
 
 func (poi *putOI) putObject() (ecode int, err error) {
@@ -187,6 +205,14 @@ func (poi *putOI) putObject() (ecode int, err error) {
 
 	poi.ltime = mono.NanoTime()
 
+	// conditional PUT: fail early, prior to receiving the payload (and see fini)
+	if poi.cond != nil {
+		if ecode, err = poi.evalCond(false /*locked*/); err != nil {
+			cos.DrainReader(poi.r)
+			return ecode, err
+		}
+	}
+
 	// if checksums match PUT is a no-op
 	if !poi.skipVC && !poi.coldGET {
 		if poi.lom.EqCksum(poi.cksumToUse) {
@@ -205,7 +231,9 @@ func (poi *putOI) putObject() (ecode int, err error) {
 		goto rerr
 	}
 
-	if ecode, err = poi.finalize(); err != nil {
+	if poi.sgl != nil {
+		poi.delay() // respond now, flush later
+	} else if ecode, err = poi.finalize(); err != nil {
 		goto rerr
 	}
 
@@ -230,7 +258,7 @@ func (poi *putOI) putObject() (ecode int, err error) {
 	}
 	return 0, nil
 rerr:
-	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
+	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t && !cmn.IsErrPrecondition(err) {
 		vlabs := poi._vlabs(true /*detailed*/)
 		poi.t.statsT.IncWith(stats.ErrPutCount, vlabs)
 
@@ -337,6 +365,17 @@ func (poi *putOI) finalize() (ecode int, err error) {
 		}
 	}
 	poi.t.putMirror(poi.lom)
+	switch {
+	case poi.owt < cmn.OwtRebalance:
+		poi.t.replPut(poi.lom)
+		objEvent(poi.lom, cmn.EvObjCreated)
+	case poi.owt == cmn.OwtRebalance:
+		// migrated (rebalance, get-from-neighbor, EC restore): the previous owner may not
+		// have shipped the object's recorded change yet - and now never will (see x-replicate)
+		poi.t.replPut(poi.lom)
+	case poi.coldGET || poi.owt == cmn.OwtGetPrefetchLock: // (prefetch and blob download finalize w/ the latter)
+		objEvent(poi.lom, cmn.EvObjColdGet)
+	}
 	return 0, nil
 }
 
@@ -360,6 +399,16 @@ func (poi *putOI) fini() (ecode int, err error) {
 		lom = poi.lom
 		bck = lom.Bck()
 	)
+	// conditional PUT: evaluate under wlock, prior to writing remote and/or local
+	if poi.cond != nil {
+		debug.Assert(poi.owt == cmn.OwtPut && poi.sgl == nil)
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		if ecode, err = poi.evalCond(true /*locked*/); err != nil {
+			return ecode, err
+		}
+	}
+
 	// put remote
 	if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
 		ecode, err = poi.putRemote()
@@ -392,11 +441,24 @@ func (poi *putOI) fini() (ecode int, err error) {
 		defer lom.Unlock(true)
 	default:
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime) // expecting valid atime
-		lom.Lock(true)
-		defer lom.Unlock(true)
+		if poi.sgl == nil && poi.cond == nil {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+		} // otherwise, delayed PUT that is already wlocked (see putOI.delay), or conditional PUT (above)
 		lom.SetAtimeUnix(poi.atime)
 	}
 
+	// keep the current object as noncurrent version (see tgtversions)
+	var (
+		cur         *core.LOM
+		maxVersions int
+	)
+	if poi.owt == cmn.OwtPut && bck.IsAIS() {
+		if vc := lom.VersionConf(); vc.Enabled && vc.MaxVersions > 0 {
+			cur, maxVersions = poi.linkVersion(), vc.MaxVersions
+		}
+	}
+
 	// ais versioning
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		switch {
@@ -414,8 +476,15 @@ func (poi *putOI) fini() (ecode int, err error) {
 
 	// done
 	if err := lom.RenameFinalize(poi.workFQN); err != nil {
+		if cur != nil {
+			cur.UnlinkVersion()
+			core.FreeLOM(cur)
+		}
 		return 0, err
 	}
+	if cur != nil {
+		poi.retainVersion(cur, maxVersions)
+	}
 	if lom.HasCopies() {
 		if errdc := lom.DelAllCopies(); errdc != nil {
 			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
@@ -469,7 +538,9 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		}{}
 		ckconf = poi.lom.CksumConf()
 	)
-	if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
+	if poi.sgl != nil {
+		lmfh = sglw{poi.sgl} // write_policy.data = delayed
+	} else if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
 		return nil, nil, nil, err
 	}
 	if poi.size <= 0 {
@@ -583,6 +654,9 @@ func (poi *putOI) validateCksum(c *cmn.CksumConf) (v bool) {
 
 func (goi *getOI) getObject() (ecode int, err error) {
 	debug.Assert(!goi.unlocked)
+	if served, err := goi.getDelayed(); served {
+		return 0, err // (write_policy.data = delayed)
+	}
 	goi.lom.Lock(false)
 	ecode, err = goi.get()
 	if !goi.unlocked {
@@ -721,7 +795,7 @@ do: // retry uplock or ec-recovery, the latter only once
 		//
 		// three alternative ways to cold GET
 		//
-		if goi.dpq.arch.path == "" && goi.dpq.arch.regx == "" &&
+		if goi.dpq.arch.path == "" && goi.dpq.arch.regx == "" && goi.cond == nil &&
 			(ckconf.Type == cos.ChecksumNone || (!ckconf.ValidateColdGet && !ckconf.EnableReadRange)) {
 			if goi.ranges.Range == "" && goi.lom.IsFeatureSet(feat.StreamingColdGET) {
 				// 1)
@@ -743,6 +817,15 @@ do: // retry uplock or ec-recovery, the latter only once
 
 	// read locally and stream back
 fin:
+	if goi.cond != nil {
+		if ecode, err = goi.cond.eval(goi.lom, true /*exists*/, true /*get*/); err != nil {
+			if cmn.IsErrNotModified(err) {
+				goi.notModified()
+				return 0, nil
+			}
+			return ecode, err
+		}
+	}
 	ecode, err = goi.txfini()
 	if err == nil {
 		return 0, nil
@@ -1697,6 +1780,10 @@ func (coi *coi) _regular(t *target, lom, dst *core.LOM, lcopy bool) (res xs.CoiR
 		if coi.Finalize {
 			t.putMirror(dst2)
 		}
+		if !lcopy {
+			t.replPut(dst2)
+			objEvent(dst2, cmn.EvObjCreated)
+		}
 	}
 	if dst2 != nil {
 		core.FreeLOM(dst2)
@@ -1752,6 +1839,7 @@ func (coi *coi) _send(t *target, lom *core.LOM, sargs *sendArgs) (res xs.CoiRes)
 	// do
 	if sargs.dm != nil {
 		res.Err = coi._dm(lom /*for attrs*/, sargs)
+		res.Async = coi.SentCB != nil // (transport calls back even when failing to send)
 	} else {
 		res.Err = coi.put(t, sargs)
 	}
@@ -1770,8 +1858,12 @@ func (coi *coi) _dm(lom *core.LOM, sargs *sendArgs) error {
 		hdr.ObjName = sargs.objNameTo
 		hdr.ObjAttrs.CopyFrom(oa, false /*skip cksum*/)
 	}
+	sentCB := coi.SentCB
 	o.Callback = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, _ error) {
 		core.FreeLOM(lom)
+		if sentCB != nil {
+			sentCB()
+		}
 	}
 	return sargs.dm.Send(o, sargs.reader, sargs.tsi)
 }
@@ -1967,6 +2059,8 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		}
 	}
 	a.t.putMirror(a.lom)
+	a.t.replPut(a.lom)
+	objEvent(a.lom, cmn.EvObjCreated)
 	return nil
 }
 
@@ -1974,6 +2068,11 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 // put mirorr (main)
 //
 
+// object event notifications (see cmn.EventsConf)
+func objEvent(lom *core.LOM, typ string) {
+	evnotif.Emit(lom.Bck(), typ, lom.ObjName, "" /*src*/, lom.Version(), lom.Lsize(true))
+}
+
 func (t *target) putMirror(lom *core.LOM) {
 	mconfig := lom.MirrorConf()
 	if !mconfig.Enabled {
@@ -24,8 +24,11 @@ import (
 )
 
 const (
-	testMountpath = "/tmp/ais-test-mpath" // mpath is created and deleted during the test
-	testBucket    = "bck"
+	testMountpath  = "/tmp/ais-test-mpath" // mpath is created and deleted during the test
+	testBucket     = "bck"
+	testBucketVer  = "bck-versioned"  // retains noncurrent versions
+	testBucketDly  = "bck-delayed"    // write_policy.data = delayed
+	testBucketRepl = "bck-replicated" // replication.enabled = true
 )
 
 var (
@@ -60,6 +63,7 @@ func TestMain(m *testing.M) {
 	fs.TestNew(nil)
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
 
 	// target
 	config := cmn.GCO.Get()
@@ -84,8 +88,26 @@ func TestMain(m *testing.M) {
 			Type: cos.ChecksumNone,
 		},
 	})
+	vbck := meta.NewBck(testBucketVer, apc.AIS, cmn.NsGlobal)
+	bmd.add(vbck, &cmn.Bprops{
+		Cksum:      cmn.CksumConf{Type: cos.ChecksumNone},
+		Versioning: cmn.VersionConf{Enabled: true, MaxVersions: 2},
+	})
+	rbck := meta.NewBck(testBucketRepl, apc.AIS, cmn.NsGlobal)
+	bmd.add(rbck, &cmn.Bprops{
+		Cksum:       cmn.CksumConf{Type: cos.ChecksumNone},
+		Replication: cmn.ReplConf{Enabled: true, Dst: "ais://@remais/dst"},
+	})
+	dbck := meta.NewBck(testBucketDly, apc.AIS, cmn.NsGlobal)
+	bmd.add(dbck, &cmn.Bprops{
+		Cksum:       cmn.CksumConf{Type: cos.ChecksumOneXxh},
+		WritePolicy: cmn.WritePolicyConf{Data: apc.WriteDelayed},
+	})
 	t.owner.bmd.putPersist(bmd, nil)
 	fs.CreateBucket(bck.Bucket(), false /*nilbmd*/)
+	fs.CreateBucket(vbck.Bucket(), false /*nilbmd*/)
+	fs.CreateBucket(dbck.Bucket(), false /*nilbmd*/)
+	fs.CreateBucket(rbck.Bucket(), false /*nilbmd*/)
 
 	m.Run()
 }
@@ -0,0 +1,100 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs/replq"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// cross-cluster async replication (see cmn.ReplConf):
+// - record (ie., append to the bucket's change log) PUT and DELETE events
+// - (re)start x-replicate when there's something to ship
+
+func (t *target) replPut(lom *core.LOM) { t._repl(lom, replq.OpPut) }
+func (t *target) replDel(lom *core.LOM) { t._repl(lom, replq.OpDel) }
+
+func (t *target) _repl(lom *core.LOM, op byte) {
+	bck := lom.Bck()
+	if !bck.Props.Replication.Match(lom.ObjName) {
+		return
+	}
+	q, err := replq.Get(bck.Bucket(), bck.Props.BID)
+	if err == nil {
+		err = q.Append(op, lom.ObjName)
+	}
+	if err != nil {
+		nlog.Errorln(t.String(), "failed to record", string(op), lom.Cname(), "for replication, err:", err)
+		return
+	}
+	t._kickRepl(bck, q)
+}
+
+// start x-replicate unless already running or recently failed
+// (in the latter case, housekeeping will take care of it - see t.replicate below)
+func (t *target) _kickRepl(bck *meta.Bck, q *replq.Queue) {
+	if q.Busy() || q.FailedWithin(hk.ReplIval) {
+		return
+	}
+	rns := xreg.RenewReplicate(cos.GenUUID(), bck)
+	if rns.Err != nil && !cmn.IsErrXactUsePrev(rns.Err) {
+		nlog.Errorln(t.String(), "failed to start replication for", bck.Cname(""), "err:", rns.Err)
+	}
+}
+
+// housekeeping callback: (re)start x-replicate for each bucket that has pending changes
+// - e.g., upon restart, or after x-replicate has failed
+func (t *target) replicate(int64) time.Duration {
+	if !t.ClusterStarted() || t.regstate.disabled.Load() {
+		return hk.ReplIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		if !bck.Props.Replication.Enabled {
+			return false
+		}
+		q, err := replq.Get(bck.Bucket(), bck.Props.BID)
+		if err != nil {
+			nlog.Errorln(t.String(), "failed to open replication log for", bck.Cname(""), "err:", err)
+			return false
+		}
+		if q.Pending() > 0 {
+			t._kickRepl(bck, q)
+		}
+		return false
+	})
+	return hk.ReplIval
+}
+
+// apc.WhatReplStats
+func (t *target) replStats() []*cmn.ReplStats {
+	var (
+		all []*cmn.ReplStats
+		bmd = t.owner.bmd.get()
+	)
+	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
+		conf := &bck.Props.Replication
+		if !conf.Enabled {
+			return false
+		}
+		q, err := replq.Get(bck.Bucket(), bck.Props.BID)
+		if err != nil {
+			st := &cmn.ReplStats{Bck: *bck.Bucket(), Dst: conf.Dst, LastErr: err.Error()}
+			all = append(all, st)
+			return false
+		}
+		all = append(all, q.Stats(conf.Dst))
+		return false
+	})
+	return all
+}
@@ -0,0 +1,59 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/replq"
+	"github.com/NVIDIA/aistore/tools/readers"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// the previous owner drops the PUT of a migrated object (see x-replicate) -
+// the new owner must record it
+func TestReplRebalance(t *testing.T) {
+	var (
+		bck = &cmn.Bck{Name: testBucketRepl, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		tgt = core.T.(*target)
+	)
+	lom := core.AllocLOM("migrated")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+
+	q, err := replq.Get(bck, lom.Bprops().BID)
+	tassert.CheckFatal(t, err)
+	defer replq.Drop(bck)
+	q.SetXid("test") // (busy: don't start x-replicate)
+	defer q.SetXid("")
+
+	for _, owt := range []cmn.OWT{cmn.OwtRebalance, cmn.OwtPut} {
+		r, _ := readers.NewRand(cos.KiB, cos.ChecksumNone)
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       tgt,
+			lom:     lom,
+			r:       r,
+			workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+			config:  cmn.GCO.Get(),
+			owt:     owt,
+			skipVC:  true,
+		}
+		_, err := poi.putObject()
+		tassert.CheckFatal(t, err)
+
+		evs, pos, err := q.Read(16, nil)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(evs) == 1, "%s: expecting PUT recorded, got %+v", owt, evs)
+		tassert.Errorf(t, evs[0].Op == replq.OpPut && evs[0].Name == lom.ObjName, "%s: unexpected %+v", owt, evs[0])
+		tassert.CheckFatal(t, q.Commit(pos))
+	}
+}
@@ -52,9 +52,17 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.putCopyMpt(w, r, config, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
-		if q.Has(s3.QparamMptUploadID) {
+		switch {
+		case q.Has(s3.QparamMptUploadID):
 			t.abortMpt(w, r, apiItems, q)
-		} else {
+		case q.Has(s3.QparamTagging):
+			bck, ecode, err := meta.InitByNameOnly(apiItems[0], t.owner.bmd)
+			if err != nil {
+				s3.WriteErr(w, r, err, ecode)
+				return
+			}
+			t.delObjTaggingS3(w, r, bck, s3.ObjName(apiItems))
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
@@ -79,6 +87,8 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 	}
 	q := r.URL.Query()
 	switch {
+	case q.Has(s3.QparamTagging):
+		t.putObjTaggingS3(w, r, bck, s3.ObjName(items))
 	case q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID):
 		if r.Header.Get(cos.S3HdrObjSrc) != "" {
 			// TODO: copy another object (or its range) => part of the specified multipart upload.
@@ -193,6 +203,15 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 			return
 		}
 	}
+	corsActual(w.Header(), r.Header, r.Method, lom.Bprops())
+	if v := r.Header.Get(cos.S3HdrObjTagging); v != "" {
+		tags, err := cmn.ParseObjTags(v)
+		if err != nil {
+			s3.WriteErrCode(w, r, err, http.StatusBadRequest, s3.ErrCodeInvalidTag)
+			return
+		}
+		lom.SetCustomKey(cmn.TagsObjMD, cmn.ObjTags2S(tags))
+	}
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
@@ -246,6 +265,10 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 	objName := s3.ObjName(items)
+	if q.Has(s3.QparamTagging) {
+		t.getObjTaggingS3(w, r, bck, objName)
+		return
+	}
 	if q.Has(s3.QparamMptPartNo) {
 		if cmn.Rom.FastV(5, cos.SmoduleS3) {
 			nlog.Infoln("getMptPart", bck.String(), objName, q)
@@ -296,6 +319,12 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	corsActual(w.Header(), r.Header, r.Method, lom.Bprops())
+	if ver := r.URL.Query().Get(apc.QparamVersionID); ver != "" && bck.IsAIS() {
+		headVersionS3(w, r, lom, ver)
+		return
+	}
+	waitDelayed(lom)
 	exists := true
 	err = lom.Load(true /*cache it*/, false /*locked*/)
 	if err != nil {
@@ -357,18 +386,23 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	ecode, err = t.DeleteObject(lom, false)
-	if err != nil {
-		name := lom.Cname()
-		if ecode == http.StatusNotFound {
-			s3.WriteErr(w, r, cos.NewErrNotFound(t, name), http.StatusNotFound)
-		} else {
-			s3.WriteErr(w, r, fmt.Errorf("error deleting %s: %v", name, err), ecode)
+	if ver := r.URL.Query().Get(apc.QparamVersionID); ver != "" {
+		ecode, err = t.delVersion(lom, ver)
+		if err == nil {
+			w.Header().Set(cos.S3VersionHeader, ver)
+			return
 		}
+	} else if ecode, err = t.DeleteObject(lom, false); err == nil {
+		// EC cleanup if EC is enabled
+		ec.ECM.CleanupObject(lom)
 		return
 	}
-	// EC cleanup if EC is enabled
-	ec.ECM.CleanupObject(lom)
+	name := lom.Cname()
+	if ecode == http.StatusNotFound {
+		s3.WriteErr(w, r, cos.NewErrNotFound(t, name), http.StatusNotFound)
+	} else {
+		s3.WriteErr(w, r, fmt.Errorf("error deleting %s: %v", name, err), ecode)
+	}
 }
 
 // POST /s3/<bucket-name>/<object-name>
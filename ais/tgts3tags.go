@@ -0,0 +1,131 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// S3 object tagging:
+// - tags are stored in LOM custom metadata (`cmn.TagsObjMD`)
+// - for remote buckets, put/delete is passed through to the backend if supported (see `core.ObjTagger`)
+// - see also: `x-amz-tagging` in putObjS3 and `x-amz-tagging-count` in s3.SetS3Headers
+
+// GET /s3/<bucket-name>/<object-name>?tagging
+func (t *target) getObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			s3.WriteErr(w, r, cos.NewErrNotFound(t, lom.Cname()), http.StatusNotFound)
+		} else {
+			s3.WriteErr(w, r, err, 0)
+		}
+		return
+	}
+	v, _ := lom.GetCustomKey(cmn.TagsObjMD)
+	tags, err := cmn.ParseObjTags(v)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	sgl := t.gmm.NewSGL(0)
+	s3.NewTagging(tags).MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo2(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?tagging
+// (replaces existing tags, if any)
+func (t *target) putObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	tg := &s3.Tagging{}
+	if err := xml.NewDecoder(r.Body).Decode(tg); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	tags, err := tg.ToNative()
+	if err != nil {
+		s3.WriteErrCode(w, r, err, http.StatusBadRequest, s3.ErrCodeInvalidTag)
+		return
+	}
+	t._setObjTagsS3(w, r, bck, objName, tags)
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+func (t *target) delObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if t._setObjTagsS3(w, r, bck, objName, "") {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (t *target) _setObjTagsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName, tags string) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+
+	errLoad := lom.Load(false /*cache it*/, true /*locked*/)
+	if errLoad != nil && !cos.IsNotExist(errLoad, 0) {
+		s3.WriteErr(w, r, errLoad, 0)
+		return false
+	}
+	exists := errLoad == nil
+
+	// remote first
+	var tagged bool
+	if bck.IsRemote() {
+		bp := t.Backend(bck)
+		if rlbp, ok := bp.(*rlbackend); ok {
+			bp = rlbp.Backend
+		}
+		if tagger, ok := bp.(core.ObjTagger); ok {
+			if ecode, err := tagger.PutObjTags(context.Background(), lom, tags); err != nil {
+				s3.WriteErr(w, r, err, ecode)
+				return false
+			}
+			tagged = true
+		}
+	}
+	if !exists {
+		if !tagged {
+			s3.WriteErr(w, r, cos.NewErrNotFound(t, lom.Cname()), http.StatusNotFound)
+			return false
+		}
+		return true // remote only (not present in the cluster)
+	}
+
+	if tags == "" {
+		delete(lom.GetCustomMD(), cmn.TagsObjMD)
+	} else {
+		lom.SetCustomKey(cmn.TagsObjMD, tags)
+	}
+	if err := lom.Persist(); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if cmn.Rom.FastV(5, cos.SmoduleS3) {
+		nlog.Infoln("set-tags", lom.Cname(), tags)
+	}
+	return true
+}
@@ -368,7 +368,18 @@ func (t *target) setBprops(c *txnSrv) (string, error) {
 			xact.GoRunW(xctn)
 			xid = xctn.ID()
 		}
-		if _, reec := _reEC(bprops, nprops, c.bck, nil /*smap*/); reec {
+		if _, reec := _reEC(bprops, nprops, c.bck, nil /*smap*/); reec && bprops.EC.Enabled {
+			// (D, P) and/or profiles changed: convert existing objects online
+			rns := xreg.RenewECReencode(c.uuid, c.bck)
+			if rns.Err != nil {
+				return "", rns.Err
+			}
+			if xid == "" {
+				xid = rns.Entry.Get().ID()
+			} else {
+				xid = "" // ditto
+			}
+		} else if reec {
 			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}
 			xreg.DoAbort(flt, errors.New("re-ec"))
 
@@ -672,7 +683,7 @@ func (t *target) tcobjs(c *txnSrv, msg *cmn.TCOMsg, disableDM bool) (xid string,
 		}
 		// begin
 		custom := &xreg.TCObjsArgs{BckFrom: bckFrom, BckTo: bckTo, Msg: &msg.TCOMsg, DisableDM: disableDM}
-		rns := xreg.RenewTCObjs(c.msg.Action /*kind*/, custom)
+		rns := xreg.RenewTCObjs("" /*generate*/, c.msg.Action /*kind*/, custom)
 		if rns.Err != nil {
 			nlog.Errorf("%s: %q %+v %v", t, c.uuid, c.msg, rns.Err)
 			return xid, rns.Err
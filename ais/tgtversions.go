@@ -0,0 +1,257 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Noncurrent object versions (ais:// buckets with versioning enabled and `versioning.max_versions` > 0):
+// - user PUT that overwrites an existing object makes the latter noncurrent (see core/lversion)
+// - at most `max_versions` most recent noncurrent versions are retained, the rest is removed
+// - GET (native and S3) and HEAD (S3) with `versionId` query return the specified version
+// - DELETE with `versionId` removes the specified version; deleting the current version
+//   does not make any of the noncurrent ones current
+// - S3 ListObjectVersions lists current and noncurrent versions (see proxy.listVersionsS3)
+// - limitations: noncurrent versions are not erasure coded, mirrored, or migrated by global rebalance
+
+// user PUT: keep the current object as noncurrent version
+// (called under wlock, prior to finalizing the new one)
+// returns the (hard-linked) current object - the caller then calls retainVersion or unlinks
+func (poi *putOI) linkVersion() *core.LOM {
+	lom := poi.lom
+	cur := core.AllocLOM(lom.ObjName)
+	if err := cur.InitBck(lom.Bucket()); err != nil {
+		core.FreeLOM(cur)
+		return nil
+	}
+	if err := cur.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) {
+			nlog.Warningln(poi.loghdr(), "failed to load current version:", err)
+		}
+		core.FreeLOM(cur)
+		return nil
+	}
+	// (in case the object was not loaded - see skip_vc)
+	lom.SetVersion(cur.Version())
+
+	if err := cur.LinkVersion(); err != nil {
+		nlog.Warningln(poi.loghdr(), "failed to retain current version:", err)
+		core.FreeLOM(cur)
+		return nil
+	}
+	return cur
+}
+
+// (called under wlock, after the new object has been finalized)
+func (poi *putOI) retainVersion(cur *core.LOM, maxVersions int) {
+	defer core.FreeLOM(cur)
+	if err := cur.RetainVersion(); err != nil {
+		nlog.Warningln(poi.loghdr(), "failed to retain current version:", err)
+		return
+	}
+	if n, err := cur.TrimVersions(maxVersions); err != nil {
+		nlog.Warningln(poi.loghdr(), "failed to remove noncurrent version(s):", err)
+	} else if n > 0 && cmn.Rom.FastV(5, cos.SmoduleAIS) {
+		nlog.Infoln(poi.loghdr(), "removed", n, "noncurrent version(s)")
+	}
+}
+
+// GET noncurrent version
+// returns false when the requested version is the current one (to proceed with regular GET)
+func (t *target) getVersion(w http.ResponseWriter, r *http.Request, dpq *dpq, lom *core.LOM) (bool, error) {
+	if !lom.Bck().IsAIS() {
+		return true, cmn.NewErrUnsupp("get version "+dpq.versionID+" of", lom.Cname()+" (not an ais:// bucket)")
+	}
+	lom.Lock(false)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err == nil && lom.Version() == dpq.versionID {
+		lom.Unlock(false)
+		return false, nil
+	}
+	defer lom.Unlock(false)
+
+	vlom, err := lom.LoadVersion(dpq.versionID)
+	if err != nil {
+		return true, err
+	}
+	defer core.FreeLOM(vlom)
+
+	fh, err := vlom.Open()
+	if err != nil {
+		return true, err
+	}
+	defer cos.Close(fh)
+
+	var (
+		size = vlom.Lsize()
+		hdr  = w.Header()
+	)
+	if dpq.isS3 {
+		hdr.Set(cos.S3VersionHeader, dpq.versionID)
+		s3.SetS3Headers(hdr, vlom)
+	} else {
+		cmn.ToHeader(vlom.ObjAttrs(), hdr, 0)
+	}
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
+	hdr.Set(cos.HdrContentType, cos.ContentBinary)
+
+	buf, slab := t.gmm.AllocSize(min(size, memsys.DefaultBuf2Size))
+	written, err := cos.CopyBuffer(w, fh, buf)
+	slab.Free(buf)
+	if err != nil {
+		// (too late to respond with error)
+		nlog.Warningln("GET", vlom.Cname(), "version", dpq.versionID, "[", err, "]")
+		return true, nil
+	}
+
+	vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
+	t.statsT.IncWith(stats.GetCount, vlabs)
+	t.statsT.AddWith(
+		cos.NamedVal64{Name: stats.GetSize, Value: written, VarLabs: vlabs},
+		cos.NamedVal64{Name: stats.GetThroughput, Value: written, VarLabs: vlabs},
+	)
+	return true, nil
+}
+
+// HEAD noncurrent (or current) version via S3 API
+func headVersionS3(w http.ResponseWriter, r *http.Request, lom *core.LOM, ver string) {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	vlom := lom
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil || lom.Version() != ver {
+		if vlom, err = lom.LoadVersion(ver); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		defer core.FreeLOM(vlom)
+	}
+	hdr := w.Header()
+	hdr.Set(cos.S3VersionHeader, ver)
+	s3.SetS3Headers(hdr, vlom)
+	hdr.Set(cos.HdrContentLength, strconv.FormatInt(vlom.Lsize(), 10))
+	if cksum := vlom.Checksum(); cksum != nil && cksum.Ty() != cos.ChecksumNone {
+		hdr.Set(cos.S3MetadataChecksumType, cksum.Ty())
+		hdr.Set(cos.S3MetadataChecksumVal, cksum.Val())
+	}
+}
+
+// DELETE a given version; deleting the current one is the same as regular DELETE
+func (t *target) delVersion(lom *core.LOM, ver string) (int, error) {
+	if !lom.Bck().IsAIS() {
+		return 0, cmn.NewErrUnsupp("delete version "+ver+" of", lom.Cname()+" (not an ais:// bucket)")
+	}
+	lom.Lock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil && lom.Version() == ver {
+		// still under wlock (compare w/ t.DeleteObject)
+		ecode, err, _ := t.delobj(lom, false /*evict*/)
+		lom.Unlock(true)
+		t.delstats(lom, false /*evict*/, ecode, err, false /*isback*/)
+		if err == nil && ecode == 0 {
+			ec.ECM.CleanupObject(lom)
+		}
+		return ecode, err
+	}
+	err := lom.RemoveVersion(ver)
+	lom.Unlock(true)
+	if err != nil {
+		if cos.IsNotExist(err, 0) {
+			return http.StatusNotFound, err
+		}
+		return 0, err
+	}
+	return 0, nil
+}
+
+//
+// list noncurrent versions (this target)
+//
+
+type lsvCtx struct {
+	bck     *meta.Bck
+	lsmsg   *apc.LsoMsg
+	lst     *cmn.LsoRes
+	resolve *fs.ObjVersionContentResolver
+}
+
+func (t *target) listVersions(w http.ResponseWriter, r *http.Request, bck *meta.Bck, lsmsg *apc.LsoMsg) {
+	if !bck.IsAIS() {
+		t.writeErr(w, r, cmn.NewErrUnsupp("list noncurrent versions of", bck.Cname("")+" (not an ais:// bucket)"))
+		return
+	}
+	ctx := &lsvCtx{
+		bck:     bck,
+		lsmsg:   lsmsg,
+		lst:     &cmn.LsoRes{UUID: lsmsg.UUID},
+		resolve: fs.CSM.Resolver(fs.ObjVersionType).(*fs.ObjVersionContentResolver),
+	}
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{
+			Mi:       mi,
+			Bck:      *bck.Bucket(),
+			CTs:      []string{fs.ObjVersionType},
+			Prefix:   lsmsg.Prefix,
+			Callback: ctx.cb,
+		}
+		if err := fs.Walk(opts); err != nil && !cos.IsNotExist(err, 0) {
+			t.writeErr(w, r, err)
+			return
+		}
+	}
+	t.writeJSON(w, r, ctx.lst, apc.ActListVersions)
+}
+
+func (ctx *lsvCtx) cb(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	var parsed fs.ParsedFQN
+	if err := parsed.Init(fqn); err != nil {
+		return nil
+	}
+	objName, ver, ok := ctx.resolve.ParseVersion(parsed.ObjName)
+	if !ok || !strings.HasPrefix(objName, ctx.lsmsg.Prefix) {
+		return nil
+	}
+
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(ctx.bck.Bucket()); err != nil {
+		return err
+	}
+	vlom, err := lom.LoadVersion(ver)
+	if err != nil {
+		nlog.Warningln("list-versions:", lom.Cname(), "version", ver, "[", err, "]")
+		return nil
+	}
+	en := &cmn.LsoEnt{
+		Name:     objName,
+		Version:  ver,
+		Size:     vlom.Lsize(),
+		Checksum: vlom.Checksum().Value(),
+		Atime:    cos.FormatNanoTime(vlom.AtimeUnix(), ctx.lsmsg.TimeFormat),
+		Flags:    apc.EntryIsCached,
+	}
+	if md := vlom.GetCustomMD(); len(md) > 0 {
+		en.Custom = cmn.CustomMD2S(md)
+	}
+	core.FreeLOM(vlom)
+	ctx.lst.Entries = append(ctx.lst.Entries, en)
+	return nil
+}
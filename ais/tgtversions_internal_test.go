@@ -0,0 +1,170 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/readers"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestNoncurrentVersions(t *testing.T) {
+	var (
+		bck = &cmn.Bck{Name: testBucketVer, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		tgt = core.T.(*target)
+		lom = core.AllocLOM("versioned/obj")
+	)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+
+	// PUT sizes: 1KiB, 2KiB, ..., 5KiB
+	for i := 1; i <= 5; i++ {
+		r, _ := readers.NewRand(int64(i)*cos.KiB, cos.ChecksumNone)
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       tgt,
+			lom:     lom,
+			r:       r,
+			workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+			config:  cmn.GCO.Get(),
+			skipVC:  true,
+		}
+		_, err := poi.putObject()
+		tassert.CheckFatal(t, err)
+	}
+	tassert.CheckFatal(t, lom.Load(false, false))
+	tassert.Fatalf(t, lom.Version() == "5", "expecting current version 5, got %q", lom.Version())
+
+	// at most 2 noncurrent versions, the most recent first
+	versions, err := lom.NoncurrentVersions()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(versions) == 2 && versions[0] == "4" && versions[1] == "3", "unexpected versions %v", versions)
+
+	vlom, err := lom.LoadVersion("3")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, vlom.Lsize() == 3*cos.KiB, "expecting size %d, got %d", 3*cos.KiB, vlom.Lsize())
+	core.FreeLOM(vlom)
+
+	// GET (noncurrent and current)
+	for _, test := range []struct {
+		ver     string
+		size    int
+		current bool
+	}{{"4", 4 * cos.KiB, false}, {"5", 5 * cos.KiB, true}} {
+		dpq := &dpq{versionID: test.ver}
+		w := httptest.NewRecorder()
+		done, err := tgt.getVersion(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody), dpq, lom)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, done != test.current, "version %s: expecting done=%t", test.ver, !test.current)
+		if done {
+			tassert.Errorf(t, w.Body.Len() == test.size, "version %s: expecting %d bytes, got %d", test.ver, test.size, w.Body.Len())
+			tassert.Errorf(t, w.Header().Get(apc.HdrObjVersion) == test.ver, "version %s: got %q",
+				test.ver, w.Header().Get(apc.HdrObjVersion))
+		}
+	}
+	_, err = tgt.getVersion(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody), &dpq{versionID: "1"}, lom)
+	tassert.Errorf(t, cos.IsNotExist(err, 0), "expecting not-found, got %v", err)
+
+	// list
+	w := httptest.NewRecorder()
+	tgt.listVersions(w, httptest.NewRequest(http.MethodGet, "/", http.NoBody), meta.CloneBck(bck), &apc.LsoMsg{Prefix: "versioned/"})
+	var lst cmn.LsoRes
+	tassert.CheckFatal(t, cos.JSON.Unmarshal(w.Body.Bytes(), &lst))
+	tassert.Fatalf(t, len(lst.Entries) == 2, "expecting 2 noncurrent versions, got %d", len(lst.Entries))
+	for _, en := range lst.Entries {
+		tassert.Errorf(t, en.Name == lom.ObjName, "unexpected name %q", en.Name)
+	}
+
+	// DELETE
+	ecode, err := tgt.delVersion(lom, "4")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, ecode == 0, "unexpected status %d", ecode)
+	ecode, _ = tgt.delVersion(lom, "4")
+	tassert.Errorf(t, ecode == http.StatusNotFound, "expecting 404, got %d", ecode)
+
+	versions, err = lom.NoncurrentVersions()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(versions) == 1 && versions[0] == "3", "unexpected versions %v", versions)
+
+	_, err = tgt.delVersion(lom, "5") // current
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, lom.Load(false, false) != nil, "expecting current version deleted")
+	_, err = tgt.delVersion(lom, "3")
+	tassert.CheckFatal(t, err)
+}
+
+// re-created object must not reuse (and overwrite) version IDs of the deleted one
+func TestNoncurrentVersionsRecreate(t *testing.T) {
+	var (
+		bck = &cmn.Bck{Name: testBucketVer, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		tgt = core.T.(*target)
+		lom = core.AllocLOM("versioned/recreated")
+	)
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+
+	// (new LOM for each PUT, as in: new request)
+	put := func(size int64) {
+		lom := core.AllocLOM(lom.ObjName)
+		defer core.FreeLOM(lom)
+		tassert.CheckFatal(t, lom.InitBck(bck))
+		r, _ := readers.NewRand(size, cos.ChecksumNone)
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       tgt,
+			lom:     lom,
+			r:       r,
+			workFQN: fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfilePut),
+			config:  cmn.GCO.Get(),
+			skipVC:  true,
+		}
+		_, err := poi.putObject()
+		tassert.CheckFatal(t, err)
+	}
+
+	// PUT, overwrite, DELETE (noncurrent version 1 remains)
+	put(cos.KiB)
+	put(2 * cos.KiB)
+	_, err := tgt.DeleteObject(lom, false /*evict*/)
+	tassert.CheckFatal(t, err)
+
+	// re-PUT, overwrite
+	put(3 * cos.KiB)
+	put(4 * cos.KiB)
+	tassert.CheckFatal(t, lom.Load(false, false))
+	tassert.Fatalf(t, lom.Version() == "3", "expecting current version 3, got %q", lom.Version())
+
+	// (version 2 of the deleted object was not retained)
+	versions, err := lom.NoncurrentVersions()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(versions) == 2 && versions[0] == "2" && versions[1] == "1", "unexpected versions %v", versions)
+
+	// original content
+	for _, test := range []struct {
+		ver  string
+		size int64
+	}{{"1", cos.KiB}, {"2", 3 * cos.KiB}} {
+		vlom, err := lom.LoadVersion(test.ver)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, vlom.Lsize() == test.size, "version %s: expecting size %d, got %d", test.ver, test.size, vlom.Lsize())
+		core.FreeLOM(vlom)
+	}
+
+	// cleanup
+	for _, ver := range []string{"3", "2", "1"} {
+		_, err = tgt.delVersion(lom, ver)
+		tassert.CheckFatal(t, err)
+	}
+}
@@ -272,14 +272,36 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
+	case apc.ActLsoIndex:
+		rns := xreg.RenewBckLsoIndex(args.ID, bck)
+		return xid, rns.Err
+	case apc.ActECReencode:
+		if !bck.Props.EC.Enabled {
+			return xid, fmt.Errorf("%s: bucket %s is not erasure coded", t, bck.Cname(""))
+		}
+		rns := xreg.RenewECReencode(args.ID, bck)
+		return xid, rns.Err
+	case apc.ActLifecycle:
+		if !bck.Props.Lifecycle.IsActive() {
+			return xid, fmt.Errorf("%s: bucket %s has no active lifecycle rules", t, bck.Cname(""))
+		}
+		rns := xreg.RenewLifecycle(args.ID, bck)
+		return xid, rns.Err
+	case apc.ActReplicate:
+		if !bck.Props.Replication.Enabled {
+			return xid, fmt.Errorf("%s: bucket %s does not have replication enabled", t, bck.Cname(""))
+		}
+		rns := xreg.RenewReplicate(args.ID, bck)
+		return xid, rns.Err
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
 		lom := core.AllocLOM(msg.Name)
 		err := lom.InitBck(&args.Bck)
 		if err == nil {
 			params := &core.BlobParams{
-				Lom: lom,
-				Msg: &apc.BlobMsg{}, // default tunables when executing via x-start API
+				Lom:       lom,
+				Msg:       &apc.BlobMsg{}, // default tunables when executing via x-start API
+				Resumable: true,
 			}
 			xid, _, err = t.blobdl(params, nil /*oa*/)
 		}
@@ -0,0 +1,280 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// resumable xactions (see xact/xckpt):
+// - suspend upon shutdown, and wait for them to checkpoint
+// - resume pending ones (upon restart, or when suspended at runtime), one kind-specific way or another
+
+const suspendTimeout = 5 * time.Second
+
+func (t *target) suspendX() {
+	ids := xreg.SuspendResumable()
+	if len(ids) == 0 {
+		return
+	}
+	sleep := cos.ProbingFrequency(suspendTimeout)
+	for elapsed := time.Duration(0); elapsed < suspendTimeout; elapsed += sleep {
+		n := 0
+		for _, id := range ids {
+			if !xckpt.IsPending(id) {
+				n++
+			}
+		}
+		if n == 0 {
+			nlog.Infoln(t.String(), "suspended", ids)
+			return
+		}
+		time.Sleep(sleep)
+	}
+	nlog.Warningln(t.String(), "timed out waiting for", ids, "to checkpoint")
+}
+
+// housekeeping callback
+func (t *target) resumeX(int64) time.Duration {
+	if !t.ClusterStarted() || t.regstate.disabled.Load() || nlog.Stopping() {
+		return hk.ResumeIval
+	}
+	cks := xckpt.Pending()
+	if len(cks) == 0 {
+		return hk.ResumeIval
+	}
+	// not while rebalancing or resilvering
+	if xreg.GetRunning(xreg.Flt{Kind: apc.ActRebalance}) != nil || xreg.GetRunning(xreg.Flt{Kind: apc.ActResilver}) != nil {
+		return hk.ResumeIval
+	}
+	now := time.Now().UnixNano()
+	for _, ck := range cks {
+		if xctn, err := xreg.GetXact(ck.ID); err == nil && xctn != nil && !xctn.Finished() {
+			continue // (unlikely)
+		}
+		if time.Duration(now-ck.Saved) > xckpt.ResumeTimeout || ck.Resumes >= xckpt.MaxResumes {
+			nlog.Errorln(t.String(), "giving up on resuming", ck.String(), "[ resumes:", ck.Resumes, "]")
+			xckpt.Remove(ck.ID)
+			continue
+		}
+		if err := t.resume(ck); err != nil {
+			nlog.Warningln(t.String(), "failed to resume", ck.String(), "err:", err)
+			xckpt.Restore(ck) // will retry
+		}
+	}
+	return hk.ResumeIval
+}
+
+func (t *target) resume(ck *xckpt.Ckpt) error {
+	switch ck.Kind {
+	case apc.ActCopyBck, apc.ActETLBck:
+		return t.resumeTCB(ck)
+	case apc.ActCopyObjects, apc.ActETLObjects:
+		return t.resumeTCO(ck)
+	case apc.ActPrefetchObjects:
+		msg := &apc.PrefetchMsg{}
+		if err := jsoniter.Unmarshal(ck.Msg, msg); err != nil {
+			return err
+		}
+		bck := meta.CloneBck(&ck.Bck)
+		if err := bck.Init(t.owner.bmd); err != nil {
+			return err
+		}
+		_, err := t.runPrefetch(ck.ID, bck, msg)
+		return err
+	case apc.ActBlobDl:
+		return t.resumeBlobDl(ck)
+	default:
+		return fmt.Errorf("%s kind is not resumable", ck)
+	}
+}
+
+func (t *target) resumeTCB(ck *xckpt.Ckpt) (err error) {
+	var (
+		msg       = &apc.TCBMsg{}
+		disableDM bool
+	)
+	if err := jsoniter.Unmarshal(ck.Msg, msg); err != nil {
+		return err
+	}
+	if ck.BckTo == nil {
+		return errors.New("missing destination bucket")
+	}
+	bckFrom, bckTo := meta.CloneBck(&ck.Bck), meta.CloneBck(ck.BckTo)
+	if err := bckFrom.Init(t.owner.bmd); err != nil {
+		return err
+	}
+	if err := bckTo.Init(t.owner.bmd); err != nil {
+		return err
+	}
+	if ck.Kind == apc.ActETLBck {
+		if disableDM, err = isDisableDM(msg); err != nil {
+			return err
+		}
+	}
+	custom := &xreg.TCBArgs{
+		Phase:     apc.ActCommit,
+		BckFrom:   bckFrom,
+		BckTo:     bckTo,
+		Msg:       msg,
+		DisableDM: disableDM,
+	}
+	rns := xreg.RenewTCB(ck.ID, ck.Kind, custom)
+	if rns.Err != nil {
+		return rns.Err
+	}
+	xctn := rns.Entry.Get()
+	xctn.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	})
+	xact.GoRunW(xctn)
+	return nil
+}
+
+// x-tco itself restores (and runs) its control messages - see xs.TCOCkpt
+func (t *target) resumeTCO(ck *xckpt.Ckpt) (err error) {
+	var (
+		ckm       = &xs.TCOCkpt{}
+		disableDM bool
+	)
+	if err := jsoniter.Unmarshal(ck.Msg, ckm); err != nil {
+		return err
+	}
+	if len(ckm.Msgs) == 0 {
+		nlog.Infoln(ck.String(), "has nothing to resume")
+		xckpt.Remove(ck.ID)
+		return nil
+	}
+	if ck.BckTo == nil {
+		return errors.New("missing destination bucket")
+	}
+	bckFrom, bckTo := meta.CloneBck(&ck.Bck), meta.CloneBck(ck.BckTo)
+	if err := bckFrom.Init(t.owner.bmd); err != nil {
+		return err
+	}
+	if err := bckTo.Init(t.owner.bmd); err != nil {
+		return err
+	}
+	msg := ckm.Msgs[0]
+	if ck.Kind == apc.ActETLObjects {
+		if disableDM, err = isDisableDM(&msg.TCBMsg); err != nil {
+			return err
+		}
+	}
+	custom := &xreg.TCObjsArgs{
+		BckFrom:   bckFrom,
+		BckTo:     bckTo,
+		Msg:       &msg.TCOMsg,
+		DisableDM: disableDM,
+	}
+	rns := xreg.RenewTCObjs(ck.ID, ck.Kind, custom)
+	if rns.Err != nil {
+		return rns.Err
+	}
+	if xctn := rns.Entry.Get(); xctn.ID() != ck.ID {
+		return cmn.NewErrXactUsePrev(xctn.String()) // will retry when done
+	}
+	return nil
+}
+
+// continue writing the same workfile unless the remote object has changed in the meantime
+func (t *target) resumeBlobDl(ck *xckpt.Ckpt) error {
+	msg := &apc.BlobMsg{}
+	if err := jsoniter.Unmarshal(ck.Msg, msg); err != nil {
+		return err
+	}
+	lom := core.AllocLOM(ck.ObjName)
+	if err := lom.InitBck(&ck.Bck); err != nil {
+		core.FreeLOM(lom)
+		return err
+	}
+	oa, _, err := t.HeadCold(lom, nil /*origReq*/)
+	if err != nil {
+		core.FreeLOM(lom)
+		return err
+	}
+
+	var (
+		lmfh cos.LomWriter
+		fh   *os.File
+		off  = ck.Pos.Off
+		wfqn = ck.Pos.Wfqn
+	)
+	if oa.Size != ck.Pos.Size || oa.Version() != ck.Pos.Version {
+		nlog.Warningln(ck.String(), "remote", lom.Cname(), "has changed - restarting from scratch")
+		off = 0
+	}
+	if wfqn != "" {
+		fh, err = os.OpenFile(wfqn, os.O_WRONLY, cos.PermRWR)
+	}
+	if wfqn == "" || err != nil {
+		wfqn = fs.CSM.Gen(lom, fs.WorkfileType, "blob-dl")
+		if lmfh, err = lom.CreateWork(wfqn); err != nil {
+			core.FreeLOM(lom)
+			return err
+		}
+		off = 0
+	} else {
+		if finfo, err := fh.Stat(); err == nil {
+			off = min(off, finfo.Size())
+		} else {
+			off = 0
+		}
+		if err = fh.Truncate(off); err == nil {
+			_, err = fh.Seek(off, io.SeekStart)
+		}
+		if err != nil {
+			cos.Close(fh)
+			core.FreeLOM(lom)
+			return err
+		}
+		lmfh = fh
+	}
+	ck.Pos.Off, ck.Pos.Wfqn = off, wfqn
+	if off == 0 {
+		ck.Objs, ck.Bytes = 0, 0
+	}
+
+	params := &core.BlobParams{
+		Lmfh:      lmfh,
+		Lom:       lom,
+		Msg:       msg,
+		Wfqn:      wfqn,
+		Resumable: true,
+	}
+	rns := xs.RenewBlobDl(ck.ID, params, oa)
+	if rns.Err != nil || rns.IsRunning() {
+		cos.Close(lmfh)
+		core.FreeLOM(lom)
+		if rns.Err == nil {
+			rns.Err = cmn.NewErrXactUsePrev(ck.String())
+		}
+		return rns.Err
+	}
+	xblob := rns.Entry.Get().(*xs.XactBlobDl)
+	go xblob.Run(nil)
+	return nil
+}
@@ -22,10 +22,11 @@ const (
 
 	ActSummaryBck = "summary-bck"
 
-	ActECEncode  = "ec-encode" // erasure code a bucket
-	ActECGet     = "ec-get"    // read erasure coded objects
-	ActECPut     = "ec-put"    // erasure code objects
-	ActECRespond = "ec-resp"   // respond to other targets' EC requests
+	ActECEncode   = "ec-encode"   // erasure code a bucket
+	ActECReencode = "ec-reencode" // convert erasure coded objects to the bucket's current EC config (profiles)
+	ActECGet      = "ec-get"      // read erasure coded objects
+	ActECPut      = "ec-put"      // erasure code objects
+	ActECRespond  = "ec-resp"     // respond to other targets' EC requests
 
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
@@ -37,6 +38,12 @@ const (
 
 	ActBlobDl = "blob-download"
 
+	// native multipart upload (see also: QparamMptUploadID)
+	ActMptCreate    = "mpt-create"
+	ActMptComplete  = "mpt-complete"
+	ActMptAbort     = "mpt-abort"
+	ActMptListParts = "mpt-list-parts"
+
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
 
@@ -49,10 +56,14 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActLifecycle    = "lifecycle" // bucket lifecycle (expiration) rules, see cmn.LifecycleConf
+	ActReplicate    = "replicate" // cross-cluster async bucket replication, see cmn.ReplConf
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActList           = "list"
+	ActListVersions   = "list-versions" // noncurrent versions of ais:// objects (see VersionConf.MaxVersions)
 	ActLoadLomCache   = "load-lom-cache"
+	ActLsoIndex       = "rebuild-list-index" // (re)build list-objects index, see feat.ListIndex and LsIndexed
 	ActNewPrimary     = "new-primary"
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
@@ -92,6 +103,8 @@ const (
 
 	ActDecommissionCluster = "decommission" // decommission all nodes in the cluster (cleanup system data)
 
+	ActSetFdomain = "set-fdomain" // label target with failure domain (zone, rack) - see core/meta/fdomain.go
+
 	ActAdminJoinTarget = "admin-join-target"
 	ActSelfJoinTarget  = "self-join-target"
 	ActAdminJoinProxy  = "admin-join-proxy"
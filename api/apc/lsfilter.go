@@ -0,0 +1,80 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+)
+
+// LsoFilter is an optional server-side filter that (when present in `LsoMsg`)
+// gets evaluated by each target _prior_ to assembling list-objects pages.
+// All specified predicates must hold (logical AND); zero value of any given
+// field means "unbounded" (or "don't care").
+//
+// Notes:
+//   - time windows are Unix nanoseconds; "after" is inclusive, "before" is exclusive
+//   - mtime is the remote LastModified when known, and the object's local modification time otherwise
+//   - remote objects that are not present in the cluster have no access time and will
+//     not match atime-bounded filters
+//   - NameGlob uses `path.Match` semantics, whereby '*' does not match '/'
+//   - Custom: key => value, whereby empty value only requires the key to be present
+type LsoFilter struct {
+	Custom      map[string]string `json:"custom,omitempty"`
+	HasCksum    *bool             `json:"has_cksum,omitempty"`
+	NameRegex   string            `json:"name_regex,omitempty"`
+	NameGlob    string            `json:"name_glob,omitempty"`
+	MinSize     int64             `json:"min_size,string,omitempty"`
+	MaxSize     int64             `json:"max_size,string,omitempty"`
+	AtimeAfter  int64             `json:"atime_after,string,omitempty"`
+	AtimeBefore int64             `json:"atime_before,string,omitempty"`
+	MtimeAfter  int64             `json:"mtime_after,string,omitempty"`
+	MtimeBefore int64             `json:"mtime_before,string,omitempty"`
+}
+
+func (f *LsoFilter) IsEmpty() bool {
+	return f.MinSize == 0 && f.MaxSize == 0 && f.AtimeAfter == 0 && f.AtimeBefore == 0 &&
+		f.MtimeAfter == 0 && f.MtimeBefore == 0 && f.NameRegex == "" && f.NameGlob == "" &&
+		f.HasCksum == nil && len(f.Custom) == 0
+}
+
+// NameOnly returns true when the filter can be evaluated without loading object metadata.
+func (f *LsoFilter) NameOnly() bool {
+	return f.MinSize == 0 && f.MaxSize == 0 && f.AtimeAfter == 0 && f.AtimeBefore == 0 &&
+		f.MtimeAfter == 0 && f.MtimeBefore == 0 && f.HasCksum == nil && len(f.Custom) == 0
+}
+
+func (f *LsoFilter) Validate() error {
+	if f.MinSize < 0 || f.MaxSize < 0 {
+		return fmt.Errorf("invalid list-objects filter: negative size range [%d, %d]", f.MinSize, f.MaxSize)
+	}
+	if f.MaxSize > 0 && f.MinSize > f.MaxSize {
+		return fmt.Errorf("invalid list-objects filter: min size %d exceeds max size %d", f.MinSize, f.MaxSize)
+	}
+	if f.AtimeBefore > 0 && f.AtimeAfter >= f.AtimeBefore {
+		return errors.New("invalid list-objects filter: empty atime window")
+	}
+	if f.MtimeBefore > 0 && f.MtimeAfter >= f.MtimeBefore {
+		return errors.New("invalid list-objects filter: empty mtime window")
+	}
+	if f.NameRegex != "" {
+		if _, err := regexp.Compile(f.NameRegex); err != nil {
+			return fmt.Errorf("invalid list-objects filter: name regex %q: %w", f.NameRegex, err)
+		}
+	}
+	if f.NameGlob != "" {
+		if _, err := path.Match(f.NameGlob, ""); err != nil {
+			return fmt.Errorf("invalid list-objects filter: name glob %q: %w", f.NameGlob, err)
+		}
+	}
+	for k := range f.Custom {
+		if k == "" {
+			return errors.New("invalid list-objects filter: empty custom metadata key")
+		}
+	}
+	return nil
+}
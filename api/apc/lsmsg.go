@@ -86,6 +86,24 @@ const (
 
 	// do not return virtual subdirectories - do not include them as `cmn.LsoEnt` entries
 	LsNoDirs
+
+	// serve the listing from the targets' persistent list-objects indexes (requires bucket
+	// feature "Enable-List-Index"); the default is authoritative listing (walk/remote);
+	// notes:
+	// - for remote buckets, implies `LsCached`
+	// - targets that do not have a ready-to-use index fall back to authoritative listing
+	// - index-served listing may include recently removed entries (and is in general
+	//   as accurate as the index itself)
+	// see also: ActLsoIndex (to rebuild the index)
+	LsIndexed
+
+	// recompute content checksums of the listed in-cluster objects and compare with those stored;
+	// mismatches are flagged with `EntryBadCksum`;
+	// notes:
+	// - slow: reads entire objects
+	// - cannot be served from the list-objects index (see `LsIndexed`)
+	// see also: `ais scrub --checksum`
+	LsValidateCksum
 )
 
 // max page sizes
@@ -115,6 +133,8 @@ const (
 	EntryVerRemoved = 1 << (EntryStatusBits + 6) // ditto
 	// added v3.26
 	EntryHeadFail = 1 << (EntryStatusBits + 7)
+	// content checksum mismatch (see LsValidateCksum)
+	EntryBadCksum = 1 << (EntryStatusBits + 8)
 )
 
 // ObjEntry.Flags field
@@ -136,6 +156,7 @@ const (
 	GetPropsEC       = "ec"
 	GetPropsCustom   = "custom"
 	GetPropsLocation = "location" // advanced usage
+	GetPropsTags     = "tags"     // user-defined object tags (URL-encoded)
 )
 
 const GetPropsNameSize = GetPropsName + LsPropsSepa + GetPropsSize
@@ -148,7 +169,7 @@ var (
 
 	GetPropsDefaultAIS = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime}
 	GetPropsAll        = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime,
-		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation}
+		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation, GetPropsTags}
 )
 
 type LsoMsg struct {
@@ -156,12 +177,13 @@ type LsoMsg struct {
 	UUID              string      `json:"uuid"`                  // ID to identify a single multi-page request
 	Props             string      `json:"props"`                 // comma-delimited, e.g. "checksum,size,custom" (see GetProps* enum)
 	TimeFormat        string      `json:"time_format,omitempty"` // RFC822 is the default
-	Prefix            string      `json:"prefix"`                // return obj names starting with prefix (TODO: e.g. "A.tar/tutorials/")
+	Prefix            string      `json:"prefix"`                // return obj names starting with prefix; with LsArchDir, may cross shard boundary, e.g. "A.tar/tutorials/"
 	StartAfter        string      `json:"start_after,omitempty"` // start listing after (AIS buckets only)
 	ContinuationToken string      `json:"continuation_token"`    // => LsoResult.ContinuationToken => LsoMsg.ContinuationToken
 	SID               string      `json:"target"`                // selected target to solely execute backend.list-objects
 	Flags             uint64      `json:"flags,string"`          // enum {LsCached, ...} - "LsoMsg flags" above
 	PageSize          int64       `json:"pagesize"`              // max entries returned by list objects call
+	Filter            *LsoFilter  `json:"filter,omitempty"`      // server-side filter (see lsfilter.go)
 }
 
 ////////////
@@ -225,6 +247,9 @@ func (lsmsg *LsoMsg) Str(cname string) string {
 		sb.WriteString(", props:")
 		sb.WriteString(lsmsg.Props)
 	}
+	if lsmsg.Filter != nil {
+		sb.WriteString(", filtered")
+	}
 	if lsmsg.Flags == 0 {
 		return sb.String()
 	}
@@ -251,6 +276,9 @@ func (lsmsg *LsoMsg) Str(cname string) string {
 	if lsmsg.IsFlagSet(LsDiff) {
 		sb.WriteString("diff,")
 	}
+	if lsmsg.IsFlagSet(LsIndexed) {
+		sb.WriteString("indexed,")
+	}
 	s := sb.String()
 	return s[:len(s)-1]
 }
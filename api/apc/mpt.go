@@ -0,0 +1,31 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+// native multipart upload:
+// 1. ActMptCreate: start a new upload and return its ID
+// 2. PUT part (QparamMptUploadID, QparamMptPartNo): can be executed in parallel, in any order, and retried
+// 3. ActMptListParts: list the parts that are already stored (e.g., to resume uploading after client crash)
+// 4. ActMptComplete: concatenate the parts and create the object, or
+//    ActMptAbort: discard the upload and all its parts
+//
+// The upload ID is carried by ActMsg.Name (all actions except ActMptCreate).
+
+const (
+	MptMaxParts = 10000 // same as S3
+)
+
+type (
+	// part of an active multipart upload (ActMptListParts)
+	MptPart struct {
+		MD5  string `json:"md5"`
+		Size int64  `json:"size"`
+		Num  int32  `json:"num"`
+	}
+	// ActMptComplete; empty `Parts` - all stored parts in ascending order
+	MptCompleteMsg struct {
+		Parts []int32 `json:"parts,omitempty"`
+	}
+)
@@ -93,7 +93,7 @@ func (*PrefetchMsg) delim(sb *strings.Builder) {
 // at the specified (bucket) destination.
 // See also: api.PutApndArchArgs
 // --------------------  terminology   ---------------------
-// here and elsewhere "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4) formatted object.
+// here and elsewhere "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4, .tar.zst) formatted object.
 // [NOTE] see cmn/api for cmn.ArchiveMsg (that also contains ToBck)
 type ArchiveMsg struct {
 	TxnUUID     string `json:"-"`        // internal use
@@ -1,6 +1,6 @@
 // Package apc: API control messages and constants
 /*
- * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
  */
 package apc
 
@@ -16,8 +16,9 @@ const (
 	GCP   = "gcp"
 	OCI   = "oci"
 	HT    = "ht"
+	File  = "file" // POSIX directory tree, e.g. NFS mount (see ais/backend/file.go)
 
-	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), oci (oc://), ht://" // NOTE: must include all
+	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), oci (oc://), ht://, file://" // NOTE: must include all
 
 	NsUUIDPrefix = '@' // BEWARE: used by on-disk layout
 	NsNamePrefix = '#' // BEWARE: used by on-disk layout
@@ -36,7 +37,7 @@ const (
 
 const RemAIS = "remais" // to differentiate ais vs "remote" ais; also, default (remote ais cluster) alias
 
-var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, OCI, HT)
+var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, OCI, HT, File)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
@@ -46,7 +47,7 @@ func IsCloudProvider(p string) bool {
 
 // NOTE: not to confuse w/ bck.IsRemote() which also includes remote AIS
 func IsRemoteProvider(p string) bool {
-	return IsCloudProvider(p) || p == HT
+	return IsCloudProvider(p) || p == HT || p == File
 }
 
 func ToScheme(p string) string {
@@ -98,6 +99,8 @@ func DisplayProvider(p string) string {
 		return "OCI"
 	case HT:
 		return "HTTP(S)"
+	case File:
+		return "POSIX"
 	default:
 		return p
 	}
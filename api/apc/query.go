@@ -66,6 +66,10 @@ const (
 	QparamAppendType   = "append_type"
 	QparamAppendHandle = "append_handle"
 
+	// PUT part of a native multipart upload (see ActMptCreate et al.)
+	QparamMptUploadID = "mpt_upload_id"
+	QparamMptPartNo   = "mpt_part"
+
 	// HTTP bucket support.
 	QparamOrigURL = "original_url"
 
@@ -75,7 +79,7 @@ const (
 	QparamAllLogs = "all"
 
 	// The following 4 (four) QparamArch* parameters are all intended for usage with sharded datasets,
-	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, and/or .tar.lz4) formatted objects.
+	// whereby the shards are (.tar, .tgz (or .tar.gz), .zip, .tar.lz4, and/or .tar.zst) formatted objects.
 	//
 	// For the most recently updated list of supported serialization formats, please see cmn/archive package.
 	//
@@ -126,6 +130,11 @@ const (
 	// - implies remote backend
 	QparamLatestVer = "latest-ver"
 
+	// GET, HEAD (S3), and DELETE a given (possibly, noncurrent) version of an object
+	// - ais:// buckets only (see `Versioning.MaxVersions`)
+	// - same name as S3 `versionId`
+	QparamVersionID = "versionId"
+
 	// in addition to the latest-ver (above), also entails removing remotely
 	// deleted objects
 	QparamSync = "synchronize"
@@ -215,6 +224,13 @@ const (
 
 	// Notification target's node ID (usually, the node that initiates the operation).
 	QparamNotifyMe = "nft"
+
+	// audit log query (see cmn/audit)
+	QparamAuditUser   = "audit-user"
+	QparamAuditAction = "audit-action"
+	QparamAuditBucket = "audit-bck"
+	QparamAuditSince  = "audit-since" // unix nanoseconds
+	QparamAuditLimit  = "audit-limit"
 )
 
 // QparamWhat enum.
@@ -244,6 +260,8 @@ const (
 	WhatSmapVote   = "smapvote"
 	WhatSysInfo    = "sysinfo"
 	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatReplStats  = "repl_stats" // cross-cluster bucket replication: per-target status (see cmn.ReplStats)
+	WhatAudit      = "audit"      // audit log records (see cmn/audit)
 
 	// log
 	WhatLog = "log"
@@ -31,6 +31,8 @@ const (
 	Users     = "users"    // AuthN
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
+	JWKS      = "jwks"     // AuthN: public keys to verify RS256/ES256 tokens
+	Accounts  = "accounts" // AuthN: service accounts
 	IC        = "ic"       // information center
 
 	// l3 ---
@@ -47,6 +49,9 @@ const (
 	// target
 	Mountpaths = "mountpaths"
 
+	// AuthN: S3 access keys, e.g. /v1/users/<user-id>/keys
+	AccessKeys = "keys"
+
 	// common
 	Init     = "init"
 	Start    = "start"
@@ -168,6 +173,8 @@ var (
 	URLPathUsers    = urlpath(Version, Users)
 	URLPathClusters = urlpath(Version, Clusters)
 	URLPathRoles    = urlpath(Version, Roles)
+	URLPathJWKS     = urlpath(Version, JWKS)
+	URLPathAccounts = urlpath(Version, Accounts)
 )
 
 func (u URLPath) Join(words ...string) string {
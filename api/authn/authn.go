@@ -7,11 +7,13 @@ package authn
 import (
 	"errors"
 	"net/http"
+	"net/url"
 	"sort"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 
 	jsoniter "github.com/json-iterator/go"
@@ -82,6 +84,213 @@ func LoginUser(bp api.BaseParams, userID, pass string, expire *time.Duration) (t
 	return token, nil
 }
 
+// Exchange ID token issued by a configured OIDC identity provider for an AIS token
+// (with permissions of the AuthN roles that the token's groups map to)
+func LoginOIDC(bp api.BaseParams, idToken string, expire *time.Duration) (token *TokenMsg, err error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(ExchangeMsg{IDToken: idToken, ExpiresIn: expire})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&token); err != nil {
+		return nil, err
+	}
+	if token.Token == "" {
+		return nil, errors.New("login failed: empty response from AuthN server")
+	}
+	return token, nil
+}
+
+// Issue a new S3 (SigV4) access key for a given user; the returned secret access key
+// is not stored and won't be shown again. Expiration semantics: same as LoginUser.
+func AddAccessKey(bp api.BaseParams, userID string, expire *time.Duration) (*AccessKey, error) {
+	bp.Method = http.MethodPost
+	msg := AccessKeyMsg{ExpiresIn: expire}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.AccessKeys)
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	key := &AccessKey{}
+	if _, err := reqParams.DoReqAny(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func GetAccessKeys(bp api.BaseParams, userID string) ([]*AccessKey, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.AccessKeys)
+	}
+	keys := make([]*AccessKey, 0, 4)
+	_, err := reqParams.DoReqAny(&keys)
+
+	less := func(i, j int) bool { return keys[i].Expires.Before(keys[j].Expires) }
+	sort.Slice(keys, less)
+	return keys, err
+}
+
+func DeleteAccessKey(bp api.BaseParams, userID, accessKeyID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.AccessKeys, accessKeyID)
+	}
+	return reqParams.DoRequest()
+}
+
+func AddServiceAccount(bp api.BaseParams, sa *ServiceAccount) error {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.S
+		reqParams.Body = cos.MustMarshal(sa)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	return reqParams.DoRequest()
+}
+
+func UpdateServiceAccount(bp api.BaseParams, sa *ServiceAccount) error {
+	bp.Method = http.MethodPut
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(sa.ID)
+		reqParams.Body = cos.MustMarshal(sa)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	return reqParams.DoRequest()
+}
+
+// Deletes service account and all its API keys (the keys are revoked)
+func DeleteServiceAccount(bp api.BaseParams, accountID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(accountID)
+	}
+	return reqParams.DoRequest()
+}
+
+func GetServiceAccount(bp api.BaseParams, accountID string) (*ServiceAccount, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(accountID)
+	}
+	sa := &ServiceAccount{}
+	_, err := reqParams.DoReqAny(sa)
+	return sa, err
+}
+
+func GetServiceAccounts(bp api.BaseParams) ([]*ServiceAccount, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.S
+	}
+	var accounts map[string]*ServiceAccount
+	if _, err := reqParams.DoReqAny(&accounts); err != nil {
+		return nil, err
+	}
+	list := make([]*ServiceAccount, 0, len(accounts))
+	for _, sa := range accounts {
+		list = append(list, sa)
+	}
+	less := func(i, j int) bool { return list[i].ID < list[j].ID }
+	sort.Slice(list, less)
+	return list, nil
+}
+
+// Issue a new API key for a given service account; the returned key (APIKey.Token)
+// is returned only once and won't be shown again
+func AddAPIKey(bp api.BaseParams, accountID string, msg *APIKeyMsg) (*APIKey, error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(accountID, apc.AccessKeys)
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	key := &APIKey{}
+	if _, err := reqParams.DoReqAny(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func GetAPIKeys(bp api.BaseParams, accountID string) ([]*APIKey, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(accountID, apc.AccessKeys)
+	}
+	keys := make([]*APIKey, 0, 4)
+	_, err := reqParams.DoReqAny(&keys)
+
+	less := func(i, j int) bool { return keys[i].Name < keys[j].Name }
+	sort.Slice(keys, less)
+	return keys, err
+}
+
+func DeleteAPIKey(bp api.BaseParams, accountID, keyID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathAccounts.Join(accountID, apc.AccessKeys, keyID)
+	}
+	return reqParams.DoRequest()
+}
+
+// Exchange service account's API key for a short-lived AIS token (with the key's permissions).
+// The token never outlives the key; if `expire` is `nil` AuthN uses its default expiration time.
+func LoginAPIKey(bp api.BaseParams, apiKey string, expire *time.Duration) (token *TokenMsg, err error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(ExchangeMsg{APIKey: apiKey, ExpiresIn: expire})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&token); err != nil {
+		return nil, err
+	}
+	if token.Token == "" {
+		return nil, errors.New("login failed: empty response from AuthN server")
+	}
+	return token, nil
+}
+
 func RegisterCluster(bp api.BaseParams, cluSpec CluACL) error {
 	msg := cos.MustMarshal(cluSpec)
 	bp.Method = http.MethodPost
@@ -273,6 +482,20 @@ func RevokeToken(bp api.BaseParams, token string) error {
 	return reqParams.DoRequest()
 }
 
+// public keys to verify RS256/ES256 tokens (no authentication required)
+func GetJWKS(bp api.BaseParams) (*JWKS, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathJWKS.S
+	}
+	jwks := &JWKS{}
+	_, err := reqParams.DoReqAny(jwks)
+	return jwks, err
+}
+
 func GetConfig(bp api.BaseParams) (*Config, error) {
 	bp.Method = http.MethodGet
 	reqParams := api.AllocRp()
@@ -287,6 +510,24 @@ func GetConfig(bp api.BaseParams) (*Config, error) {
 	return conf, err
 }
 
+// GetAuditRecords returns the most recent AuthN audit records, filtered as per query (see cmn/audit)
+func GetAuditRecords(bp api.BaseParams, aq *audit.Query) (recs []*audit.Record, err error) {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamWhat, apc.WhatAudit)
+	aq.AddToQuery(q)
+
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDae.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&recs)
+	return recs, err
+}
+
 func SetConfig(bp api.BaseParams, conf *ConfigToUpdate) error {
 	bp.Method = http.MethodPut
 	reqParams := api.AllocRp()
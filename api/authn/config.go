@@ -1,6 +1,6 @@
 // Package authn provides AuthN API over HTTP(S)
 /*
- * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
  */
 package authn
 
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/jsp"
@@ -18,10 +19,12 @@ import (
 
 type (
 	Config struct {
-		Log     LogConf     `json:"log"`
-		Net     NetConf     `json:"net"`
-		Server  ServerConf  `json:"auth"`
-		Timeout TimeoutConf `json:"timeout"`
+		Log     LogConf       `json:"log"`
+		Net     NetConf       `json:"net"`
+		Server  ServerConf    `json:"auth"`
+		Timeout TimeoutConf   `json:"timeout"`
+		OIDC    OIDCConf      `json:"oidc"`
+		Audit   cmn.AuditConf `json:"audit"` // (requires restart)
 		// private
 		mu sync.RWMutex `json:"-"`
 	}
@@ -41,19 +44,49 @@ type (
 	ServerConf struct {
 		Secret string       `json:"secret"`
 		Expire cos.Duration `json:"expiration_time"`
+		// asymmetric (RS256, ES256) signing: private keys by key ID - all published via JWKS,
+		// while only the active one signs new tokens (when empty, tokens are HS256-signed with the secret)
+		SigningKeys []SigningKeyConf `json:"signing_keys,omitempty"`
+		ActiveKey   string           `json:"active_key,omitempty"`
 		// private
 		psecret *string       `json:"-"`
 		pexpire *cos.Duration `json:"-"`
 	}
+	SigningKeyConf struct {
+		ID   string `json:"kid"`
+		Path string `json:"path"` // PEM-encoded RSA or ECDSA (P-256) private key
+	}
 	TimeoutConf struct {
 		Default cos.Duration `json:"default_timeout"`
 	}
+	// external identity providers: AuthN exchanges their (OIDC) ID tokens for AIS tokens
+	OIDCConf struct {
+		Issuers []OIDCIssuerConf `json:"issuers,omitempty"`
+	}
+	OIDCIssuerConf struct {
+		Name     string `json:"name"`     // short (alphanumeric) name; federated user IDs are "<name>:<user>"
+		Issuer   string `json:"issuer"`   // must match the "iss" claim
+		Audience string `json:"audience"` // (client ID) must be one of the "aud" claim values
+		// default: "jwks_uri" from <issuer>/.well-known/openid-configuration
+		JWKSURL string `json:"jwks_url,omitempty"`
+		// optional CA certificate to verify the issuer's HTTPS (in addition to system roots)
+		CACert string `json:"ca_cert,omitempty"`
+		// claims: user name (default "sub") and groups (default "groups")
+		UserClaim   string `json:"user_claim,omitempty"`
+		GroupsClaim string `json:"groups_claim,omitempty"`
+		// group (value of the groups claim) => AuthN role names;
+		// ID token that maps to no roles is rejected
+		GroupRoles map[string][]string `json:"group_roles"`
+	}
 	ConfigToUpdate struct {
 		Server *ServerConfToSet `json:"auth"`
+		OIDC   *OIDCConf        `json:"oidc,omitempty"`
 	}
 	ServerConfToSet struct {
-		Secret *string `json:"secret,omitempty"`
-		Expire *string `json:"expiration_time,omitempty"`
+		Secret      *string           `json:"secret,omitempty"`
+		Expire      *string           `json:"expiration_time,omitempty"`
+		SigningKeys *[]SigningKeyConf `json:"signing_keys,omitempty"`
+		ActiveKey   *string           `json:"active_key,omitempty"`
 	}
 	// TokenList is a list of tokens pushed by authn
 	TokenList struct {
@@ -94,9 +127,18 @@ func (c *Config) SetSecret(val *string) {
 }
 
 func (c *Config) ApplyUpdate(cu *ConfigToUpdate) error {
-	if cu.Server == nil {
+	if cu.Server == nil && cu.OIDC == nil {
 		return errors.New("configuration is empty")
 	}
+	if cu.OIDC != nil {
+		if err := cu.OIDC.Validate(); err != nil {
+			return err
+		}
+		c.OIDC = *cu.OIDC
+	}
+	if cu.Server == nil {
+		return nil
+	}
 	if cu.Server.Secret != nil {
 		if *cu.Server.Secret == "" {
 			return errors.New("secret not defined")
@@ -112,5 +154,74 @@ func (c *Config) ApplyUpdate(cu *ConfigToUpdate) error {
 		c.Server.Expire = v
 		c.Server.pexpire = &v
 	}
+	if cu.Server.SigningKeys == nil && cu.Server.ActiveKey == nil {
+		return nil
+	}
+	keys := ServerConf{SigningKeys: c.Server.SigningKeys, ActiveKey: c.Server.ActiveKey}
+	if cu.Server.SigningKeys != nil {
+		keys.SigningKeys = *cu.Server.SigningKeys
+	}
+	if cu.Server.ActiveKey != nil {
+		keys.ActiveKey = *cu.Server.ActiveKey
+	}
+	if err := keys.ValidateKeys(); err != nil {
+		return err
+	}
+	c.Server.SigningKeys, c.Server.ActiveKey = keys.SigningKeys, keys.ActiveKey
+	return nil
+}
+
+// key rotation: add the new key (to be published and cached by AIS gateways),
+// make it active, and remove the old one once all tokens it has signed expire
+func (c *ServerConf) ValidateKeys() error {
+	if len(c.SigningKeys) == 0 {
+		if c.ActiveKey != "" {
+			return fmt.Errorf("active key %q: no signing keys configured", c.ActiveKey)
+		}
+		return nil
+	}
+	var found bool
+	kids := make(cos.StrSet, len(c.SigningKeys))
+	for _, sk := range c.SigningKeys {
+		if sk.ID == "" || sk.Path == "" {
+			return fmt.Errorf("invalid signing key %+v: both key ID and path are required", sk)
+		}
+		if kids.Contains(sk.ID) {
+			return fmt.Errorf("duplicate signing key ID %q", sk.ID)
+		}
+		kids.Add(sk.ID)
+		found = found || sk.ID == c.ActiveKey
+	}
+	if !found {
+		return fmt.Errorf("active key %q is not one of the configured signing keys", c.ActiveKey)
+	}
+	return nil
+}
+
+func (c *OIDCConf) Validate() error {
+	var (
+		names   = make(cos.StrSet, len(c.Issuers))
+		issuers = make(cos.StrSet, len(c.Issuers))
+	)
+	for i := range c.Issuers {
+		ic := &c.Issuers[i]
+		if ic.Name == "" || !cos.IsAlphaNice(ic.Name) {
+			return fmt.Errorf("OIDC issuer name %q is invalid: %s", ic.Name, cos.OnlyNice)
+		}
+		if names.Contains(ic.Name) {
+			return fmt.Errorf("duplicate OIDC issuer name %q", ic.Name)
+		}
+		names.Add(ic.Name)
+		if ic.Issuer == "" || ic.Audience == "" {
+			return fmt.Errorf("OIDC issuer %q: both issuer URL and audience are required", ic.Name)
+		}
+		if issuers.Contains(ic.Issuer) {
+			return fmt.Errorf("duplicate OIDC issuer %q", ic.Issuer)
+		}
+		issuers.Add(ic.Issuer)
+		if len(ic.GroupRoles) == 0 {
+			return fmt.Errorf("OIDC issuer %q: no group roles", ic.Name)
+		}
+	}
 	return nil
 }
@@ -1,10 +1,11 @@
 // Package authn provides AuthN API over HTTP(S)
 /*
- * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
  */
 package authn
 
 import (
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -30,8 +31,11 @@ type (
 		URLs   []string        `json:"urls,omitempty"`
 	}
 
+	// bucket ACL, optionally scoped to objects whose names start with Prefix (e.g., "team-a/");
+	// an empty prefix applies to the entire bucket
 	BckACL struct {
 		Bck    cmn.Bck         `json:"bck"`
+		Prefix string          `json:"prefix,omitempty"`
 		Access apc.AccessAttrs `json:"perm,string"`
 	}
 
@@ -43,6 +47,54 @@ type (
 		Password  string         `json:"password"`
 		ExpiresIn *time.Duration `json:"expires_in"`
 	}
+	// exchange (short-lived) AIS token for either:
+	// - ID token issued by external (OIDC) identity provider, or
+	// - service account's API key
+	ExchangeMsg struct {
+		IDToken   string         `json:"id_token,omitempty"`
+		APIKey    string         `json:"api_key,omitempty"`
+		ExpiresIn *time.Duration `json:"expires_in"`
+	}
+
+	// S3 (SigV4) access key: secret access key is returned only once - upon creation
+	AccessKey struct {
+		ID      string    `json:"id"`
+		Secret  string    `json:"secret,omitempty"`
+		UserID  string    `json:"user_id"`
+		Expires time.Time `json:"expires"`
+	}
+	AccessKeyMsg struct {
+		ExpiresIn *time.Duration `json:"expires_in"`
+	}
+
+	// non-interactive identity (no password) that authenticates with API keys
+	ServiceAccount struct {
+		ID          string    `json:"id"`
+		Description string    `json:"desc,omitempty"`
+		Roles       []*Role   `json:"roles"`
+		Created     time.Time `json:"created"`
+	}
+
+	// named, long-lived API key of a service account, scoped to a subset of the account's
+	// roles and (optionally) bucket ACLs; the key itself (Token) is returned only once - upon creation
+	APIKey struct {
+		ID         string    `json:"id"`
+		Name       string    `json:"name"`
+		AccountID  string    `json:"account_id"`
+		Token      string    `json:"token,omitempty"` // returned only once, upon creation
+		Hash       string    `json:"hash,omitempty"`  // SHA-256 of the token (AuthN does not store the token itself)
+		Roles      []string  `json:"roles"`
+		BucketACLs []*BckACL `json:"buckets,omitempty"`
+		Created    time.Time `json:"created"`
+		Expires    time.Time `json:"expires"`
+		LastUsed   time.Time `json:"last_used"`
+	}
+	APIKeyMsg struct {
+		Name       string         `json:"name"`
+		Roles      []string       `json:"roles,omitempty"`   // empty: all roles of the account
+		BucketACLs []*BckACL      `json:"buckets,omitempty"` // when specified, restricts the key to (only) these buckets
+		ExpiresIn  *time.Duration `json:"expires_in"`        // nil or zero: never expires
+	}
 
 	RegisteredClusters struct {
 		Clusters map[string]*CluACL `json:"clusters,omitempty"`
@@ -55,6 +107,24 @@ type (
 		BucketACLs  []*BckACL `json:"buckets"`
 		IsAdmin     bool      `json:"admin"`
 	}
+
+	// public key to verify RS256 or ES256 signed tokens (RFC 7517)
+	JWK struct {
+		Kty string `json:"kty"`           // "RSA" | "EC"
+		Kid string `json:"kid"`           // key ID (token header "kid")
+		Alg string `json:"alg,omitempty"` // "RS256" | "ES256"
+		Use string `json:"use,omitempty"` // "sig"
+		N   string `json:"n,omitempty"`   // RSA modulus (base64url)
+		E   string `json:"e,omitempty"`   // RSA exponent (ditto)
+		Crv string `json:"crv,omitempty"` // EC curve ("P-256")
+		X   string `json:"x,omitempty"`   // EC point coordinates (base64url)
+		Y   string `json:"y,omitempty"`
+	}
+	// JWK set: all currently valid public keys, including those
+	// that are still valid during key rotation
+	JWKS struct {
+		Keys []JWK `json:"keys"`
+	}
 )
 
 //////////
@@ -72,6 +142,19 @@ func (u *User) IsAdmin() bool {
 	return false
 }
 
+////////////////////
+// ServiceAccount //
+////////////////////
+
+func (sa *ServiceAccount) Role(name string) *Role {
+	for _, r := range sa.Roles {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
 ////////////
 // CluACL //
 ////////////
@@ -87,6 +170,33 @@ func (clu *CluACL) String() string {
 	return uuid
 }
 
+////////////
+// BckACL //
+////////////
+
+func (acl *BckACL) String() string { return acl.Bck.Cname(acl.Prefix) }
+
+// same bucket and prefix
+func (acl *BckACL) Equal(other *BckACL) bool {
+	return acl.Prefix == other.Prefix && acl.Bck.Equal(&other.Bck)
+}
+
+// whether the ACL applies to the given object name or (all objects under) prefix
+func (acl *BckACL) Covers(name string) bool { return strings.HasPrefix(name, acl.Prefix) }
+
+//////////
+// JWKS //
+//////////
+
+func (jwks *JWKS) Find(kid string) *JWK {
+	for i := range jwks.Keys {
+		if jwks.Keys[i].Kid == kid {
+			return &jwks.Keys[i]
+		}
+	}
+	return nil
+}
+
 //////////////
 // TokenMsg //
 //////////////
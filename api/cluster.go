@@ -12,6 +12,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 )
@@ -183,6 +184,47 @@ func GetClusterSysInfo(bp BaseParams) (info apc.ClusterSysInfo, err error) {
 	return info, err
 }
 
+// GetReplStats returns per-target replication status of all buckets that have
+// cross-cluster replication enabled (see cmn.ReplConf)
+func GetReplStats(bp BaseParams) (out map[string][]*cmn.ReplStats, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatReplStats)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&out)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return out, err
+}
+
+// GetAuditRecords returns the most recent audit records of all AIS gateways,
+// merged and filtered as per query (see cmn/audit and cmn.AuditConf)
+func GetAuditRecords(bp BaseParams, aq *audit.Query) (recs []*audit.Record, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatAudit)
+	aq.AddToQuery(q)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&recs)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return recs, err
+}
+
 func GetRemoteAIS(bp BaseParams) (remais meta.RemAisVec, err error) {
 	q := qalloc()
 	q.Set(apc.QparamWhat, apc.WhatRemoteAIS)
@@ -346,6 +388,12 @@ func ClearLcache(bp BaseParams, tid string) error {
 	return _putCluster(bp, apc.ActMsg{Action: apc.ActClearLcache, Name: tid})
 }
 
+// SetFdomain labels a given target with failure domain (empty string to remove the label);
+// see also: `GetClusterMap`, `meta.Smap.CheckFdomains`
+func SetFdomain(bp BaseParams, tid, domain string) error {
+	return _putCluster(bp, apc.ActMsg{Action: apc.ActSetFdomain, Name: tid, Value: domain})
+}
+
 func _putCluster(bp BaseParams, msg apc.ActMsg) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -25,6 +25,9 @@ const (
 	AisLocalRedirectCIDR = "AIS_CLUSTER_CIDR"
 	AisPubIPv4CIDR       = "AIS_PUBLIC_IP_CIDR"
 
+	// target's failure domain (zone, rack); takes precedence over local config "failure_domain"
+	AisFailureDomain = "AIS_FAILURE_DOMAIN"
+
 	//
 	// HTTPS
 	// for details and background, see: https://github.com/NVIDIA/aistore/blob/main/docs/environment-vars.md#https
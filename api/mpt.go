@@ -0,0 +1,101 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Native multipart upload ===================================================================
+// 1. CreateMultipartUpload returns upload ID
+// 2. UploadPart (any number of times, in parallel, in any order; the same part can be re-uploaded)
+// 3. ListMultipartParts (optional) returns the parts that are already stored
+// 4. CompleteMultipartUpload creates the object, or AbortMultipartUpload discards all uploaded parts
+// NOTE: active uploads are kept in memory - target restart loses them (along with their uploaded parts)
+// See also: apc.ActMptCreate et al.
+
+type PutPartArgs struct {
+	UploadID string
+	PutArgs
+	PartNum int // [1, apc.MptMaxParts]
+}
+
+func CreateMultipartUpload(bp BaseParams, bck cmn.Bck, objName string) (uploadID string, err error) {
+	err = _mpt(bp, bck, objName, apc.ActMsg{Action: apc.ActMptCreate}, &uploadID)
+	return uploadID, err
+}
+
+// returns the part's MD5 (as computed by the cluster)
+func UploadPart(args *PutPartArgs) (md5 string, err error) {
+	var (
+		resp *http.Response
+		q    = qalloc()
+	)
+	args.Bck.SetQuery(q)
+	q.Set(apc.QparamMptUploadID, args.UploadID)
+	q.Set(apc.QparamMptPartNo, strconv.Itoa(args.PartNum))
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathObjects.Join(args.Bck.Name, args.ObjName)
+		reqArgs.Query = q
+		reqArgs.BodyR = args.Reader
+		reqArgs.Header = args.Header
+	}
+	resp, err = DoWithRetry(args.BaseParams.Client, args.put, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	qfree(q)
+	if err == nil {
+		md5 = resp.Header.Get(apc.HdrObjCksumVal)
+	}
+	return md5, err
+}
+
+// returns parts sorted by part number
+func ListMultipartParts(bp BaseParams, bck cmn.Bck, objName, uploadID string) (parts []apc.MptPart, err error) {
+	err = _mpt(bp, bck, objName, apc.ActMsg{Action: apc.ActMptListParts, Name: uploadID}, &parts)
+	return parts, err
+}
+
+// empty `partNums` - all uploaded parts in ascending order
+func CompleteMultipartUpload(bp BaseParams, bck cmn.Bck, objName, uploadID string, partNums []int32) error {
+	actMsg := apc.ActMsg{Action: apc.ActMptComplete, Name: uploadID, Value: &apc.MptCompleteMsg{Parts: partNums}}
+	return _mpt(bp, bck, objName, actMsg, nil)
+}
+
+func AbortMultipartUpload(bp BaseParams, bck cmn.Bck, objName, uploadID string) error {
+	return _mpt(bp, bck, objName, apc.ActMsg{Action: apc.ActMptAbort, Name: uploadID}, nil)
+}
+
+func _mpt(bp BaseParams, bck cmn.Bck, objName string, actMsg apc.ActMsg, out any) (err error) {
+	q := qalloc()
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(actMsg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		bck.SetQuery(q)
+		reqParams.Query = q
+	}
+	switch v := out.(type) {
+	case nil:
+		err = reqParams.DoRequest()
+	case *string:
+		_, err = reqParams.doReqStr(v)
+	default:
+		_, err = reqParams.DoReqAny(out)
+	}
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
@@ -40,6 +40,8 @@ type (
 		// - `apc.QparamOrigURL`: GET from a vanilla http(s) location (`ht://` bucket with the corresponding `OrigURLBck`)
 		// - `apc.QparamSilent`: do not log errors
 		// - `apc.QparamLatestVer`: get latest version from the associated Cloud bucket; see also: `ValidateWarmGet`
+		// - `apc.QparamVersionID`: get a given (current or noncurrent) version of the object in ais:// bucket;
+		//   see also: `VersionConf.MaxVersions`
 		// - and also a group of parameters used to read aistore-supported serialized archives ("shards"),
 		//   namely:
 		//   - `apc.QparamArchpath`
@@ -55,6 +57,7 @@ type (
 		//   For range formatting, see https://www.rfc-editor.org/rfc/rfc7233#section-2.1
 		// E.g. blob download:
 		// * Header.Set(apc.HdrBlobDownload, "true")
+		// and also conditional GET (e.g., cos.HdrIfNoneMatch, cos.HdrIfModifiedSince)
 		Header http.Header
 	}
 
@@ -436,6 +439,26 @@ func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
 	return err
 }
 
+// DeleteObjectVersion deletes a given (current or noncurrent) version of the object in ais:// bucket
+// (see also: `VersionConf.MaxVersions`)
+func DeleteObjectVersion(bp BaseParams, bck cmn.Bck, objName, version string) error {
+	q := qalloc()
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		bck.SetQuery(q)
+		q.Set(apc.QparamVersionID, version)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+
+	FreeRp(reqParams)
+	qfree(q)
+	return err
+}
+
 // Evict(object) ======================================================================================
 
 func EvictObject(bp BaseParams, bck cmn.Bck, objName string) error {
@@ -472,7 +495,7 @@ func PrefetchObject(bp BaseParams, bck cmn.Bck, objName string) (string, error)
 }
 
 // Archive the content of a reader (`args.Reader` - e.g., an open file). =======================================
-// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4)
+// Destination, depending on the options, can be an existing (.tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst)
 // formatted object (aka "shard") or a new one (or, a new version).
 // ---
 // For the updated list of supported archival formats -- aka MIME types -- see cmn/cos/archive.go.
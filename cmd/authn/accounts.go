@@ -0,0 +1,333 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Service accounts and their API keys:
+// - service account is a non-interactive identity: no password, cannot log in
+// - instead, it has any number of named API keys, each scoped to a subset of the account's roles
+//   and, optionally, to a given set of bucket (and prefix) ACLs
+// - API key is a signed bearer token that AIS gateways accept as is; alternatively,
+//   it can be exchanged (POST /v1/tokens) for a short-lived token - in which case AuthN
+//   records the key's last usage (gateways validate keys locally and do not report usage)
+// - AuthN stores only the SHA-256 of the key (see apiKeyHash); the key itself is returned once, upon creation
+// - deleting a key (or the account) revokes the key by its ID (see revokeAPIKey)
+
+//
+// service accounts ===========================================================
+//
+
+func (m *mgr) addAccount(sa *authn.ServiceAccount) (int, error) {
+	if sa.ID == "" {
+		return http.StatusBadRequest, errors.New("service account ID is required")
+	}
+	if !cos.IsAlphaNice(sa.ID) {
+		return http.StatusBadRequest, fmt.Errorf("service account ID %q is invalid: %s", sa.ID, cos.OnlyNice)
+	}
+	// users and service accounts share the namespace (token's "username")
+	if _, _, err := m.db.GetString(usersCollection, sa.ID); err == nil {
+		return http.StatusConflict, cos.NewErrAlreadyExists(m, "user "+sa.ID)
+	}
+	if _, _, err := m.db.GetString(accountsCollection, sa.ID); err == nil {
+		return http.StatusConflict, cos.NewErrAlreadyExists(m, "service account "+sa.ID)
+	}
+	roles, code, err := m.resolveRoles(sa.Roles)
+	if err != nil {
+		return code, err
+	}
+	sa.Roles, sa.Created = roles, time.Now()
+	return m.db.Set(accountsCollection, sa.ID, sa)
+}
+
+// Updates description and/or roles; API keys that refer to removed roles are deleted (and revoked)
+func (m *mgr) updateAccount(id string, updateReq *authn.ServiceAccount) (int, error) {
+	sa, code, err := m.lookupAccount(id)
+	if err != nil {
+		return code, err
+	}
+	if updateReq.Description != "" {
+		sa.Description = updateReq.Description
+	}
+	if len(updateReq.Roles) != 0 {
+		if sa.Roles, code, err = m.resolveRoles(updateReq.Roles); err != nil {
+			return code, err
+		}
+	}
+	if code, err := m.db.Set(accountsCollection, id, sa); err != nil {
+		return code, err
+	}
+	keys, _, err := m.apiKeys(id)
+	if err != nil {
+		nlog.Errorln(err)
+	}
+	for _, key := range keys {
+		for _, name := range key.Roles {
+			if sa.Role(name) == nil {
+				nlog.Infoln("service account", id, "no longer has role", name, "- deleting API key", key.Name)
+				m.delAPIKey(id, key.ID)
+				break
+			}
+		}
+	}
+	return http.StatusOK, nil
+}
+
+func (m *mgr) delAccount(id string) (int, error) {
+	code, err := m.db.Delete(accountsCollection, id)
+	if err != nil {
+		return code, err
+	}
+	keys, _, err := m.apiKeys(id)
+	if err != nil {
+		nlog.Errorln(err)
+	}
+	for _, key := range keys {
+		m.delAPIKey(id, key.ID)
+	}
+	return code, nil
+}
+
+func (m *mgr) lookupAccount(id string) (*authn.ServiceAccount, int, error) {
+	sa := &authn.ServiceAccount{}
+	code, err := m.db.Get(accountsCollection, id, sa)
+	if err != nil {
+		return nil, code, err
+	}
+	return sa, http.StatusOK, nil
+}
+
+func (m *mgr) accountList() (map[string]*authn.ServiceAccount, int, error) {
+	recs, code, err := m.db.GetAll(accountsCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	accounts := make(map[string]*authn.ServiceAccount, len(recs))
+	for _, str := range recs {
+		sa := &authn.ServiceAccount{}
+		if err := jsoniter.Unmarshal([]byte(str), sa); err != nil {
+			nlog.Errorln("failed to unmarshal service account:", err)
+			continue
+		}
+		accounts[sa.ID] = sa
+	}
+	return accounts, http.StatusOK, nil
+}
+
+// (roles are referenced by name; the account stores their current definitions)
+func (m *mgr) resolveRoles(roles []*authn.Role) ([]*authn.Role, int, error) {
+	if len(roles) == 0 {
+		return nil, http.StatusBadRequest, errors.New("service account requires at least one role")
+	}
+	resolved := make([]*authn.Role, 0, len(roles))
+	for _, r := range roles {
+		role, code, err := m.lookupRole(r.Name)
+		if err != nil {
+			return nil, code, err
+		}
+		resolved = append(resolved, role)
+	}
+	return resolved, http.StatusOK, nil
+}
+
+//
+// API keys ===================================================================
+//
+
+// Issues a new API key on behalf of an existing service account.
+// The key carries the ACLs of the selected roles (all account's roles, if not specified) -
+// or, when specified, only the requested bucket ACLs, each of which must be granted by those roles.
+func (m *mgr) addAPIKey(accountID string, msg *authn.APIKeyMsg) (*authn.APIKey, int, error) {
+	sa, code, err := m.lookupAccount(accountID)
+	if err != nil {
+		return nil, code, err
+	}
+	if msg.Name == "" {
+		return nil, http.StatusBadRequest, errors.New("API key name is required")
+	}
+	if !cos.IsAlphaNice(msg.Name) {
+		return nil, http.StatusBadRequest, fmt.Errorf("API key name %q is invalid: %s", msg.Name, cos.OnlyNice)
+	}
+	keys, code, err := m.apiKeys(accountID)
+	if err != nil {
+		return nil, code, err
+	}
+	for _, key := range keys {
+		if key.Name == msg.Name {
+			return nil, http.StatusConflict, cos.NewErrAlreadyExists(m, "API key "+accountID+"/"+msg.Name)
+		}
+	}
+
+	// scope: roles
+	uInfo := &authn.User{ID: accountID}
+	if len(msg.Roles) == 0 {
+		uInfo.Roles = sa.Roles
+	} else {
+		for _, name := range msg.Roles {
+			role := sa.Role(name)
+			if role == nil {
+				return nil, http.StatusBadRequest, fmt.Errorf("service account %q does not have role %q", accountID, name)
+			}
+			uInfo.Roles = append(uInfo.Roles, role)
+		}
+	}
+	key := &authn.APIKey{
+		ID:        cos.GenUUID(),
+		Name:      msg.Name,
+		AccountID: accountID,
+		Created:   time.Now(),
+	}
+	for _, role := range uInfo.Roles {
+		key.Roles = append(key.Roles, role.Name)
+	}
+
+	// scope: bucket ACLs
+	var (
+		isAdmin          = uInfo.IsAdmin()
+		cluACLs, bckACLs = userACLs(uInfo)
+	)
+	m.fixClusterIDs(cluACLs)
+	if len(msg.BucketACLs) > 0 {
+		if err := validateBckACLs(msg.BucketACLs); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+		granted := &tok.Token{UserID: accountID, IsAdmin: isAdmin, ClusterACLs: cluACLs, BucketACLs: bckACLs}
+		for _, acl := range msg.BucketACLs {
+			if err := granted.CheckBckACL(acl); err != nil {
+				return nil, http.StatusBadRequest, fmt.Errorf("API key %q: %s: %v", msg.Name, acl, err)
+			}
+		}
+		isAdmin, cluACLs, bckACLs = false, nil, msg.BucketACLs
+		key.BucketACLs = msg.BucketACLs
+	}
+
+	// NOTE: unlike tokens, API keys never expire unless explicitly requested
+	key.Expires = time.Now().Add(foreverTokenTime)
+	if msg.ExpiresIn != nil {
+		key.Expires = expiresAt(msg.ExpiresIn)
+	}
+	token, err := tok.APIKeyJWT(key.Expires, accountID, key.ID, bckACLs, cluACLs, isAdmin, kring.signer())
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	key.Hash = apiKeyHash(token)
+	if code, err := m.db.Set(apiKeysCollection, key.ID, key); err != nil {
+		return nil, code, err
+	}
+	key.Token, key.Hash = token, ""
+	return key, http.StatusOK, nil
+}
+
+// (without the keys themselves; expired keys are removed)
+func (m *mgr) apiKeys(accountID string) ([]*authn.APIKey, int, error) {
+	recs, code, err := m.db.GetAll(apiKeysCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	var (
+		keys = make([]*authn.APIKey, 0, len(recs))
+		now  = time.Now()
+	)
+	for id, str := range recs {
+		key := &authn.APIKey{}
+		if err := jsoniter.Unmarshal([]byte(str), key); err != nil {
+			nlog.Errorln("failed to unmarshal API key:", err)
+			continue
+		}
+		if key.Expires.Before(now) {
+			m.db.Delete(apiKeysCollection, id)
+			continue
+		}
+		if accountID == "" || key.AccountID == accountID {
+			key.Token, key.Hash = "", ""
+			keys = append(keys, key)
+		}
+	}
+	return keys, http.StatusOK, nil
+}
+
+// Deletes API key and revokes it
+func (m *mgr) delAPIKey(accountID, keyID string) (int, error) {
+	key := &authn.APIKey{}
+	if code, err := m.db.Get(apiKeysCollection, keyID, key); err != nil {
+		return code, err
+	}
+	if key.AccountID != accountID {
+		return http.StatusNotFound, cos.NewErrNotFound(m, "API key of service account "+accountID)
+	}
+	if code, err := m.db.Delete(apiKeysCollection, keyID); err != nil {
+		return code, err
+	}
+	return m.revokeAPIKey(key)
+}
+
+// AuthN does not have the key itself - revoking instead a (permission-less) token that carries
+// the same key ID and expires along with the key; gateways reject all tokens with revoked key IDs
+func (m *mgr) revokeAPIKey(key *authn.APIKey) (int, error) {
+	token, err := tok.APIKeyJWT(key.Expires, key.AccountID, key.ID, nil, nil, false, kring.signer())
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return m.revokeToken(token)
+}
+
+func apiKeyHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Exchanges API key for a regular token with the same permissions.
+// The token expires at the default (or requested) time but never later than the key itself.
+func (m *mgr) issueTokenAPIKey(msg *authn.ExchangeMsg) (token string, code int, err error) {
+	tk, err := kring.parse(msg.APIKey)
+	if err != nil {
+		return "", http.StatusUnauthorized, err
+	}
+	if tk.KeyID == "" {
+		return "", http.StatusUnauthorized, fmt.Errorf("%v: not an API key", tok.ErrInvalidToken)
+	}
+	key := &authn.APIKey{}
+	_, err = m.db.Get(apiKeysCollection, tk.KeyID, key)
+	if err != nil || subtle.ConstantTimeCompare([]byte(key.Hash), []byte(apiKeyHash(msg.APIKey))) != 1 {
+		return "", http.StatusUnauthorized, fmt.Errorf("%v: API key does not exist or has been revoked", tok.ErrInvalidToken)
+	}
+	now := time.Now()
+	if key.Expires.Before(now) {
+		return "", http.StatusUnauthorized, tok.ErrTokenExpired
+	}
+
+	key.LastUsed = now
+	if _, err := m.db.Set(apiKeysCollection, key.ID, key); err != nil {
+		nlog.Errorln("failed to update API key", key.Name, "last-used time:", err)
+	}
+
+	expiresIn := msg.ExpiresIn
+	if expiresIn != nil && *expiresIn == 0 {
+		expiresIn = nil // exchanged tokens always expire (default expiration time)
+	}
+	tk.Expires = expiresAt(expiresIn)
+	if tk.Expires.After(key.Expires) {
+		tk.Expires = key.Expires
+	}
+	if token, err = tk.JWT(kring.signer()); err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	return token, http.StatusOK, nil
+}
@@ -0,0 +1,128 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
+)
+
+// AuthN audit trail: same records and same (rotating, JSONL) log as AIS gateways - see cmn/audit.
+// Enabled and configured via AuthN config ("audit" section), requires restart.
+
+// AuthN operations that have no apc.ActMsg
+const (
+	auditLogin    = "login"
+	auditExchange = "exchange-token"
+	auditRevoke   = "revoke-token"
+)
+
+type auditW struct {
+	http.ResponseWriter
+	rec audit.Record
+}
+
+var alog *audit.Log // nil when not enabled
+
+func audited(handler func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if alog == nil {
+			handler(w, r)
+			return
+		}
+		aw := &auditW{ResponseWriter: w}
+		aw.rec.Method, aw.rec.Path = r.Method, r.URL.Path
+		handler(aw, r)
+
+		rec := &aw.rec
+		if rec.Code == 0 {
+			rec.Code = http.StatusOK
+		}
+		if !Conf.Audit.Selected(rec.Method, rec.Action, rec.Code) {
+			return
+		}
+		rec.Time = time.Now().UnixNano()
+		rec.Node = audit.NodeAuthN
+		rec.ClientIP = audit.ClientIP(r)
+		if rec.User == "" {
+			rec.User = auditUser(r)
+		}
+		alog.Add(rec, int64(Conf.Audit.MaxSize))
+	}
+}
+
+// the caller: token's user (or service account), if any
+func auditUser(r *http.Request) string {
+	token, err := tok.ExtractToken(r.Header)
+	if err != nil {
+		return ""
+	}
+	tk, err := kring.parse(token)
+	if err != nil {
+		return ""
+	}
+	return tk.UserID
+}
+
+// (the user, if known, is the one logging in)
+func auditAction(w http.ResponseWriter, action, user string) {
+	if aw, ok := w.(*auditW); ok {
+		aw.rec.Action, aw.rec.User = action, user
+	}
+}
+
+// the user is the one the (newly issued) token belongs to
+func auditIssued(w http.ResponseWriter, action, token string) {
+	aw, ok := w.(*auditW)
+	if !ok {
+		return
+	}
+	aw.rec.Action = action
+	if tk, err := kring.parse(token); err == nil {
+		aw.rec.User = tk.UserID
+	}
+}
+
+// GET /v1/daemon?what=audit (admin only)
+func httpAuditGet(w http.ResponseWriter, r *http.Request, query url.Values) {
+	if alog == nil {
+		cmn.WriteErrMsg(w, r, "audit is not enabled (see AuthN configuration)")
+		return
+	}
+	q := &audit.Query{}
+	if err := q.FillFromQuery(query); err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	recs, err := alog.Query(q)
+	if err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	writeJSON(w, recs, "get audit records")
+}
+
+////////////
+// auditW //
+////////////
+
+func (aw *auditW) WriteHeader(code int) {
+	if aw.rec.Code == 0 {
+		aw.rec.Code = code
+	}
+	aw.ResponseWriter.WriteHeader(code)
+}
+
+func (aw *auditW) Write(b []byte) (int, error) {
+	if aw.rec.Code == 0 {
+		aw.rec.Code = http.StatusOK
+	}
+	return aw.ResponseWriter.Write(b)
+}
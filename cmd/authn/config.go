@@ -7,6 +7,7 @@ package main
 import (
 	"net/http"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/jsp"
@@ -31,6 +32,10 @@ func httpConfigGet(w http.ResponseWriter, r *http.Request) {
 	if err := validateAdminPerms(w, r); err != nil {
 		return
 	}
+	if query := r.URL.Query(); query.Get(apc.QparamWhat) == apc.WhatAudit {
+		httpAuditGet(w, r, query)
+		return
+	}
 	Conf.Lock()
 	writeJSON(w, Conf, "get config")
 	Conf.Unlock()
@@ -47,7 +52,23 @@ func httpConfigPut(w http.ResponseWriter, r *http.Request) {
 	}
 
 	Conf.Lock()
-	err := Conf.ApplyUpdate(updateCfg)
+	var (
+		prevKeys   = Conf.Server.SigningKeys
+		prevActive = Conf.Server.ActiveKey
+		prevOIDC   = Conf.OIDC
+		err        = Conf.ApplyUpdate(updateCfg)
+	)
+	if err == nil && updateCfg.Server != nil && (updateCfg.Server.SigningKeys != nil || updateCfg.Server.ActiveKey != nil) {
+		// key rotation
+		if err = kring.load(&Conf.Server); err != nil {
+			Conf.Server.SigningKeys, Conf.Server.ActiveKey = prevKeys, prevActive
+		}
+	}
+	if err == nil && updateCfg.OIDC != nil {
+		if err = oidcs.init(&Conf.OIDC); err != nil {
+			Conf.OIDC = prevOIDC
+		}
+	}
 	Conf.Unlock()
 	if err != nil {
 		cmn.WriteErr(w, r, err)
@@ -18,6 +18,10 @@ const (
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
 
+	accessKeysCollection = "s3key"
+	accountsCollection   = "account"
+	apiKeysCollection    = "apikey"
+
 	adminUserID   = "admin"
 	adminUserPass = "admin"
 
@@ -107,6 +107,7 @@ func (h *hserv) Run() error {
 }
 
 func (h *hserv) registerHandler(path string, handler func(http.ResponseWriter, *http.Request)) {
+	handler = audited(handler)
 	h.mux.HandleFunc(path, handler)
 	if !cos.IsLastB(path, '/') {
 		h.mux.HandleFunc(path+"/", handler)
@@ -119,6 +120,8 @@ func (h *hserv) registerPublicHandlers() {
 	h.registerHandler(apc.URLPathClusters.S, h.clusterHandler)
 	h.registerHandler(apc.URLPathRoles.S, h.roleHandler)
 	h.registerHandler(apc.URLPathDae.S, configHandler)
+	h.registerHandler(apc.URLPathJWKS.S, jwksHandler)
+	h.registerHandler(apc.URLPathAccounts.S, h.accountHandler)
 }
 
 func (h *hserv) userHandler(w http.ResponseWriter, r *http.Request) {
@@ -140,8 +143,10 @@ func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
 		h.httpRevokeToken(w, r)
+	case http.MethodPost:
+		h.httpTokenExchange(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost)
 	}
 }
 
@@ -173,19 +178,64 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErrMsg(w, r, "empty token")
 		return
 	}
-	secret := Conf.Secret()
-	if _, err := tok.DecryptToken(msg.Token, secret); err != nil {
+	if _, err := kring.parse(msg.Token); err != nil {
 		cmn.WriteErr(w, r, err)
 		return
 	}
+	auditAction(w, auditRevoke, "")
 	h.mgr.revokeToken(msg.Token)
 }
 
+// Exchanges for AIS token either:
+// - ID token issued by external identity provider (the ID token itself is the credential - see oidc.go), or
+// - service account's API key (see accounts.go)
+func (h *hserv) httpTokenExchange(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	msg := &authn.ExchangeMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	var (
+		token string
+		code  int
+		err   error
+		tag   string
+	)
+	switch {
+	case msg.IDToken != "" && msg.APIKey != "":
+		cmn.WriteErrMsg(w, r, "expecting either ID token or API key (not both)")
+		return
+	case msg.IDToken != "":
+		tag = "OIDC login"
+		token, code, err = h.mgr.issueTokenOIDC(msg)
+	case msg.APIKey != "":
+		tag = "API key exchange"
+		token, code, err = h.mgr.issueTokenAPIKey(msg)
+	default:
+		cmn.WriteErrMsg(w, r, "missing ID token or API key")
+		return
+	}
+	if err != nil {
+		auditAction(w, auditExchange, "")
+		nlog.Errorf("%s failed: %v", tag, err)
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	auditIssued(w, auditExchange, token)
+	writeJSON(w, &authn.TokenMsg{Token: token}, tag)
+}
+
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := parseURL(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
 		return
 	}
+	if len(apiItems) > 1 {
+		h.delAccessKey(w, r, apiItems)
+		return
+	}
 	if err = validateAdminPerms(w, r); err != nil {
 		return
 	}
@@ -200,9 +250,12 @@ func (h *hserv) httpUserPost(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if len(apiItems) == 0 {
+	switch {
+	case len(apiItems) == 0:
 		h.userAdd(w, r)
-	} else {
+	case len(apiItems) == 2 && apiItems[1] == apc.AccessKeys:
+		h.addAccessKey(w, r, apiItems[0])
+	default:
 		h.userLogin(w, r)
 	}
 }
@@ -257,6 +310,10 @@ func (h *hserv) httpUserGet(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(items) == 2 && items[1] == apc.AccessKeys {
+		h.listAccessKeys(w, r, items[0])
+		return
+	}
 	if len(items) > 1 {
 		cmn.WriteErrMsg(w, r, "invalid request")
 		return
@@ -290,11 +347,16 @@ func getToken(r *http.Request) (*tok.Token, error) {
 	if err != nil {
 		return nil, err
 	}
-	secret := Conf.Secret()
-	tk, err := tok.DecryptToken(tokenStr, secret)
+	tk, err := kring.parse(tokenStr)
 	if err != nil {
 		return nil, err
 	}
+	if tk.IsAccessKey {
+		return nil, tok.ErrAccessKey
+	}
+	if tk.KeyID != "" {
+		return nil, tok.ErrAPIKey
+	}
 	if tk.Expires.Before(time.Now()) {
 		return nil, fmt.Errorf("not authorized (token expired): %s", tk)
 	}
@@ -334,6 +396,71 @@ func validateUpdatePerms(w http.ResponseWriter, r *http.Request, userID string,
 	return err
 }
 
+// Checks that the request comes from either admin or the user itself
+func validateUserPerms(w http.ResponseWriter, r *http.Request, userID string) error {
+	tk, err := getToken(r)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return err
+	}
+	if tk.IsAdmin || tk.UserID == userID {
+		return nil
+	}
+	err = fmt.Errorf("not authorized: (%s)", tk)
+	cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+	return err
+}
+
+// POST /v1/users/<user-id>/keys
+func (h *hserv) addAccessKey(w http.ResponseWriter, r *http.Request, userID string) {
+	if err := validateUserPerms(w, r, userID); err != nil {
+		return
+	}
+	msg := &authn.AccessKeyMsg{}
+	if r.ContentLength > 0 {
+		if err := cmn.ReadJSON(w, r, msg); err != nil {
+			return
+		}
+	}
+	key, code, err := h.mgr.addAccessKey(userID, msg)
+	if err != nil {
+		h.failAction(w, r, "add access key for", userID, err, code)
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("Add access key for user %q", userID)
+	}
+	writeJSON(w, key, "add access key")
+}
+
+// GET /v1/users/<user-id>/keys
+func (h *hserv) listAccessKeys(w http.ResponseWriter, r *http.Request, userID string) {
+	if err := validateUserPerms(w, r, userID); err != nil {
+		return
+	}
+	keys, code, err := h.mgr.accessKeys(userID)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	writeJSON(w, keys, "list access keys")
+}
+
+// DELETE /v1/users/<user-id>/keys/<access-key-id>
+func (h *hserv) delAccessKey(w http.ResponseWriter, r *http.Request, apiItems []string) {
+	if len(apiItems) != 3 || apiItems[1] != apc.AccessKeys {
+		cmn.WriteErrMsg(w, r, "invalid request")
+		return
+	}
+	userID := apiItems[0]
+	if err := validateUserPerms(w, r, userID); err != nil {
+		return
+	}
+	if code, err := h.mgr.delAccessKey(userID, apiItems[2]); err != nil {
+		h.failAction(w, r, "delete access key of", userID, err, code)
+	}
+}
+
 // Generate h token for h user if provided credentials are valid.
 // If h token is already issued and it is not expired yet then the old
 // token is returned
@@ -356,6 +483,7 @@ func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 		code   int
 		userID = apiItems[0]
 	)
+	auditAction(w, auditLogin, userID)
 	if token, code, err = h.mgr.issueToken(userID, msg.Password, msg); err != nil {
 		h.failAction(w, r, "generate token for", userID, err, code)
 		return
@@ -567,3 +695,154 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 		h.failAction(w, r, "update role", role, err, code)
 	}
 }
+
+//
+// service accounts and API keys (admin only)
+//
+
+func (h *hserv) accountHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.httpAccountPost(w, r)
+	case http.MethodPut:
+		h.httpAccountPut(w, r)
+	case http.MethodDelete:
+		h.httpAccountDel(w, r)
+	case http.MethodGet:
+		h.httpAccountGet(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
+	}
+}
+
+// POST /v1/accounts
+// POST /v1/accounts/<account-id>/keys
+func (h *hserv) httpAccountPost(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 0, apc.URLPathAccounts.L)
+	if err != nil {
+		return
+	}
+	if err = validateAdminPerms(w, r); err != nil {
+		return
+	}
+	switch {
+	case len(apiItems) == 0:
+		sa := &authn.ServiceAccount{}
+		if err := cmn.ReadJSON(w, r, sa); err != nil {
+			return
+		}
+		if code, err := h.mgr.addAccount(sa); err != nil {
+			h.failAction(w, r, "add service account", sa.ID, err, code)
+			return
+		}
+		if Conf.Verbose() {
+			nlog.Infof("Add service account %q", sa.ID)
+		}
+	case len(apiItems) == 2 && apiItems[1] == apc.AccessKeys:
+		accountID := apiItems[0]
+		msg := &authn.APIKeyMsg{}
+		if err := cmn.ReadJSON(w, r, msg); err != nil {
+			return
+		}
+		key, code, err := h.mgr.addAPIKey(accountID, msg)
+		if err != nil {
+			h.failAction(w, r, "add API key for", accountID, err, code)
+			return
+		}
+		if Conf.Verbose() {
+			nlog.Infof("Add API key %q for service account %q", key.Name, accountID)
+		}
+		writeJSON(w, key, "add API key")
+	default:
+		cmn.WriteErrMsg(w, r, "invalid request")
+	}
+}
+
+// PUT /v1/accounts/<account-id>
+func (h *hserv) httpAccountPut(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 1, apc.URLPathAccounts.L)
+	if err != nil {
+		return
+	}
+	if err = validateAdminPerms(w, r); err != nil {
+		return
+	}
+	var (
+		accountID = apiItems[0]
+		updateReq = &authn.ServiceAccount{}
+	)
+	if err := cmn.ReadJSON(w, r, updateReq); err != nil {
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("PUT service account %q", accountID)
+	}
+	if code, err := h.mgr.updateAccount(accountID, updateReq); err != nil {
+		h.failAction(w, r, "update service account", accountID, err, code)
+	}
+}
+
+// DELETE /v1/accounts/<account-id>
+// DELETE /v1/accounts/<account-id>/keys/<key-id>
+func (h *hserv) httpAccountDel(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 1, apc.URLPathAccounts.L)
+	if err != nil {
+		return
+	}
+	if err = validateAdminPerms(w, r); err != nil {
+		return
+	}
+	accountID := apiItems[0]
+	switch {
+	case len(apiItems) == 1:
+		if code, err := h.mgr.delAccount(accountID); err != nil {
+			h.failAction(w, r, "delete service account", accountID, err, code)
+		}
+	case len(apiItems) == 3 && apiItems[1] == apc.AccessKeys:
+		if code, err := h.mgr.delAPIKey(accountID, apiItems[2]); err != nil {
+			h.failAction(w, r, "delete API key of", accountID, err, code)
+		}
+	default:
+		cmn.WriteErrMsg(w, r, "invalid request")
+	}
+}
+
+// GET /v1/accounts[/<account-id>[/keys]]
+func (h *hserv) httpAccountGet(w http.ResponseWriter, r *http.Request) {
+	apiItems, err := parseURL(w, r, 0, apc.URLPathAccounts.L)
+	if err != nil {
+		return
+	}
+	if err = validateAdminPerms(w, r); err != nil {
+		return
+	}
+	switch {
+	case len(apiItems) == 0:
+		accounts, code, err := h.mgr.accountList()
+		if err != nil {
+			cmn.WriteErr(w, r, err, code)
+			return
+		}
+		writeJSON(w, accounts, "list service accounts")
+	case len(apiItems) == 1:
+		sa, code, err := h.mgr.lookupAccount(apiItems[0])
+		if err != nil {
+			cmn.WriteErr(w, r, err, code)
+			return
+		}
+		writeJSON(w, sa, "get service account")
+	case len(apiItems) == 2 && apiItems[1] == apc.AccessKeys:
+		if _, code, err := h.mgr.lookupAccount(apiItems[0]); err != nil {
+			cmn.WriteErr(w, r, err, code)
+			return
+		}
+		keys, code, err := h.mgr.apiKeys(apiItems[0])
+		if err != nil {
+			cmn.WriteErr(w, r, err, code)
+			return
+		}
+		writeJSON(w, keys, "list API keys")
+	default:
+		cmn.WriteErrMsg(w, r, "invalid request")
+	}
+}
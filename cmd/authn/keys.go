@@ -0,0 +1,107 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"crypto"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Asymmetric signing keys (see authn.ServerConf.SigningKeys)
+// - the active key signs new tokens; all configured keys verify
+// - public keys are published via GET /v1/jwks for AIS gateways to fetch and cache
+// - without signing keys, tokens are signed with the shared secret (HS256)
+// - tokens signed with the shared secret remain valid in either case (until they expire)
+type keyring struct {
+	active *tok.SigningKey
+	keys   map[string]*tok.SigningKey
+	jwks   []byte // marshaled authn.JWKS
+	mu     sync.RWMutex
+}
+
+var kring keyring
+
+// (re)load upon startup and config update
+func (kr *keyring) load(conf *authn.ServerConf) error {
+	if err := conf.ValidateKeys(); err != nil {
+		return err
+	}
+	var (
+		keys = make(map[string]*tok.SigningKey, len(conf.SigningKeys))
+		jwks = authn.JWKS{Keys: make([]authn.JWK, 0, len(conf.SigningKeys))}
+	)
+	for _, skc := range conf.SigningKeys {
+		sk, err := tok.LoadSigningKey(skc.ID, skc.Path)
+		if err != nil {
+			return err
+		}
+		keys[sk.ID] = sk
+		jwks.Keys = append(jwks.Keys, sk.JWK())
+	}
+
+	kr.mu.Lock()
+	kr.keys, kr.active = keys, keys[conf.ActiveKey]
+	kr.jwks = cos.MustMarshal(&jwks)
+	kr.mu.Unlock()
+
+	if conf.ActiveKey != "" {
+		nlog.Infof("signing keys: %d, active %q (%s)", len(keys), conf.ActiveKey, keys[conf.ActiveKey].Alg())
+	}
+	return nil
+}
+
+func (kr *keyring) signer() tok.Signer {
+	kr.mu.RLock()
+	active := kr.active
+	kr.mu.RUnlock()
+	if active != nil {
+		return active
+	}
+	return tok.SecretKey(Conf.Secret())
+}
+
+func (kr *keyring) pubKey(kid, alg string) (crypto.PublicKey, error) {
+	kr.mu.RLock()
+	sk, ok := kr.keys[kid]
+	kr.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%v: unknown key ID %q", tok.ErrInvalidToken, kid)
+	}
+	if sk.Alg() != alg {
+		return nil, fmt.Errorf("%v: key %q does not support %s", tok.ErrInvalidToken, kid, alg)
+	}
+	return sk.Public(), nil
+}
+
+func (kr *keyring) parse(token string) (*tok.Token, error) {
+	kr.mu.RLock()
+	l := len(kr.keys)
+	kr.mu.RUnlock()
+	if l == 0 {
+		return tok.ParseToken(token, Conf.Secret(), nil)
+	}
+	return tok.ParseToken(token, Conf.Secret(), kr.pubKey)
+}
+
+// GET /v1/jwks (public)
+func jwksHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		cmn.WriteErr405(w, r, http.MethodGet)
+		return
+	}
+	kring.mu.RLock()
+	b := kring.jwks
+	kring.mu.RUnlock()
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Write(b)
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/jsp"
@@ -74,9 +75,21 @@ func main() {
 	if val := os.Getenv(env.AisAuthSecretKey); val != "" {
 		Conf.SetSecret(&val)
 	}
+	if err := kring.load(&Conf.Server); err != nil {
+		cos.ExitLogf("Failed to load signing keys: %v", err)
+	}
+	if err := oidcs.init(&Conf.OIDC); err != nil {
+		cos.ExitLogf("Failed to initialize OIDC issuers: %v", err)
+	}
 	if err := updateLogOptions(); err != nil {
 		cos.ExitLogf("Failed to set up logger: %v", err)
 	}
+	if err := Conf.Audit.Validate(); err != nil {
+		cos.ExitLogf("Invalid audit configuration: %v", err)
+	}
+	if Conf.Audit.Enabled {
+		alog = audit.NewLog(cos.GetEnvOrDefault(env.AisAuthLogDir, Conf.Log.Dir))
+	}
 	if Conf.Verbose() {
 		nlog.Infof("Loaded configuration from %s", configPath)
 	}
@@ -98,6 +111,9 @@ func main() {
 	srv := newServer(mgr)
 	err = srv.Run()
 
+	if alog != nil {
+		alog.Close()
+	}
 	nlog.Flush(nlog.ActExit)
 	cos.Close(mgr.db)
 	if err != nil {
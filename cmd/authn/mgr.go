@@ -76,6 +76,9 @@ func (m *mgr) addUser(info *authn.User) (int, error) {
 	if err == nil {
 		return http.StatusConflict, cos.NewErrAlreadyExists(m, "user "+info.ID)
 	}
+	if _, _, err := m.db.GetString(accountsCollection, info.ID); err == nil {
+		return http.StatusConflict, cos.NewErrAlreadyExists(m, "service account "+info.ID)
+	}
 	info.Password = encryptPassword(info.Password)
 	return m.db.Set(usersCollection, info.ID, info)
 }
@@ -85,7 +88,19 @@ func (m *mgr) delUser(userID string) (int, error) {
 	if userID == adminUserID {
 		return http.StatusForbidden, fmt.Errorf("cannot remove built-in %q account", adminUserID)
 	}
-	return m.db.Delete(usersCollection, userID)
+	code, err := m.db.Delete(usersCollection, userID)
+	if err != nil {
+		return code, err
+	}
+	// revoke user's S3 access keys, if any
+	keys, _, err := m.accessKeys(userID)
+	if err != nil {
+		nlog.Errorln(err)
+	}
+	for _, key := range keys {
+		m.delAccessKey(userID, key.ID)
+	}
+	return code, nil
 }
 
 // Updates an existing user. The function invalidates user tokens after
@@ -149,6 +164,9 @@ func (m *mgr) addRole(info *authn.Role) (int, error) {
 	if info.IsAdmin {
 		return http.StatusForbidden, fmt.Errorf("only built-in roles can have %q permissions", adminUserID)
 	}
+	if err := validateBckACLs(info.BucketACLs); err != nil {
+		return http.StatusBadRequest, err
+	}
 	_, _, err := m.db.GetString(rolesCollection, info.Name)
 	if err == nil {
 		return http.StatusConflict, cos.NewErrAlreadyExists(m, "role "+info.Name)
@@ -169,6 +187,9 @@ func (m *mgr) updateRole(role string, updateReq *authn.Role) (int, error) {
 	if role == authn.AdminRole {
 		return http.StatusForbidden, fmt.Errorf("cannot modify built-in %q role", authn.AdminRole)
 	}
+	if err := validateBckACLs(updateReq.BucketACLs); err != nil {
+		return http.StatusBadRequest, err
+	}
 	rInfo := &authn.Role{}
 	code, err := m.db.Get(rolesCollection, role, rInfo)
 	if err != nil {
@@ -365,12 +386,7 @@ func (m *mgr) delCluster(cluID string) (int, error) {
 // Token includes user ID, permissions, and token expiration time.
 // If a new token was generated then it sends the proxy a new valid token list
 func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, code int, err error) {
-	var (
-		uInfo   = &authn.User{}
-		cid     string
-		cluACLs []*authn.CluACL
-		bckACLs []*authn.BckACL
-	)
+	uInfo := &authn.User{}
 	_, err = m.db.Get(usersCollection, uid, uInfo)
 	if err != nil {
 		nlog.Errorln(err)
@@ -383,13 +399,8 @@ func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, co
 		return "", http.StatusUnauthorized, errInvalidCredentials
 	}
 
-	// update ACLs with roles' ones
-	for _, role := range uInfo.Roles {
-		cluACLs = mergeClusterACLs(cluACLs, role.ClusterACLs, cid)
-		bckACLs = mergeBckACLs(bckACLs, role.BucketACLs, cid)
-	}
-
 	// generate token
+	cluACLs, bckACLs := userACLs(uInfo)
 	token, err = m._token(msg, uInfo, cluACLs, bckACLs)
 	if err != nil {
 		return "", http.StatusInternalServerError, err
@@ -397,25 +408,38 @@ func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, co
 	return token, http.StatusOK, nil
 }
 
-func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) (token string, err error) {
+// update ACLs with roles' ones
+func userACLs(uInfo *authn.User) (cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) {
+	var cid string
+	for _, role := range uInfo.Roles {
+		cluACLs = mergeClusterACLs(cluACLs, role.ClusterACLs, cid)
+		bckACLs = mergeBckACLs(bckACLs, role.BucketACLs, cid)
+	}
+	return cluACLs, bckACLs
+}
+
+func expiresAt(expiresIn *time.Duration) time.Time {
 	expDelta := Conf.Expire()
-	if msg.ExpiresIn != nil {
-		expDelta = *msg.ExpiresIn
+	if expiresIn != nil {
+		expDelta = *expiresIn
 	}
 	if expDelta == 0 {
 		expDelta = foreverTokenTime
 	}
+	return time.Now().Add(expDelta)
+}
 
+func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) (token string, err error) {
 	// put all useful info into token: who owns the token, when it was issued,
 	// when it expires and credentials to log in AWS, GCP etc.
 	// If a user is a super user, it is enough to pass only isAdmin marker
-	expires := time.Now().Add(expDelta)
+	expires := expiresAt(msg.ExpiresIn)
 	uid := uInfo.ID
 	if uInfo.IsAdmin() {
-		token, err = tok.AdminJWT(expires, uid, Conf.Secret())
+		token, err = tok.AdminJWT(expires, uid, kring.signer())
 	} else {
 		m.fixClusterIDs(cluACLs)
-		token, err = tok.JWT(expires, uid, bckACLs, cluACLs, Conf.Secret())
+		token, err = tok.JWT(expires, uid, bckACLs, cluACLs, kring.signer())
 	}
 	return token, err
 }
@@ -464,9 +488,8 @@ func (m *mgr) generateRevokedTokenList() ([]string, int, error) {
 
 	now := time.Now()
 	revokeList := make([]string, 0, len(tokens))
-	secret := Conf.Secret()
 	for _, token := range tokens {
-		tk, err := tok.DecryptToken(token, secret)
+		tk, err := kring.parse(token)
 		if err != nil {
 			m.db.Delete(revokedCollection, token)
 			continue
@@ -481,6 +504,84 @@ func (m *mgr) generateRevokedTokenList() ([]string, int, error) {
 	return revokeList, http.StatusOK, nil
 }
 
+//
+// S3 access keys =============================================================
+//
+
+// Issues a new S3 (SigV4) access key on behalf of an existing user.
+// Similar to tokens, the key ID carries the user's permissions (at the time of issuing),
+// while the secret access key is derived (see tok.AccessKeySecret) and returned only once.
+func (m *mgr) addAccessKey(uid string, msg *authn.AccessKeyMsg) (*authn.AccessKey, int, error) {
+	uInfo := &authn.User{}
+	if code, err := m.db.Get(usersCollection, uid, uInfo); err != nil {
+		return nil, code, err
+	}
+	var (
+		akid    string
+		err     error
+		secret  = Conf.Secret()
+		expires = expiresAt(msg.ExpiresIn)
+	)
+	if uInfo.IsAdmin() {
+		akid, err = tok.AccessKeyJWT(expires, uid, nil, nil, true, secret)
+	} else {
+		cluACLs, bckACLs := userACLs(uInfo)
+		m.fixClusterIDs(cluACLs)
+		akid, err = tok.AccessKeyJWT(expires, uid, bckACLs, cluACLs, false, secret)
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	key := &authn.AccessKey{ID: akid, UserID: uid, Expires: expires}
+	if code, err := m.db.Set(accessKeysCollection, akid, key); err != nil {
+		return nil, code, err
+	}
+	key.Secret = tok.AccessKeySecret(akid, secret)
+	return key, http.StatusOK, nil
+}
+
+// (without secrets; expired keys are removed)
+func (m *mgr) accessKeys(uid string) ([]*authn.AccessKey, int, error) {
+	recs, code, err := m.db.GetAll(accessKeysCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	var (
+		keys = make([]*authn.AccessKey, 0, len(recs))
+		now  = time.Now()
+	)
+	for akid, str := range recs {
+		key := &authn.AccessKey{}
+		if err := jsoniter.Unmarshal([]byte(str), key); err != nil {
+			nlog.Errorln("failed to unmarshal access key:", err)
+			continue
+		}
+		if key.Expires.Before(now) {
+			m.db.Delete(accessKeysCollection, akid)
+			continue
+		}
+		if uid == "" || key.UserID == uid {
+			keys = append(keys, key)
+		}
+	}
+	return keys, http.StatusOK, nil
+}
+
+// Deletes access key and revokes it (the same way tokens are revoked)
+func (m *mgr) delAccessKey(uid, akid string) (int, error) {
+	key := &authn.AccessKey{}
+	if code, err := m.db.Get(accessKeysCollection, akid, key); err != nil {
+		return code, err
+	}
+	if key.UserID != uid {
+		return http.StatusNotFound, cos.NewErrNotFound(m, "access key of user "+uid)
+	}
+	if code, err := m.db.Delete(accessKeysCollection, akid); err != nil {
+		return code, err
+	}
+	return m.revokeToken(akid)
+}
+
 //
 // private helpers ============================================================
 //
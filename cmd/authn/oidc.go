@@ -0,0 +1,328 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	"github.com/golang-jwt/jwt/v4"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// OIDC federation (see authn.OIDCConf):
+// - validate ID token issued by one of the configured identity providers (issuer, audience, signature, expiration)
+// - map its groups to existing AuthN roles
+// - issue AIS token with the resulting cluster and bucket ACLs, on behalf of "<issuer-name>:<user>"
+//   (local user IDs cannot contain ':' - see mgr.addUser)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+	oidcMaxAge  = 10 * time.Minute // refresh public keys at least that often
+	oidcMinAge  = 10 * time.Second // and not more often than that (unknown key ID, failure to fetch)
+	oidcTimeout = 10 * time.Second
+
+	dfltUserClaim   = "sub"
+	dfltGroupsClaim = "groups"
+)
+
+type (
+	oidcIssuer struct {
+		client  *http.Client
+		jwks    *authn.JWKS
+		jwksURL string // configured or discovered
+		conf    authn.OIDCIssuerConf
+		loaded  int64 // mono-time
+		failed  int64 // ditto
+		mu      sync.Mutex
+	}
+	oidcIssuers struct {
+		m  map[string]*oidcIssuer // by issuer URL
+		mu sync.RWMutex
+	}
+	oidcDiscovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+)
+
+var (
+	oidcs oidcIssuers
+
+	errOIDCUnknownIssuer = errors.New("unknown OIDC issuer")
+)
+
+/////////////////
+// oidcIssuers //
+/////////////////
+
+// (re)initialize upon startup and config update
+func (o *oidcIssuers) init(conf *authn.OIDCConf) error {
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+	m := make(map[string]*oidcIssuer, len(conf.Issuers))
+	for i := range conf.Issuers {
+		oi, err := newOIDCIssuer(&conf.Issuers[i])
+		if err != nil {
+			return err
+		}
+		m[oi.conf.Issuer] = oi
+		nlog.Infoln("OIDC issuer", oi.conf.Name, oi.conf.Issuer)
+	}
+	o.mu.Lock()
+	o.m = m
+	o.mu.Unlock()
+	return nil
+}
+
+func (o *oidcIssuers) get(iss string) *oidcIssuer {
+	o.mu.RLock()
+	oi := o.m[iss]
+	o.mu.RUnlock()
+	return oi
+}
+
+////////////////
+// oidcIssuer //
+////////////////
+
+func newOIDCIssuer(conf *authn.OIDCIssuerConf) (*oidcIssuer, error) {
+	oi := &oidcIssuer{conf: *conf, jwksURL: conf.JWKSURL}
+	if oi.conf.UserClaim == "" {
+		oi.conf.UserClaim = dfltUserClaim
+	}
+	if oi.conf.GroupsClaim == "" {
+		oi.conf.GroupsClaim = dfltGroupsClaim
+	}
+	if conf.CACert != "" {
+		if _, err := os.Stat(conf.CACert); err != nil {
+			return nil, fmt.Errorf("OIDC issuer %q: %v", conf.Name, err)
+		}
+	}
+	// NOTE: always verify the issuer's certificate (compare with mgr.clientTLS)
+	tlsConf, err := cmn.NewTLS(cmn.TLSArgs{ClientCA: conf.CACert}, false /*intra-cluster*/)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC issuer %q: %v", conf.Name, err)
+	}
+	transport := cmn.NewTransport(cmn.TransportArgs{Timeout: oidcTimeout})
+	transport.TLSClientConfig = tlsConf
+	oi.client = &http.Client{Transport: transport, Timeout: oidcTimeout}
+	return oi, nil
+}
+
+// validate ID token and return its claims
+func (oi *oidcIssuer) verify(idToken string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return oi.pubKey(kid, t.Method.Alg())
+	}, jwt.WithValidMethods([]string{tok.AlgRS256, tok.AlgES256}))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	switch {
+	case !claims.VerifyExpiresAt(now, true /*required*/):
+		return nil, tok.ErrTokenExpired
+	case !claims.VerifyIssuer(oi.conf.Issuer, true):
+		return nil, fmt.Errorf("%v: issuer mismatch", tok.ErrInvalidToken)
+	case !claims.VerifyAudience(oi.conf.Audience, true):
+		return nil, fmt.Errorf("%v: audience mismatch", tok.ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+// (required and verified - see oidcIssuer.verify)
+func idTokenExp(claims jwt.MapClaims) (time.Time, bool) {
+	switch exp := claims["exp"].(type) {
+	case float64:
+		return time.Unix(int64(exp), 0), true
+	case json.Number:
+		v, err := exp.Int64()
+		return time.Unix(v, 0), err == nil
+	}
+	return time.Time{}, false
+}
+
+// user name and groups
+func (oi *oidcIssuer) identity(claims jwt.MapClaims) (user string, groups []string, err error) {
+	user, _ = claims[oi.conf.UserClaim].(string)
+	if user == "" {
+		return "", nil, fmt.Errorf("%v: missing %q claim", tok.ErrInvalidToken, oi.conf.UserClaim)
+	}
+	switch v := claims[oi.conf.GroupsClaim].(type) {
+	case string:
+		groups = []string{v}
+	case []any:
+		groups = make([]string, 0, len(v))
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+	}
+	return oi.conf.Name + ":" + user, groups, nil
+}
+
+func (oi *oidcIssuer) pubKey(kid, alg string) (crypto.PublicKey, error) {
+	oi.mu.Lock()
+	defer oi.mu.Unlock()
+	var (
+		jwk   = oi.find(kid)
+		now   = mono.NanoTime()
+		since = time.Duration(now - oi.loaded)
+	)
+	if (since > oidcMaxAge || (jwk == nil && since > oidcMinAge)) && time.Duration(now-oi.failed) > oidcMinAge {
+		if err := oi.load(); err != nil {
+			oi.failed = now
+			if jwk == nil {
+				return nil, err
+			}
+			nlog.Warningln("OIDC issuer", oi.conf.Name, "failed to refresh public keys (using cached):", err)
+		} else {
+			jwk = oi.find(kid)
+		}
+	}
+	if jwk == nil {
+		return nil, fmt.Errorf("%v: unknown key ID %q (issuer %q)", tok.ErrInvalidToken, kid, oi.conf.Name)
+	}
+	return tok.JWKPublicKey(jwk, alg)
+}
+
+// under lock; (no key ID) is fine when there's a single key
+func (oi *oidcIssuer) find(kid string) *authn.JWK {
+	if oi.jwks == nil {
+		return nil
+	}
+	if kid == "" {
+		if len(oi.jwks.Keys) == 1 {
+			return &oi.jwks.Keys[0]
+		}
+		return nil
+	}
+	return oi.jwks.Find(kid)
+}
+
+// under lock
+func (oi *oidcIssuer) load() error {
+	if oi.jwksURL == "" {
+		disc := &oidcDiscovery{}
+		if err := oi.get(strings.TrimSuffix(oi.conf.Issuer, "/")+oidcDiscoveryPath, disc); err != nil {
+			return err
+		}
+		if disc.Issuer != oi.conf.Issuer || disc.JWKSURI == "" {
+			return fmt.Errorf("OIDC issuer %q: invalid discovery document (issuer %q, jwks_uri %q)",
+				oi.conf.Name, disc.Issuer, disc.JWKSURI)
+		}
+		oi.jwksURL = disc.JWKSURI
+	}
+	jwks := &authn.JWKS{}
+	if err := oi.get(oi.jwksURL, jwks); err != nil {
+		if oi.conf.JWKSURL == "" {
+			oi.jwksURL = "" // rediscover next time
+		}
+		return err
+	}
+	oi.jwks, oi.loaded = jwks, mono.NanoTime()
+	return nil
+}
+
+func (oi *oidcIssuer) get(url string, v any) error {
+	resp, err := oi.client.Get(url)
+	if err != nil {
+		return err
+	}
+	b, err := cos.ReadAllN(resp.Body, resp.ContentLength)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s (%s)", url, resp.Status, cos.BHead(b))
+	}
+	return jsoniter.Unmarshal(b, v)
+}
+
+/////////
+// mgr //
+/////////
+
+// exchange ID token for AIS token
+func (m *mgr) issueTokenOIDC(msg *authn.ExchangeMsg) (token string, code int, err error) {
+	unverified := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(msg.IDToken, unverified); err != nil {
+		return "", http.StatusUnauthorized, tok.ErrInvalidToken
+	}
+	iss, _ := unverified["iss"].(string)
+	oi := oidcs.get(iss)
+	if oi == nil {
+		return "", http.StatusUnauthorized, fmt.Errorf("%v %q", errOIDCUnknownIssuer, iss)
+	}
+	claims, err := oi.verify(msg.IDToken)
+	if err != nil {
+		return "", http.StatusUnauthorized, err
+	}
+	uid, groups, err := oi.identity(claims)
+	if err != nil {
+		return "", http.StatusUnauthorized, err
+	}
+
+	// groups => roles
+	var (
+		uInfo = &authn.User{ID: uid}
+		added = make(cos.StrSet, 4)
+	)
+	for _, g := range groups {
+		for _, name := range oi.conf.GroupRoles[g] {
+			if added.Contains(name) {
+				continue
+			}
+			role, _, err := m.lookupRole(name)
+			if err != nil {
+				nlog.Warningln("OIDC issuer", oi.conf.Name, "group", g, "maps to non-existing role", name)
+				continue
+			}
+			added.Add(name)
+			uInfo.Roles = append(uInfo.Roles, role)
+		}
+	}
+	if len(uInfo.Roles) == 0 {
+		return "", http.StatusForbidden, fmt.Errorf("%v: %s (groups %v) maps to no roles", tok.ErrNoPermissions, uid, groups)
+	}
+
+	// federated tokens always expire: no later than the (configured) default expiration time
+	// and the ID token itself
+	expiresIn := Conf.Expire()
+	if msg.ExpiresIn != nil && *msg.ExpiresIn > 0 && (expiresIn == 0 || *msg.ExpiresIn < expiresIn) {
+		expiresIn = *msg.ExpiresIn
+	}
+	if exp, ok := idTokenExp(claims); ok {
+		if d := time.Until(exp); expiresIn == 0 || d < expiresIn {
+			expiresIn = d
+		}
+	}
+	lmsg := &authn.LoginMsg{ExpiresIn: &expiresIn}
+	cluACLs, bckACLs := userACLs(uInfo)
+	if token, err = m._token(lmsg, uInfo, cluACLs, bckACLs); err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	return token, http.StatusOK, nil
+}
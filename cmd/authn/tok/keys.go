@@ -0,0 +1,216 @@
+// Package tok provides AuthN token (structure and methods)
+// for validation by AIS gateways
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package tok
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+
+	ktyRSA  = "RSA"
+	ktyEC   = "EC"
+	crvP256 = "P-256"
+
+	sizeP256 = 32 // P-256 coordinate size in bytes
+)
+
+var validMethods = []string{jwt.SigningMethodHS256.Alg(), AlgRS256, AlgES256}
+
+type (
+	// signs (new) token claims
+	Signer interface {
+		Sign(claims jwt.MapClaims) (string, error)
+	}
+
+	// HS256: shared secret
+	SecretKey string
+
+	// RS256 or ES256: private key under a given key ID ("kid" in the token header)
+	SigningKey struct {
+		method jwt.SigningMethod
+		priv   crypto.Signer
+		ID     string
+	}
+
+	// returns public key to verify a token signed with the given key ID and algorithm
+	PubKeyFunc func(kid, alg string) (crypto.PublicKey, error)
+)
+
+// interface guard
+var (
+	_ Signer = SecretKey("")
+	_ Signer = (*SigningKey)(nil)
+)
+
+var errNoPEM = errors.New("no PEM data")
+
+///////////////
+// SecretKey //
+///////////////
+
+func (secret SecretKey) Sign(claims jwt.MapClaims) (string, error) {
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return t.SignedString([]byte(secret))
+}
+
+////////////////
+// SigningKey //
+////////////////
+
+func NewSigningKey(kid string, priv crypto.Signer) (*SigningKey, error) {
+	sk := &SigningKey{ID: kid, priv: priv}
+	switch k := priv.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("signing key %q: RSA key is too short (%d bits)", kid, k.N.BitLen())
+		}
+		sk.method = jwt.SigningMethodRS256
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("signing key %q: unsupported curve %s (expecting %s)", kid, k.Curve.Params().Name, crvP256)
+		}
+		sk.method = jwt.SigningMethodES256
+	default:
+		return nil, fmt.Errorf("signing key %q: unsupported key type %T", kid, priv)
+	}
+	return sk, nil
+}
+
+// load PEM-encoded private key: PKCS #8, PKCS #1 (RSA), or SEC 1 (EC)
+func LoadSigningKey(kid, fpath string) (*SigningKey, error) {
+	b, err := os.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("signing key %q (%s): %v", kid, fpath, errNoPEM)
+	}
+	var priv any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		priv, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		priv, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		priv, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("signing key %q (%s): %v", kid, fpath, err)
+	}
+	signer, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("signing key %q (%s): unsupported key type %T", kid, fpath, priv)
+	}
+	return NewSigningKey(kid, signer)
+}
+
+func (sk *SigningKey) Alg() string              { return sk.method.Alg() }
+func (sk *SigningKey) Public() crypto.PublicKey { return sk.priv.Public() }
+
+func (sk *SigningKey) Sign(claims jwt.MapClaims) (string, error) {
+	t := jwt.NewWithClaims(sk.method, claims)
+	t.Header["kid"] = sk.ID
+	return t.SignedString(sk.priv)
+}
+
+func (sk *SigningKey) JWK() authn.JWK {
+	jwk, err := PublicJWK(sk.ID, sk.priv.Public())
+	debug.AssertNoErr(err) // (validated by NewSigningKey)
+	return jwk
+}
+
+/////////
+// JWK //
+/////////
+
+func PublicJWK(kid string, pub crypto.PublicKey) (jwk authn.JWK, err error) {
+	jwk.Kid, jwk.Use = kid, "sig"
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty, jwk.Alg = ktyRSA, AlgRS256
+		jwk.N = b64(k.N.Bytes())
+		jwk.E = b64(big.NewInt(int64(k.E)).Bytes())
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return jwk, fmt.Errorf("key %q: unsupported curve %s", kid, k.Curve.Params().Name)
+		}
+		jwk.Kty, jwk.Alg, jwk.Crv = ktyEC, AlgES256, crvP256
+		jwk.X = b64(k.X.FillBytes(make([]byte, sizeP256)))
+		jwk.Y = b64(k.Y.FillBytes(make([]byte, sizeP256)))
+	default:
+		return jwk, fmt.Errorf("key %q: unsupported key type %T", kid, pub)
+	}
+	return jwk, nil
+}
+
+// convert JWK to public key, and check that it can verify the given algorithm
+func JWKPublicKey(jwk *authn.JWK, alg string) (crypto.PublicKey, error) {
+	if jwk.Alg != "" && alg != "" && jwk.Alg != alg {
+		return nil, fmt.Errorf("key %q: algorithm mismatch (%s vs %s)", jwk.Kid, jwk.Alg, alg)
+	}
+	switch jwk.Kty {
+	case ktyRSA:
+		if alg != "" && alg != AlgRS256 {
+			break
+		}
+		n, err := unb64(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid modulus: %v", jwk.Kid, err)
+		}
+		e, err := unb64(jwk.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("key %q: invalid exponent", jwk.Kid)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case ktyEC:
+		if alg != "" && alg != AlgES256 {
+			break
+		}
+		if jwk.Crv != crvP256 {
+			return nil, fmt.Errorf("key %q: unsupported curve %q", jwk.Kid, jwk.Crv)
+		}
+		x, errX := unb64(jwk.X)
+		y, errY := unb64(jwk.Y)
+		if errX != nil || errY != nil || len(x) != sizeP256 || len(y) != sizeP256 {
+			return nil, fmt.Errorf("key %q: invalid EC point coordinates", jwk.Kid)
+		}
+		// validate the point (uncompressed form)
+		point := make([]byte, 0, 1+2*sizeP256)
+		point = append(point, 4)
+		point = append(point, x...)
+		point = append(point, y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("key %q: invalid EC point: %v", jwk.Kid, err)
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("key %q: unsupported key type %q", jwk.Kid, jwk.Kty)
+	}
+	return nil, fmt.Errorf("key %q (%s): cannot verify %s", jwk.Kid, jwk.Kty, alg)
+}
+
+func b64(b []byte) string            { return base64.RawURLEncoding.EncodeToString(b) }
+func unb64(s string) ([]byte, error) { return base64.RawURLEncoding.DecodeString(s) }
@@ -6,6 +6,9 @@
 package tok
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -20,14 +23,22 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
-type Token struct {
-	UserID      string          `json:"username"`
-	Expires     time.Time       `json:"expires"`
-	Token       string          `json:"token"`
-	ClusterACLs []*authn.CluACL `json:"clusters"`
-	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
-	IsAdmin     bool            `json:"admin"`
-}
+type (
+	Token struct {
+		UserID      string          `json:"username"`
+		Expires     time.Time       `json:"expires"`
+		Token       string          `json:"token"`
+		ClusterACLs []*authn.CluACL `json:"clusters"`
+		BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
+		PrefixACLs  []*authn.BckACL `json:"prefixes,omitempty"` // (claim only - see setBckACLs)
+		IsAdmin     bool            `json:"admin"`
+		IsAccessKey bool            `json:"access_key,omitempty"` // S3 access key ID (see AccessKeyJWT)
+		KeyID       string          `json:"key_id,omitempty"`     // service account's API key (see APIKeyJWT)
+	}
+
+	// selects objects (and virtual directories) the token grants access to (see ListFilter)
+	LsFilter func(name string, isDir bool) bool
+)
 
 var (
 	ErrNoPermissions = errors.New("insufficient permissions")
@@ -36,28 +47,97 @@ var (
 	ErrNoBearerToken = errors.New("invalid token: no bearer")
 	ErrTokenExpired  = errors.New("token expired")
 	ErrTokenRevoked  = errors.New("token revoked")
+	ErrAccessKey     = errors.New("invalid token: S3 access key ID cannot be used as a bearer token")
+	ErrAPIKey        = errors.New("invalid token: API key must be exchanged for a token to access AuthN")
 )
 
 // TODO: cos.Unsafe* and other micro-optimization and refactoring
 
-func AdminJWT(expires time.Time, userID, secret string) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+func AdminJWT(expires time.Time, userID string, signer Signer) (string, error) {
+	return signer.Sign(jwt.MapClaims{
 		"expires":  expires,
 		"username": userID,
 		"admin":    true,
 	})
-	return t.SignedString([]byte(secret))
 }
 
 func JWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
-	secret string) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	signer Signer) (string, error) {
+	claims := jwt.MapClaims{
 		"expires":  expires,
 		"username": userID,
-		"buckets":  bucketACLs,
 		"clusters": clusterACLs,
-	})
-	return t.SignedString([]byte(secret))
+	}
+	setBckACLs(claims, bucketACLs)
+	return signer.Sign(claims)
+}
+
+// Prefix-scoped bucket ACLs are carried in a separate claim: gateways that do not support
+// prefixes either ignore or reject the latter (and deny access) rather than take a prefix grant
+// for an entire-bucket one.
+// Upon parsing, both claims merge into Token.BucketACLs.
+func setBckACLs(claims jwt.MapClaims, bucketACLs []*authn.BckACL) {
+	var bcks, prefixes []*authn.BckACL
+	for _, acl := range bucketACLs {
+		if acl.Prefix == "" {
+			bcks = append(bcks, acl)
+		} else {
+			prefixes = append(prefixes, acl)
+		}
+	}
+	claims["buckets"] = bcks
+	if len(prefixes) > 0 {
+		claims["prefixes"] = prefixes
+	}
+}
+
+// S3 access key ID (SigV4) carries the same claims as a regular token, plus
+// the `access_key` marker. The corresponding secret access key is never stored:
+// AIS gateways derive it from the access key ID and the shared secret (see AccessKeySecret).
+// Hence, access keys are always HS256-signed, even when AuthN signs regular tokens with private keys.
+func AccessKeyJWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
+	isAdmin bool, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"expires":    expires,
+		"username":   userID,
+		"access_key": true,
+	}
+	if isAdmin {
+		claims["admin"] = true
+	} else {
+		setBckACLs(claims, bucketACLs)
+		claims["clusters"] = clusterACLs
+	}
+	return SecretKey(secret).Sign(claims)
+}
+
+// API key of a service account: a regular (long-lived) bearer token that also carries
+// the key ID - the latter is used by AuthN to track usage, exchange, and revoke the key.
+func APIKeyJWT(expires time.Time, accountID, keyID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
+	isAdmin bool, signer Signer) (string, error) {
+	claims := jwt.MapClaims{
+		"expires":  expires,
+		"username": accountID,
+		"key_id":   keyID,
+	}
+	if isAdmin {
+		claims["admin"] = true
+	} else {
+		setBckACLs(claims, bucketACLs)
+		claims["clusters"] = clusterACLs
+	}
+	return signer.Sign(claims)
+}
+
+func AccessKeySecret(accessKeyID, secret string) string {
+	const (
+		salt = "aws4-secret-access-key"
+		l    = 40 // same length as AWS secret access keys
+	)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(salt))
+	mac.Write([]byte(accessKeyID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))[:l]
 }
 
 // Header format: 'Authorization: Bearer <token>'
@@ -73,13 +153,35 @@ func ExtractToken(hdr http.Header) (string, error) {
 	return s[idx+1:], nil
 }
 
+// HS256 only
 func DecryptToken(tokenStr, secret string) (*Token, error) {
+	return ParseToken(tokenStr, secret, nil)
+}
+
+// Validates signature and returns the token's claims. Supported signing methods:
+// - HS256: shared secret (must not be empty when public keys are configured)
+// - RS256 and ES256: public key by its ID (when pubKey is not nil; see also JWKS)
+func ParseToken(tokenStr, secret string, pubKey PubKeyFunc) (*Token, error) {
 	jwtToken, err := jwt.Parse(tokenStr, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+		switch t.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if secret == "" && pubKey != nil {
+				return nil, fmt.Errorf("unexpected signing method %v: no secret", t.Header["alg"])
+			}
+			return []byte(secret), nil
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+			if pubKey == nil {
+				return nil, fmt.Errorf("unexpected signing method %v: no public keys", t.Header["alg"])
+			}
+			kid, _ := t.Header["kid"].(string)
+			if kid == "" {
+				return nil, fmt.Errorf("%v: missing key ID", ErrInvalidToken)
+			}
+			return pubKey(kid, t.Method.Alg())
+		default:
 			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
 		}
-		return []byte(secret), nil
-	})
+	}, jwt.WithValidMethods(validMethods))
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +193,13 @@ func DecryptToken(tokenStr, secret string) (*Token, error) {
 	if err := cos.MorphMarshal(claims, tk); err != nil {
 		return nil, ErrInvalidToken
 	}
+	for _, acl := range tk.BucketACLs {
+		if acl.Prefix != "" {
+			return nil, fmt.Errorf("%v: prefix-scoped %s in the bucket claim", ErrInvalidToken, acl)
+		}
+	}
+	tk.BucketACLs = append(tk.BucketACLs, tk.PrefixACLs...)
+	tk.PrefixACLs = nil
 	return tk, nil
 }
 
@@ -98,6 +207,14 @@ func DecryptToken(tokenStr, secret string) (*Token, error) {
 // Token //
 ///////////
 
+// (re)issue regular token with the same claims, e.g. for a validated S3 access key
+func (tk *Token) JWT(signer Signer) (string, error) {
+	if tk.IsAdmin {
+		return AdminJWT(tk.Expires, tk.UserID, signer)
+	}
+	return JWT(tk.Expires, tk.UserID, tk.BucketACLs, tk.ClusterACLs, signer)
+}
+
 func (tk *Token) String() string {
 	return fmt.Sprintf("user %s, %s", tk.UserID, expiresIn(tk.Expires))
 }
@@ -165,6 +282,77 @@ func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.Acce
 	return nil
 }
 
+// Prefix-scoped bucket ACLs (authn.BckACL.Prefix) apply to object-level operations:
+// - an object is governed by the bucket ACL with the longest matching prefix
+//   (the entire-bucket ACL, if present, has the shortest, empty, prefix)
+// - when no bucket ACL matches, cluster ACL applies (same as in CheckPermissions)
+// - bucket-level operations (e.g., HEAD bucket) are subject to the entire-bucket ACL only
+
+// CheckObjPermissions checks access to a given object.
+func (tk *Token) CheckObjPermissions(clusterID string, bck *cmn.Bck, objName string, perms apc.AccessAttrs) error {
+	objPerms, err := tk.checkCluPerms(clusterID, bck, perms)
+	if err != nil || objPerms == 0 {
+		return err
+	}
+	if acl, desc := tk.aclForObject(clusterID, bck, objName); !acl.Has(objPerms) {
+		return fmt.Errorf("user `%s` has %v: [%s, %s, granted(%s)]", tk.UserID, ErrNoPermissions, tk,
+			bck.Cname(objName), desc)
+	}
+	return nil
+}
+
+// CheckPrefixPermissions checks access to all objects under a given prefix
+// (multi-object operations; empty prefix means the entire bucket).
+func (tk *Token) CheckPrefixPermissions(clusterID string, bck *cmn.Bck, prefix string, perms apc.AccessAttrs) error {
+	objPerms, err := tk.checkCluPerms(clusterID, bck, perms)
+	if err != nil || objPerms == 0 {
+		return err
+	}
+	if acl, desc := tk.aclForObject(clusterID, bck, prefix); !acl.Has(objPerms) {
+		return fmt.Errorf("user `%s` has %v: [%s, prefix %s, granted(%s)]", tk.UserID, ErrNoPermissions, tk,
+			bck.Cname(prefix), desc)
+	}
+	// narrower ACLs under the prefix
+	for _, b := range tk.bckACLs(clusterID, bck) {
+		if len(b.Prefix) > len(prefix) && strings.HasPrefix(b.Prefix, prefix) && !b.Access.Has(objPerms) {
+			return fmt.Errorf("user `%s` has %v: [%s, prefix %s, granted(%s)]", tk.UserID, ErrNoPermissions, tk,
+				bck.Cname(b.Prefix), b.Access.Describe(false /*include all*/))
+		}
+	}
+	return nil
+}
+
+// CheckBckACL checks that the token grants (at least) the given bucket or prefix ACL, e.g. to issue
+// narrower credentials. Cluster-wide permissions do not apply at the bucket level and are ignored.
+func (tk *Token) CheckBckACL(acl *authn.BckACL) error {
+	perms := acl.Access &^ accessCluster
+	if perms == 0 {
+		return fmt.Errorf("%s: no bucket permissions", acl)
+	}
+	bck := cmn.Bck{Name: acl.Bck.Name, Provider: acl.Bck.Provider}
+	return tk.CheckPrefixPermissions(acl.Bck.Ns.UUID, &bck, acl.Prefix, perms)
+}
+
+// ListFilter checks permission to list objects under a given prefix.
+// Returns nil filter when all objects under the prefix are accessible, or
+// a filter that selects the accessible subset (objects and, for non-recursive listing, virtual directories).
+func (tk *Token) ListFilter(clusterID string, bck *cmn.Bck, prefix string, perms apc.AccessAttrs) (LsFilter, error) {
+	err := tk.CheckPrefixPermissions(clusterID, bck, prefix, perms)
+	if err == nil {
+		return nil, nil
+	}
+	objPerms := perms &^ accessCluster
+	if objPerms == 0 || bck == nil || !tk.anyUnder(clusterID, bck, prefix, objPerms) {
+		return nil, err
+	}
+	return func(name string, isDir bool) bool {
+		if acl, _ := tk.aclForObject(clusterID, bck, name); acl.Has(objPerms) {
+			return true
+		}
+		return isDir && tk.anyUnder(clusterID, bck, name, objPerms)
+	}, nil
+}
+
 //
 // private
 //
@@ -198,16 +386,77 @@ func (tk *Token) aclForCluster(clusterID string) (perms apc.AccessAttrs, ok bool
 
 func (tk *Token) aclForBucket(clusterID string, bck *cmn.Bck) (perms apc.AccessAttrs, ok bool) {
 	for _, b := range tk.BucketACLs {
-		tbBck := b.Bck
-		if tbBck.Ns.UUID != clusterID {
-			continue
-		}
-		// For AuthN all buckets are external: they have UUIDs of the respective AIS clusters.
-		// To correctly compare with the caller's `bck` we construct tokenBck from the token.
-		tokenBck := cmn.Bck{Name: tbBck.Name, Provider: tbBck.Provider}
-		if tokenBck.Equal(bck) {
+		if b.Prefix == "" && _sameBck(b, clusterID, bck) {
 			return b.Access, true
 		}
 	}
 	return 0, false
 }
+
+// whether any object under the prefix is accessible
+func (tk *Token) anyUnder(clusterID string, bck *cmn.Bck, prefix string, objPerms apc.AccessAttrs) bool {
+	if acl, _ := tk.aclForObject(clusterID, bck, prefix); acl.Has(objPerms) {
+		return true
+	}
+	for _, b := range tk.bckACLs(clusterID, bck) {
+		if strings.HasPrefix(b.Prefix, prefix) && b.Access.Has(objPerms) {
+			return true
+		}
+	}
+	return false
+}
+
+// bucket ACLs (entire bucket and prefix-scoped)
+func (tk *Token) bckACLs(clusterID string, bck *cmn.Bck) (acls []*authn.BckACL) {
+	for _, b := range tk.BucketACLs {
+		if _sameBck(b, clusterID, bck) {
+			acls = append(acls, b)
+		}
+	}
+	return acls
+}
+
+// the longest matching prefix, or cluster ACL when none matches
+func (tk *Token) aclForObject(clusterID string, bck *cmn.Bck, name string) (perms apc.AccessAttrs, desc string) {
+	var acl *authn.BckACL
+	for _, b := range tk.BucketACLs {
+		if b.Covers(name) && (acl == nil || len(b.Prefix) > len(acl.Prefix)) && _sameBck(b, clusterID, bck) {
+			acl = b
+		}
+	}
+	if acl != nil {
+		return acl.Access, acl.Access.Describe(false /*include all*/)
+	}
+	perms, _ = tk.aclForCluster(clusterID)
+	return perms, perms.Describe(false)
+}
+
+// For AuthN all buckets are external: they have UUIDs of the respective AIS clusters.
+// To correctly compare with the caller's `bck` we construct tokenBck from the token.
+func _sameBck(b *authn.BckACL, clusterID string, bck *cmn.Bck) bool {
+	if b.Bck.Ns.UUID != clusterID {
+		return false
+	}
+	tokenBck := cmn.Bck{Name: b.Bck.Name, Provider: b.Bck.Provider}
+	return tokenBck.Equal(bck)
+}
+
+// check cluster-wide part of the requested permissions, if any; return the rest
+func (tk *Token) checkCluPerms(clusterID string, bck *cmn.Bck, perms apc.AccessAttrs) (apc.AccessAttrs, error) {
+	if tk.IsAdmin {
+		return 0, nil
+	}
+	if perms == 0 {
+		return 0, errors.New("empty permissions requested")
+	}
+	if cluPerms := perms & accessCluster; cluPerms != 0 {
+		if err := tk.CheckPermissions(clusterID, nil, cluPerms); err != nil {
+			return 0, err
+		}
+	}
+	objPerms := perms &^ accessCluster
+	if objPerms != 0 && bck == nil {
+		return 0, errors.New("requested bucket permissions without a bucket")
+	}
+	return objPerms, nil
+}
@@ -9,6 +9,17 @@ package main
 // NOTE go:build debug (above) =====================================
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -19,6 +30,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/mock"
 	"github.com/NVIDIA/aistore/tools/tassert"
+
+	"github.com/golang-jwt/jwt/v4"
+	jsoniter "github.com/json-iterator/go"
 )
 
 var (
@@ -637,3 +651,446 @@ func TestMergeBckACLS(t *testing.T) {
 		}
 	}
 }
+
+func TestAccessKey(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+
+	secret := Conf.Secret()
+	key, _, err := mgr.addAccessKey(users[0], &authn.AccessKeyMsg{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, key.Secret == tok.AccessKeySecret(key.ID, secret), "unexpected secret access key")
+
+	tk, err := tok.DecryptToken(key.ID, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.IsAccessKey && tk.UserID == users[0], "unexpected access key claims: %+v", tk)
+	tassert.Errorf(t, len(tk.ClusterACLs) == 1 && tk.ClusterACLs[0].Access == apc.AccessRO, "expected guest permissions: %+v", tk)
+
+	keys, _, err := mgr.accessKeys(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 1 && keys[0].ID == key.ID && keys[0].Secret == "", "unexpected access keys: %+v", keys)
+	keys, _, err = mgr.accessKeys(users[1])
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected no access keys for %s, got %d", users[1], len(keys))
+
+	_, err = mgr.delAccessKey(users[1], key.ID)
+	tassert.Errorf(t, err != nil, "expected failure to delete other user's access key")
+	_, err = mgr.delAccessKey(users[0], key.ID)
+	tassert.CheckFatal(t, err)
+	_, _, err = mgr.db.GetString(revokedCollection, key.ID)
+	tassert.Errorf(t, err == nil, "expected access key to be revoked: %v", err)
+}
+
+func writeKey(t *testing.T, priv any) string {
+	b, err := x509.MarshalPKCS8PrivateKey(priv)
+	tassert.CheckFatal(t, err)
+	fpath := filepath.Join(t.TempDir(), "key.pem")
+	err = os.WriteFile(fpath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}), 0o600)
+	tassert.CheckFatal(t, err)
+	return fpath
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	tassert.CheckFatal(t, err)
+	var (
+		old  = authn.SigningKeyConf{ID: "old", Path: writeKey(t, ecKey)}
+		curr = authn.SigningKeyConf{ID: "new", Path: writeKey(t, rsaKey)}
+		conf = &authn.ServerConf{SigningKeys: []authn.SigningKeyConf{old}, ActiveKey: old.ID}
+	)
+	defer kring.load(&authn.ServerConf{})
+
+	issue := func() string {
+		token, _, err := mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+		tassert.CheckFatal(t, err)
+		return token
+	}
+	// verify the way AIS gateways do: via (published) JWKS
+	verify := func(token string) error {
+		kring.mu.RLock()
+		b := kring.jwks
+		kring.mu.RUnlock()
+		jwks := &authn.JWKS{}
+		tassert.CheckFatal(t, jsoniter.Unmarshal(b, jwks))
+		_, err := tok.ParseToken(token, "" /*no secret*/, func(kid, alg string) (crypto.PublicKey, error) {
+			if jwk := jwks.Find(kid); jwk != nil {
+				return tok.JWKPublicKey(jwk, alg)
+			}
+			return nil, tok.ErrInvalidToken
+		})
+		return err
+	}
+
+	tassert.CheckFatal(t, kring.load(conf))
+	tokOld := issue()
+	tassert.CheckFatal(t, verify(tokOld))
+	_, err = tok.DecryptToken(tokOld, Conf.Secret())
+	tassert.Errorf(t, err != nil, "ES256 token must not verify with the shared secret")
+
+	// 1. add the new key and make it active: both keys remain valid
+	conf.SigningKeys, conf.ActiveKey = []authn.SigningKeyConf{old, curr}, curr.ID
+	tassert.CheckFatal(t, kring.load(conf))
+	tokNew := issue()
+	tassert.CheckFatal(t, verify(tokNew))
+	tassert.CheckFatal(t, verify(tokOld))
+	tk, err := kring.parse(tokNew)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.UserID == users[0], "unexpected user %q", tk.UserID)
+
+	// 2. remove the old key
+	conf.SigningKeys = []authn.SigningKeyConf{curr}
+	tassert.CheckFatal(t, kring.load(conf))
+	tassert.CheckFatal(t, verify(tokNew))
+	tassert.Errorf(t, verify(tokOld) != nil, "token signed with a removed key must not verify")
+	_, err = kring.parse(tokOld)
+	tassert.Errorf(t, err != nil, "token signed with a removed key must not verify")
+
+	// active key must be one of the configured
+	conf.ActiveKey = old.ID
+	tassert.Errorf(t, kring.load(conf) != nil, "expected failure to activate a missing key")
+}
+
+func TestOIDCLogin(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.addRole(guestRole)
+	tassert.CheckFatal(t, err)
+
+	// mock identity provider: discovery document and public keys
+	idpKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	jwk, err := tok.PublicJWK("idp-key", idpKey.Public())
+	tassert.CheckFatal(t, err)
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(cos.MustMarshal(&oidcDiscovery{Issuer: srv.URL, JWKSURI: srv.URL + "/keys"}))
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(cos.MustMarshal(&authn.JWKS{Keys: []authn.JWK{jwk}}))
+	})
+
+	conf := &authn.OIDCConf{Issuers: []authn.OIDCIssuerConf{{
+		Name:       "idp",
+		Issuer:     srv.URL,
+		Audience:   "ais",
+		GroupRoles: map[string][]string{"readers": {GuestRole}},
+	}}}
+	tassert.CheckFatal(t, oidcs.init(conf))
+	defer oidcs.init(&authn.OIDCConf{})
+
+	idToken := func(iss, aud string, exp time.Duration, groups ...string) string {
+		t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"iss":    iss,
+			"aud":    aud,
+			"sub":    "alice",
+			"exp":    time.Now().Add(exp).Unix(),
+			"groups": groups,
+		})
+		t.Header["kid"] = jwk.Kid
+		s, err := t.SignedString(idpKey)
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}
+	exchange := func(idToken string) (*tok.Token, int, error) {
+		token, code, err := mgr.issueTokenOIDC(&authn.ExchangeMsg{IDToken: idToken})
+		if err != nil {
+			return nil, code, err
+		}
+		tk, err := kring.parse(token)
+		return tk, code, err
+	}
+
+	tk, _, err := exchange(idToken(srv.URL, "ais", time.Hour, "readers", "others"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.UserID == "idp:alice", "unexpected user %q", tk.UserID)
+	tassert.Errorf(t, len(tk.ClusterACLs) == 1, "expected cluster ACLs from the %q role", GuestRole)
+	tassert.Errorf(t, !tk.Expires.IsZero(), "federated token must expire")
+
+	// expiration: no later than the ID token and the configured default
+	for _, test := range []struct {
+		idExp, expiresIn, max time.Duration
+	}{
+		{time.Hour, 100 * time.Hour, time.Hour},
+		{time.Hour, time.Minute, time.Minute},
+		{time.Hour, 0, time.Hour},
+		{10 * Conf.Expire(), 10 * Conf.Expire(), Conf.Expire()},
+	} {
+		token, _, err := mgr.issueTokenOIDC(&authn.ExchangeMsg{
+			IDToken:   idToken(srv.URL, "ais", test.idExp, "readers"),
+			ExpiresIn: &test.expiresIn,
+		})
+		tassert.CheckFatal(t, err)
+		tk, err := kring.parse(token)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, time.Until(tk.Expires) <= test.max, "%+v: token expires in %v", test, time.Until(tk.Expires))
+	}
+
+	_, code, err := exchange(idToken(srv.URL, "other", time.Hour, "readers"))
+	tassert.Errorf(t, err != nil && code == http.StatusUnauthorized, "expected audience mismatch, got %v(%d)", err, code)
+	_, code, err = exchange(idToken(srv.URL, "ais", -time.Minute, "readers"))
+	tassert.Errorf(t, err != nil && code == http.StatusUnauthorized, "expected expired token, got %v(%d)", err, code)
+	_, code, err = exchange(idToken("https://unknown.example.com", "ais", time.Hour, "readers"))
+	tassert.Errorf(t, err != nil && code == http.StatusUnauthorized, "expected unknown issuer, got %v(%d)", err, code)
+	_, code, err = exchange(idToken(srv.URL, "ais", time.Hour, "others"))
+	tassert.Errorf(t, err != nil && code == http.StatusForbidden, "expected no mapped roles, got %v(%d)", err, code)
+
+	// a token signed by someone else
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	forged := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": srv.URL, "aud": "ais", "sub": "mallory", "exp": time.Now().Add(time.Hour).Unix(), "groups": []string{"readers"},
+	})
+	forged.Header["kid"] = jwk.Kid
+	s, err := forged.SignedString(otherKey)
+	tassert.CheckFatal(t, err)
+	_, code, err = exchange(s)
+	tassert.Errorf(t, err != nil && code == http.StatusUnauthorized, "expected invalid signature, got %v(%d)", err, code)
+}
+
+func TestPrefixACLs(t *testing.T) {
+	const cluID = "test-clu-id"
+	var (
+		bck = cmn.Bck{Name: "datasets", Provider: apc.AIS}
+		tk  = &tok.Token{
+			UserID:      "user-a",
+			ClusterACLs: []*authn.CluACL{{ID: cluID, Access: apc.AccessRO}},
+			BucketACLs: []*authn.BckACL{
+				{Bck: newBck(bck.Name, apc.AIS, cluID), Prefix: "team-a/", Access: apc.AccessRW},
+				{Bck: newBck(bck.Name, apc.AIS, cluID), Prefix: "team-a/private/", Access: apc.AccessNone},
+			},
+		}
+	)
+	tests := []struct {
+		name  string
+		perms apc.AccessAttrs
+		ok    bool
+	}{
+		{"team-a/obj", apc.AcePUT, true},
+		{"team-a/sub/obj", apc.AceObjDELETE, true},
+		{"team-b/obj", apc.AcePUT, false},
+		{"team-b/obj", apc.AceGET, true}, // cluster ACL
+		{"team-a/private/obj", apc.AceGET, false},
+		{"team-a", apc.AcePUT, false},
+	}
+	for _, test := range tests {
+		err := tk.CheckObjPermissions(cluID, &bck, test.name, test.perms)
+		tassert.Errorf(t, (err == nil) == test.ok, "%s (%s): expected ok=%t, got %v", test.name,
+			test.perms.Describe(false), test.ok, err)
+	}
+
+	// entire prefix (multi-object operations)
+	tassert.CheckError(t, tk.CheckPrefixPermissions(cluID, &bck, "team-a/sub/", apc.AcePUT))
+	tassert.Errorf(t, tk.CheckPrefixPermissions(cluID, &bck, "team-a/", apc.AcePUT) != nil, "expected team-a/private/ to deny")
+	tassert.Errorf(t, tk.CheckPrefixPermissions(cluID, &bck, "", apc.AcePUT) != nil, "expected entire bucket to deny")
+	tassert.Errorf(t, tk.CheckPermissions(cluID, &bck, apc.AcePUT) != nil, "expected bucket-level check to ignore prefix ACLs")
+
+	// list-objects
+	flt, err := tk.ListFilter(cluID, &bck, "team-a/sub/", apc.AceObjLIST)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, flt == nil, "expected no filtering")
+	flt, err = tk.ListFilter(cluID, &bck, "team-a/", apc.AceObjLIST)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, flt != nil, "expected filter")
+	tassert.Errorf(t, flt("team-a/obj", false), "expected team-a/obj")
+	tassert.Errorf(t, !flt("team-a/private/obj", false), "unexpected team-a/private/obj")
+	tassert.Errorf(t, !flt("team-a/private/", true), "unexpected team-a/private/")
+
+	// no cluster-wide access: the bucket is accessible only under team-a/
+	tk.ClusterACLs = nil
+	flt, err = tk.ListFilter(cluID, &bck, "", apc.AceObjLIST)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, flt != nil, "expected filter")
+	tassert.Errorf(t, flt("team-a/", true) && flt("team-a/obj", false), "expected team-a/")
+	tassert.Errorf(t, !flt("team-b/", true) && !flt("team-b/obj", false), "unexpected team-b/")
+	_, err = tk.ListFilter(cluID, &bck, "team-b/", apc.AceObjLIST)
+	tassert.Errorf(t, err != nil, "expected team-b/ to deny")
+}
+
+// prefix-scoped ACLs must not be visible to gateways that only know the "buckets" claim
+func TestPrefixACLsClaim(t *testing.T) {
+	const (
+		cluID  = "test-clu-id"
+		secret = "test-secret"
+	)
+	var (
+		bck  = cmn.Bck{Name: "datasets", Provider: apc.AIS}
+		acls = []*authn.BckACL{
+			{Bck: newBck("models", apc.AIS, cluID), Access: apc.AccessRO},
+			{Bck: newBck(bck.Name, apc.AIS, cluID), Prefix: "team-a/", Access: apc.AccessRW},
+		}
+	)
+	token, err := tok.JWT(time.Now().Add(time.Hour), "user-a", acls, nil, tok.SecretKey(secret))
+	tassert.CheckFatal(t, err)
+
+	// legacy view
+	legacy := struct {
+		BucketACLs []*authn.BckACL `json:"buckets"`
+	}{}
+	jwtToken, err := jwt.Parse(token, func(*jwt.Token) (any, error) { return []byte(secret), nil })
+	tassert.CheckFatal(t, err)
+	b, err := jsoniter.Marshal(jwtToken.Claims)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, jsoniter.Unmarshal(b, &legacy))
+	tassert.Fatalf(t, len(legacy.BucketACLs) == 1, "expected a single entire-bucket ACL, got %v", legacy.BucketACLs)
+	tassert.Errorf(t, legacy.BucketACLs[0].Bck.Name == "models", "unexpected %s", legacy.BucketACLs[0])
+
+	tk, err := tok.DecryptToken(token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(tk.BucketACLs) == 2 && len(tk.PrefixACLs) == 0, "expected merged ACLs, got %v", tk.BucketACLs)
+	tassert.CheckError(t, tk.CheckObjPermissions(cluID, &bck, "team-a/obj", apc.AcePUT))
+	tassert.Errorf(t, tk.CheckObjPermissions(cluID, &bck, "team-b/obj", apc.AceGET) != nil, "expected team-b/ to deny")
+
+	// prefix in the bucket claim is rejected
+	forged, err := tok.SecretKey(secret).Sign(jwt.MapClaims{
+		"expires":  time.Now().Add(time.Hour),
+		"username": "user-a",
+		"buckets":  acls,
+	})
+	tassert.CheckFatal(t, err)
+	_, err = tok.DecryptToken(forged, secret)
+	tassert.Errorf(t, err != nil, "expected prefix-scoped ACL in the bucket claim to fail")
+}
+
+func TestServiceAccountAPIKeys(t *testing.T) {
+	const (
+		cluID     = "test-clu-id"
+		accountID = "ci-bot"
+	)
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	writer := &authn.Role{
+		Name: "DatasetsWriter",
+		BucketACLs: []*authn.BckACL{
+			{Bck: newBck("datasets", apc.AIS, cluID), Access: apc.AccessRW},
+		},
+	}
+	_, err = mgr.addRole(guestRole)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.addRole(writer)
+	tassert.CheckFatal(t, err)
+
+	// account: unique ID, existing roles
+	_, err = mgr.addAccount(&authn.ServiceAccount{ID: adminUserID, Roles: []*authn.Role{{Name: GuestRole}}})
+	tassert.Errorf(t, err != nil, "expected conflict with existing user %q", adminUserID)
+	_, err = mgr.addAccount(&authn.ServiceAccount{ID: accountID, Roles: []*authn.Role{{Name: "no-such-role"}}})
+	tassert.Errorf(t, err != nil, "expected failure to add service account with non-existing role")
+	_, err = mgr.addAccount(&authn.ServiceAccount{ID: accountID, Roles: []*authn.Role{{Name: GuestRole}, {Name: writer.Name}}})
+	tassert.CheckFatal(t, err)
+	_, err = mgr.addUser(&authn.User{ID: accountID, Password: "pass"})
+	tassert.Errorf(t, err != nil, "expected conflict with existing service account %q", accountID)
+
+	// key with all account's roles (never expires)
+	all, _, err := mgr.addAPIKey(accountID, &authn.APIKeyMsg{Name: "all"})
+	tassert.CheckFatal(t, err)
+	tk, err := kring.parse(all.Token)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.KeyID == all.ID && tk.UserID == accountID, "unexpected API key claims: %+v", tk)
+	tassert.Errorf(t, len(tk.ClusterACLs) == 1 && len(tk.BucketACLs) == 1, "expected guest and writer ACLs: %+v", tk)
+	tassert.Errorf(t, time.Until(all.Expires) > 365*24*time.Hour, "expected API key to never expire, got %v", all.Expires)
+	_, _, err = mgr.addAPIKey(accountID, &authn.APIKeyMsg{Name: "all"})
+	tassert.Errorf(t, err != nil, "expected duplicate API key name to fail")
+
+	// scope: roles and bucket ACLs
+	_, _, err = mgr.addAPIKey(accountID, &authn.APIKeyMsg{Name: "admin", Roles: []string{authn.AdminRole}})
+	tassert.Errorf(t, err != nil, "expected failure to select role that the account does not have")
+	_, _, err = mgr.addAPIKey(accountID, &authn.APIKeyMsg{
+		Name:       "models",
+		BucketACLs: []*authn.BckACL{{Bck: newBck("models", apc.AIS, cluID), Access: apc.AccessRW}},
+	})
+	tassert.Errorf(t, err != nil, "expected failure to exceed account's permissions")
+	expiresIn := time.Minute
+	scoped, _, err := mgr.addAPIKey(accountID, &authn.APIKeyMsg{
+		Name:       "team-a",
+		Roles:      []string{writer.Name},
+		BucketACLs: []*authn.BckACL{{Bck: newBck("datasets", apc.AIS, cluID), Prefix: "team-a/", Access: apc.AccessRW}},
+		ExpiresIn:  &expiresIn,
+	})
+	tassert.CheckFatal(t, err)
+	tk, err = kring.parse(scoped.Token)
+	tassert.CheckFatal(t, err)
+	bck := cmn.Bck{Name: "datasets", Provider: apc.AIS}
+	tassert.CheckError(t, tk.CheckObjPermissions(cluID, &bck, "team-a/obj", apc.AcePUT))
+	tassert.Errorf(t, tk.CheckObjPermissions(cluID, &bck, "team-b/obj", apc.AceGET) != nil, "expected team-b/ to deny")
+
+	keys, _, err := mgr.apiKeys(accountID)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 2, "expected 2 API keys, got %d", len(keys))
+	for _, key := range keys {
+		tassert.Errorf(t, key.Token == "", "API key %q: not expecting the key itself to be listed", key.Name)
+	}
+
+	// exchange: short-lived token that does not outlive the key; last-used time
+	token, _, err := mgr.issueTokenAPIKey(&authn.ExchangeMsg{APIKey: scoped.Token})
+	tassert.CheckFatal(t, err)
+	tk, err = kring.parse(token)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.KeyID == "" && tk.UserID == accountID, "unexpected exchanged token claims: %+v", tk)
+	tassert.Errorf(t, !tk.Expires.After(scoped.Expires), "exchanged token outlives API key: %v vs %v", tk.Expires, scoped.Expires)
+	tassert.CheckError(t, tk.CheckObjPermissions(cluID, &bck, "team-a/obj", apc.AcePUT))
+
+	key := &authn.APIKey{}
+	_, err = mgr.db.Get(apiKeysCollection, scoped.ID, key)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !key.LastUsed.IsZero(), "expected last-used time to be updated")
+	tassert.Errorf(t, key.Token == "" && key.Hash == apiKeyHash(scoped.Token), "expected only the key's hash to be stored")
+	tassert.Errorf(t, scoped.Hash == "", "not expecting the hash to be returned")
+
+	// same key ID, different key
+	forged, err := tok.APIKeyJWT(scoped.Expires, accountID, scoped.ID, nil, nil, true, kring.signer())
+	tassert.CheckFatal(t, err)
+	_, _, err = mgr.issueTokenAPIKey(&authn.ExchangeMsg{APIKey: forged})
+	tassert.Errorf(t, err != nil, "expected failure to exchange API key that does not match the stored hash")
+
+	_, _, err = mgr.issueTokenAPIKey(&authn.ExchangeMsg{APIKey: token})
+	tassert.Errorf(t, err != nil, "expected failure to exchange regular token")
+
+	// delete (and revoke) one key, then the account with the rest
+	_, err = mgr.delAPIKey("other", scoped.ID)
+	tassert.Errorf(t, err != nil, "expected failure to delete other account's API key")
+	_, err = mgr.delAPIKey(accountID, scoped.ID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, revokedKeyIDs(t, mgr)[scoped.ID], "expected API key to be revoked")
+	_, _, err = mgr.issueTokenAPIKey(&authn.ExchangeMsg{APIKey: scoped.Token})
+	tassert.Errorf(t, err != nil, "expected failure to exchange deleted API key")
+
+	_, err = mgr.delAccount(accountID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, revokedKeyIDs(t, mgr)[all.ID], "expected API key to be revoked along with the account")
+	keys, _, err = mgr.apiKeys(accountID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected no API keys, got %d", len(keys))
+}
+
+func revokedKeyIDs(t *testing.T, mgr *mgr) map[string]bool {
+	recs, _, err := mgr.db.GetAll(revokedCollection, "")
+	tassert.CheckFatal(t, err)
+	ids := make(map[string]bool, len(recs))
+	for token := range recs {
+		tk, err := kring.parse(token)
+		tassert.CheckFatal(t, err)
+		if tk.KeyID != "" {
+			tassert.Errorf(t, !tk.IsAdmin && len(tk.ClusterACLs) == 0 && len(tk.BucketACLs) == 0,
+				"revoked API key %s: not expecting permissions", tk.KeyID)
+			ids[tk.KeyID] = true
+		}
+	}
+	return ids
+}
@@ -6,13 +6,15 @@ package main
 
 import (
 	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
 type bckACLList []*authn.BckACL
 
 func (bckList bckACLList) updated(bckACL *authn.BckACL) bool {
 	for _, acl := range bckList {
-		if acl.Bck.Equal(&bckACL.Bck) {
+		if acl.Equal(bckACL) {
 			acl.Access = bckACL.Access
 			return true
 		}
@@ -20,6 +22,17 @@ func (bckList bckACLList) updated(bckACL *authn.BckACL) bool {
 	return false
 }
 
+// prefix-scoped bucket ACLs (see authn.BckACL.Prefix)
+func validateBckACLs(acls bckACLList) error {
+	for _, acl := range acls {
+		acl.Prefix = cos.TrimPrefix(acl.Prefix)
+		if err := cmn.ValidatePrefix("invalid bucket ACL", acl.Prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type cluACLList []*authn.CluACL
 
 func (cluList cluACLList) updated(cluACL *authn.CluACL) bool {
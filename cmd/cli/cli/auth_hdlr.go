@@ -39,13 +39,15 @@ const (
 	flagsAuthRevokeToken = "revoke_token"
 	flagsAuthRoleShow    = "role_show"
 	flagsAuthConfShow    = "conf_show"
+	flagsAuthKeyAdd      = "key_add"
+	flagsAuthAPIKeyAdd   = "apikey_add"
 )
 
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
 
 var (
 	authFlags = map[string][]cli.Flag{
-		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag},
+		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag, apiKeyFlag},
 		flagsAuthUserLogout:  {tokenFileFlag},
 		cmdAuthUser:          {passwordFlag},
 		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag},
@@ -53,6 +55,9 @@ var (
 		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
 		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
 		flagsAuthConfShow:    {jsonFlag},
+		flagsAuthKeyAdd:      {expireFlag},
+		cmdAuthAccount:       {descAccountFlag},
+		flagsAuthAPIKeyAdd:   {apiKeyExpireFlag, apiKeyRolesFlag, apiKeyBucketFlag, clusterRoleFlag},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -81,6 +86,27 @@ var (
 				ArgsUsage: showAuthUserListArgument,
 				Action:    wrapAuthN(showAuthUserHandler),
 			},
+			{
+				Name:         cmdAuthKey,
+				Usage:        "Show S3 access keys of a given user",
+				ArgsUsage:    userLoginArgument,
+				Action:       wrapAuthN(showAuthKeyHandler),
+				BashComplete: oneUserCompletions,
+			},
+			{
+				Name:         cmdAuthAccount,
+				Usage:        "Show service accounts",
+				ArgsUsage:    showAuthAccountArgument,
+				Action:       wrapAuthN(showAuthAccountHandler),
+				BashComplete: oneAccountCompletions,
+			},
+			{
+				Name:         cmdAuthAPIKey,
+				Usage:        "Show API keys of a given service account (the keys themselves are never shown)",
+				ArgsUsage:    showAuthAPIKeyArgument,
+				Action:       wrapAuthN(showAuthAPIKeyHandler),
+				BashComplete: oneAccountCompletions,
+			},
 			{
 				Name:   cmdAuthConfig,
 				Usage:  "Show AuthN server configuration",
@@ -123,6 +149,32 @@ var (
 						Action:       wrapAuthN(addAuthRoleHandler),
 						BashComplete: addRoleCompletions,
 					},
+					{
+						Name: cmdAuthKey,
+						Usage: "Issue S3 access key for an existing user (to sign S3 requests with native AWS Signature V4);\n" +
+							indent4 + "\tnote that the secret access key is shown only once and cannot be retrieved later",
+						ArgsUsage:    userLoginArgument,
+						Flags:        sortFlags(authFlags[flagsAuthKeyAdd]),
+						Action:       wrapAuthN(addAuthKeyHandler),
+						BashComplete: oneUserCompletions,
+					},
+					{
+						Name:         cmdAuthAccount,
+						Usage:        "Add a new service account (non-interactive identity that authenticates with API keys)",
+						ArgsUsage:    addAuthAccountArgument,
+						Flags:        sortFlags(authFlags[cmdAuthAccount]),
+						Action:       wrapAuthN(addAuthAccountHandler),
+						BashComplete: oneRoleCompletions,
+					},
+					{
+						Name: cmdAuthAPIKey,
+						Usage: "Issue API key for an existing service account, optionally scoped to a subset of its roles and permissions;\n" +
+							indent4 + "\tnote that the key is shown only once and cannot be retrieved later",
+						ArgsUsage:    addAuthAPIKeyArgument,
+						Flags:        sortFlags(authFlags[flagsAuthAPIKeyAdd]),
+						Action:       wrapAuthN(addAuthAPIKeyHandler),
+						BashComplete: oneAccountCompletions,
+					},
 				},
 			},
 			// rm
@@ -158,6 +210,27 @@ var (
 						ArgsUsage: deleteAuthTokenArgument,
 						Action:    wrapAuthN(revokeTokenHandler),
 					},
+					{
+						Name:         cmdAuthKey,
+						Usage:        "Remove (and revoke) S3 access key",
+						ArgsUsage:    deleteAuthKeyArgument,
+						Action:       wrapAuthN(deleteAuthKeyHandler),
+						BashComplete: oneUserCompletions,
+					},
+					{
+						Name:         cmdAuthAccount,
+						Usage:        "Remove service account and revoke all its API keys",
+						ArgsUsage:    deleteAuthAccountArgument,
+						Action:       wrapAuthN(deleteAuthAccountHandler),
+						BashComplete: oneAccountCompletions,
+					},
+					{
+						Name:         cmdAuthAPIKey,
+						Usage:        "Remove (and revoke) API key",
+						ArgsUsage:    deleteAuthAPIKeyArgument,
+						Action:       wrapAuthN(deleteAuthAPIKeyHandler),
+						BashComplete: oneAccountCompletions,
+					},
 				},
 			},
 			// set
@@ -194,12 +267,21 @@ var (
 						Action:       wrapAuthN(updateAuthRoleHandler),
 						BashComplete: setRoleCompletions,
 					},
+					{
+						Name: cmdAuthAccount,
+						Usage: "Update service account's description and/or roles;\n" +
+							indent4 + "\tnote that API keys referring to removed roles are deleted (and revoked)",
+						ArgsUsage:    setAuthAccountArgument,
+						Flags:        sortFlags(authFlags[cmdAuthAccount]),
+						Action:       wrapAuthN(updateAuthAccountHandler),
+						BashComplete: oneAccountCompletions,
+					},
 				},
 			},
 			// login, logout
 			{
 				Name:      cmdAuthLogin,
-				Usage:     "Log in with existing user ID and password",
+				Usage:     "Log in with existing user ID and password (or with service account's API key)",
 				Flags:     sortFlags(authFlags[flagsAuthUserLogin]),
 				ArgsUsage: userLoginArgument,
 				Action:    wrapAuthN(loginUserHandler),
@@ -344,6 +426,200 @@ func deleteUserHandler(c *cli.Context) (err error) {
 	return authn.DeleteUser(authParams, userName)
 }
 
+func addAuthKeyHandler(c *cli.Context) error {
+	var (
+		expireIn *time.Duration
+		userID   = c.Args().Get(0)
+	)
+	if userID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if flagIsSet(c, expireFlag) {
+		expireIn = apc.Ptr(parseDurationFlag(c, expireFlag))
+	}
+	key, err := authn.AddAccessKey(authParams, userID, expireIn)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "Access key ID:\t\t%s\nSecret access key:\t%s\n", key.ID, key.Secret)
+	fmt.Fprintln(c.App.Writer)
+	actionNote(c, "the secret access key won't be shown again - make sure to save it, e.g.:\n"+
+		"  aws configure set aws_access_key_id <ACCESS_KEY_ID> && aws configure set aws_secret_access_key <SECRET>")
+	return nil
+}
+
+func showAuthKeyHandler(c *cli.Context) error {
+	userID := c.Args().Get(0)
+	if userID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	keys, err := authn.GetAccessKeys(authParams, userID)
+	if err != nil {
+		return err
+	}
+	return teb.Print(keys, teb.AuthNAccessKeyTmpl)
+}
+
+func deleteAuthKeyHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteAccessKey(authParams, c.Args().Get(0), c.Args().Get(1))
+}
+
+func addAuthAccountHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.AddServiceAccount(authParams, accountFromArgs(c))
+}
+
+func updateAuthAccountHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	sa := accountFromArgs(c)
+	if sa.Description == "" && len(sa.Roles) == 0 {
+		return fmt.Errorf("nothing to update: specify roles and/or %s", qflprn(descAccountFlag))
+	}
+	return authn.UpdateServiceAccount(authParams, sa)
+}
+
+// roles are referenced by name (AuthN stores their current definitions)
+func accountFromArgs(c *cli.Context) *authn.ServiceAccount {
+	sa := &authn.ServiceAccount{
+		ID:          c.Args().Get(0),
+		Description: parseStrFlag(c, descAccountFlag),
+	}
+	for _, name := range c.Args().Tail() {
+		sa.Roles = append(sa.Roles, &authn.Role{Name: strings.TrimSpace(name)})
+	}
+	return sa
+}
+
+func showAuthAccountHandler(c *cli.Context) error {
+	accountID := c.Args().Get(0)
+	if accountID == "" {
+		list, err := authn.GetServiceAccounts(authParams)
+		if err != nil {
+			return err
+		}
+		return teb.Print(list, teb.AuthNAccountTmpl)
+	}
+	sa, err := authn.GetServiceAccount(authParams, accountID)
+	if err != nil {
+		return err
+	}
+	return teb.Print([]*authn.ServiceAccount{sa}, teb.AuthNAccountTmpl)
+}
+
+func deleteAuthAccountHandler(c *cli.Context) error {
+	accountID := c.Args().Get(0)
+	if accountID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteServiceAccount(authParams, accountID)
+}
+
+func addAuthAPIKeyHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	var (
+		args      = c.Args()
+		accountID = args.Get(0)
+		msg       = &authn.APIKeyMsg{Name: args.Get(1)}
+	)
+	if flagIsSet(c, apiKeyRolesFlag) {
+		msg.Roles = splitCsv(parseStrFlag(c, apiKeyRolesFlag))
+	}
+	if flagIsSet(c, apiKeyExpireFlag) {
+		msg.ExpiresIn = apc.Ptr(parseDurationFlag(c, apiKeyExpireFlag))
+	}
+	if flagIsSet(c, apiKeyBucketFlag) {
+		acl, err := apiKeyBckACL(c)
+		if err != nil {
+			return err
+		}
+		msg.BucketACLs = []*authn.BckACL{acl}
+	} else if c.NArg() > 2 {
+		return fmt.Errorf("permissions %v require %s", args[2:], qflprn(apiKeyBucketFlag))
+	}
+	key, err := authn.AddAPIKey(authParams, accountID, msg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "API key ID:\t%s\nAPI key:\t%s\n", key.ID, key.Token)
+	fmt.Fprintln(c.App.Writer)
+	actionNote(c, "the API key won't be shown again - make sure to save it; to use it, either:\n"+
+		"  export "+env.AisAuthToken+"=<API_KEY>, or\n"+
+		"  ais auth login --"+apiKeyFlag.Name+" <API_KEY> (to exchange the key for a short-lived token)")
+	return nil
+}
+
+// bucket (or prefix) and permissions the API key is restricted to
+func apiKeyBckACL(c *cli.Context) (*authn.BckACL, error) {
+	cluster := parseStrFlag(c, clusterRoleFlag)
+	if cluster == "" {
+		return nil, fmt.Errorf("flag %s requires %s to be specified", qflprn(apiKeyBucketFlag), qflprn(clusterRoleFlag))
+	}
+	cluID, err := lookupClusterID(cluster)
+	if err != nil {
+		return nil, err
+	}
+	bck, prefix, err := parseBckObjURI(c, parseStrFlag(c, apiKeyBucketFlag), true /*emptyObjnameOK*/)
+	if err != nil {
+		return nil, err
+	}
+	bck.Ns.UUID = cluID
+	perms := apc.AccessNone
+	for _, arg := range c.Args()[2:] {
+		p, err := apc.StrToAccess(arg)
+		if err != nil {
+			return nil, err
+		}
+		perms |= p
+	}
+	if perms == apc.AccessNone {
+		return nil, missingArgumentsError(c, "PERMISSION")
+	}
+	return &authn.BckACL{Bck: bck, Prefix: prefix, Access: perms}, nil
+}
+
+func showAuthAPIKeyHandler(c *cli.Context) error {
+	accountID := c.Args().Get(0)
+	if accountID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	keys, err := authn.GetAPIKeys(authParams, accountID)
+	if err != nil {
+		return err
+	}
+	return teb.Print(keys, teb.AuthNAPIKeyTmpl)
+}
+
+// (by key ID or name)
+func deleteAuthAPIKeyHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	var (
+		accountID = c.Args().Get(0)
+		keyID     = c.Args().Get(1)
+	)
+	keys, err := authn.GetAPIKeys(authParams, accountID)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Name == keyID {
+			keyID = key.ID
+			break
+		}
+	}
+	return authn.DeleteAPIKey(authParams, accountID, keyID)
+}
+
 func deleteRoleHandler(c *cli.Context) (err error) {
 	role := c.Args().Get(0)
 	if role == "" {
@@ -354,9 +630,8 @@ func deleteRoleHandler(c *cli.Context) (err error) {
 
 func loginUserHandler(c *cli.Context) (err error) {
 	var (
+		token    *authn.TokenMsg
 		expireIn *time.Duration
-		name     = cliAuthnUserName(c)
-		password = cliAuthnUserPassword(c, false)
 		cluID    = parseStrFlag(c, clusterTokenFlag)
 	)
 	if flagIsSet(c, expireFlag) {
@@ -367,7 +642,15 @@ func loginUserHandler(c *cli.Context) (err error) {
 			return err
 		}
 	}
-	token, err := authn.LoginUser(authParams, name, password, expireIn)
+	if flagIsSet(c, apiKeyFlag) {
+		token, err = authn.LoginAPIKey(authParams, parseStrFlag(c, apiKeyFlag), expireIn)
+	} else {
+		var (
+			name     = cliAuthnUserName(c)
+			password = cliAuthnUserPassword(c, false)
+		)
+		token, err = authn.LoginUser(authParams, name, password, expireIn)
+	}
 	if err != nil {
 		return err
 	}
@@ -629,7 +912,8 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 		Description: parseStrFlag(c, descRoleFlag),
 	}
 	if bucket != "" {
-		bck, err := parseBckURI(c, bucket, false)
+		// optional prefix, e.g. ais://datasets/team-a/
+		bck, prefix, err := parseBckObjURI(c, bucket, true /*emptyObjnameOK*/)
 		if err != nil {
 			return nil, err
 		}
@@ -637,6 +921,7 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 		roleACL.BucketACLs = []*authn.BckACL{
 			{
 				Bck:    bck,
+				Prefix: prefix,
 				Access: perms,
 			},
 		}
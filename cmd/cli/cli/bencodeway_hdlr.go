@@ -138,9 +138,10 @@ func ecEncodeHandler(c *cli.Context) error {
 	checkAndRecover := flagIsSet(c, checkAndRecoverFlag)
 	if bprops.EC.Enabled {
 		if bprops.EC.DataSlices != numd || bprops.EC.ParitySlices != nump {
-			// not supported yet:
-			err := fmt.Errorf("%s is already (D=%d, P=%d) erasure-coded - cannot change this existing configuration to (D=%d, P=%d)",
-				bck.Cname(""), bprops.EC.DataSlices, bprops.EC.ParitySlices, numd, nump)
+			err := fmt.Errorf("%s is already (D=%d, P=%d) erasure-coded - to change this existing configuration to (D=%d, P=%d), run:\n"+
+				"\tais bucket props set %s ec.data_slices=%d ec.parity_slices=%d\n"+
+				"(existing objects will then be re-encoded online)",
+				bck.Cname(""), bprops.EC.DataSlices, bprops.EC.ParitySlices, numd, nump, bck.Cname(""), numd, nump)
 			return err
 		}
 		if !checkAndRecover {
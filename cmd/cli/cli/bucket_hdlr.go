@@ -30,6 +30,11 @@ Usage examples:
 - ais bucket props BUCKET checksum		# to show
 - ais bucket props set BUCKET backend_bck=s3://abc
 - ais bucket props set BUCKET backend_bck=none	# to reset
+- ais bucket props set BUCKET '{"lifecycle": {"enabled": true, "rules": [{"id": "tmp", "prefix": "tmp/", "age": "72h"}]}}'
+- ais bucket props set BUCKET lifecycle.enabled=false
+- ais bucket props set BUCKET '{"cors": {"rules": [{"allowed_origins": ["https://*.example.com"], "allowed_methods": ["GET", "HEAD"], "max_age_seconds": 600}]}}'
+- ais bucket props set BUCKET replication.dst=ais://@remais/dst replication.enabled=true
+- ais bucket props set BUCKET '{"events": {"enabled": true, "rules": [{"id": "new", "events": ["created"], "prefix": "img/", "webhook": "https://host/hook"}]}}'
   (see docs/cli for details)
 `
 
@@ -120,6 +125,16 @@ var (
 		regexLsAnyFlag,
 		templateFlag,
 		listObjPrefixFlag,
+		lsGlobFlag,
+		lsMinSizeFlag,
+		lsMaxSizeFlag,
+		lsModifiedAfterFlag,
+		lsModifiedBeforeFlag,
+		lsAccessedAfterFlag,
+		lsAccessedBeforeFlag,
+		lsCustomMDFlag,
+		lsHasCksumFlag,
+		lsIndexedFlag,
 		pageSizeFlag,
 		pagedFlag,
 		objLimitFlag,
@@ -228,6 +228,14 @@ var (
 				Action:       clearLcacheHandler,
 				BashComplete: suggestTargets,
 			},
+			{
+				Name: cmdSetDomain,
+				Usage: "Label target with failure domain (zone, rack, etc.) - erasure coded slices and replicas\n" +
+					indent1 + "are placed in distinct domains; omit DOMAIN to remove the label",
+				ArgsUsage:    nodeIDArgument + " [DOMAIN]",
+				Action:       setFdomainHandler,
+				BashComplete: suggestTargets,
+			},
 			{
 				Name:         cmdReloadCreds,
 				Usage:        "Reload (updated) backend credentials",
@@ -705,6 +713,29 @@ func clearLcacheHandler(c *cli.Context) error {
 	return nil
 }
 
+func setFdomainHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	node, sname, err := getNode(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	if !node.IsTarget() {
+		return fmt.Errorf("%s is not a target (failure domains apply to storage targets only)", sname)
+	}
+	domain := c.Args().Get(1)
+	if err := api.SetFdomain(apiBP, node.ID(), domain); err != nil {
+		return V(err)
+	}
+	if domain == "" {
+		actionDone(c, sname+": removed failure domain label")
+	} else {
+		actionDone(c, fmt.Sprintf("%s: failure domain %q", sname, domain))
+	}
+	return nil
+}
+
 func reloadCredsHandler(c *cli.Context) error {
 	p := c.Args().Get(0)
 	if p == scopeAll {
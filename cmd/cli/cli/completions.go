@@ -805,6 +805,19 @@ func oneUserCompletionsWithRoles(c *cli.Context) {
 	}
 }
 
+func oneAccountCompletions(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	accounts, err := authn.GetServiceAccounts(authParams)
+	if err != nil {
+		return
+	}
+	for _, sa := range accounts {
+		fmt.Println(sa.ID)
+	}
+}
+
 func oneClusterCompletions(c *cli.Context) {
 	if c.NArg() > 0 {
 		return
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dload"
 
@@ -74,6 +75,7 @@ const (
 
 	cmdSmap   = apc.WhatSmap
 	cmdBMD    = apc.WhatBMD
+	cmdFdoms  = "domains"
 	cmdConfig = "config" // apc.WhatNodeConfig and apc.WhatClusterConfig
 	cmdLog    = apc.WhatLog
 
@@ -112,6 +114,7 @@ const (
 	cmdDetach     = "detach"
 	cmdResetStats = "reset-stats"
 	cmdDropLcache = "drop-lcache"
+	cmdSetDomain  = "set-domain"
 
 	cmdReloadCreds = "reload-backend-creds"
 
@@ -157,6 +160,8 @@ const (
 	cmdShowCounters   = "counters"
 	cmdShowThroughput = "throughput"
 	cmdShowLatency    = "latency"
+	cmdShowRepl       = "replication"
+	cmdShowAudit      = "audit"
 
 	// Bucket properties subcommands
 	cmdSetBprops   = "set"
@@ -174,6 +179,9 @@ const (
 	cmdAuthCluster = cmdCluster
 	cmdAuthToken   = "token"
 	cmdAuthConfig  = cmdConfig
+	cmdAuthKey     = "access-key"
+	cmdAuthAccount = "account"
+	cmdAuthAPIKey  = "api-key"
 
 	// K8s subcommans
 	cmdK8s        = "kubectl"
@@ -257,7 +265,7 @@ const (
 )
 
 const (
-	archFormats = ".tar, .tgz or .tar.gz, .zip, .tar.lz4" // namely, archive.FileExtensions
+	archFormats = ".tar, .tgz or .tar.gz, .zip, .tar.lz4, .tar.zst" // namely, archive.FileExtensions
 	archExts    = "(" + archFormats + ")"
 )
 
@@ -367,6 +375,14 @@ const (
 	addSetAuthRoleArgument    = "ROLE [PERMISSION ...]"
 	deleteAuthRoleArgument    = "ROLE"
 	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE" //nolint:gosec // false positive G101
+	deleteAuthKeyArgument     = "USER_NAME ACCESS_KEY_ID"
+	addAuthAccountArgument    = "ACCOUNT_ID ROLE [ROLE...]"
+	setAuthAccountArgument    = "ACCOUNT_ID [ROLE...]"
+	showAuthAccountArgument   = "[ACCOUNT_ID]"
+	deleteAuthAccountArgument = "ACCOUNT_ID"
+	addAuthAPIKeyArgument     = "ACCOUNT_ID KEY_NAME [PERMISSION ...]"
+	showAuthAPIKeyArgument    = "ACCOUNT_ID"
+	deleteAuthAPIKeyArgument  = "ACCOUNT_ID API_KEY_ID|KEY_NAME"
 
 	// Alias
 	aliasURLPairArgument = "ALIAS=URL (or UUID=URL)"
@@ -575,6 +591,53 @@ var (
 		Usage: "List bucket's content alphabetically starting with the first name _after_ the specified",
 	}
 
+	// list-objects: server-side filters (see apc.LsoFilter)
+	lsMinSizeFlag = cli.StringFlag{
+		Name:  "min-size",
+		Usage: "List only objects of (at least) the specified size, e.g.: '--min-size 1MiB' (see also: '--units')",
+	}
+	lsMaxSizeFlag = cli.StringFlag{
+		Name:  "max-size",
+		Usage: "List only objects not exceeding the specified size, e.g.: '--max-size 4KiB' (see also: '--units')",
+	}
+	lsModifiedAfterFlag = cli.StringFlag{
+		Name: "modified-after",
+		Usage: "List only objects modified at or after the specified time (RFC3339 or YYYY-MM-DD) or duration ago, e.g.:\n" +
+			indent4 + "\t'--modified-after 2025-01-31', '--modified-after 2025-01-31T10:00:00Z', '--modified-after 24h'",
+	}
+	lsModifiedBeforeFlag = cli.StringFlag{
+		Name:  "modified-before",
+		Usage: "List only objects modified before the specified time or duration ago (same format as '--modified-after')",
+	}
+	lsAccessedAfterFlag = cli.StringFlag{
+		Name:  "accessed-after",
+		Usage: "List only in-cluster objects accessed at or after the specified time or duration ago (same format as '--modified-after')",
+	}
+	lsAccessedBeforeFlag = cli.StringFlag{
+		Name:  "accessed-before",
+		Usage: "List only in-cluster objects accessed before the specified time or duration ago (same format as '--modified-after')",
+	}
+	lsGlobFlag = cli.StringFlag{
+		Name:  "glob",
+		Usage: "Shell pattern to match object names (note that '*' does not match '/'), e.g.: '--glob \"images/*.jpg\"'",
+	}
+	lsCustomMDFlag = cli.StringFlag{
+		Name: "custom-md",
+		Usage: "List only objects with the specified custom metadata, comma-separated key=value pairs and/or keys, e.g.:\n" +
+			indent4 + "\t'--custom-md \"source=camera-7,reviewed\"'\t- 'source' must equal 'camera-7', 'reviewed' must be present",
+	}
+	lsHasCksumFlag = cli.BoolFlag{
+		Name:  "has-cksum",
+		Usage: "List only objects that have (a non-empty) checksum",
+	}
+
+	lsIndexedFlag = cli.BoolFlag{
+		Name: "indexed",
+		Usage: "Serve the listing from the targets' persistent list-objects indexes (in-cluster objects only);\n" +
+			indent4 + "\trequires bucket feature flag 'Enable-List-Index' and (re)building the index, e.g.:\n" +
+			indent4 + "\t'ais start rebuild-list-index BUCKET'; targets that have no ready-to-use index list authoritatively",
+	}
+
 	//
 	// list-objects sizing and limiting
 	//
@@ -854,6 +917,11 @@ var (
 		Name:  listCachedFlag.Name,
 		Usage: "Only visit " + _onlyin,
 	}
+	scrubCksumFlag = cli.BoolFlag{
+		Name: cksumFlag.Name,
+		Usage: "Validate checksums of in-cluster objects: recompute and compare with those stored\n" +
+			indent4 + "\t(slow: reads entire objects)",
+	}
 
 	// when '--all' is used for/by another flag
 	objNotCachedPropsFlag = cli.BoolFlag{
@@ -925,6 +993,17 @@ var (
 		Usage: "Chunk size in IEC or SI units, or \"raw\" bytes (e.g.: 4mb, 1MiB, 1048576, 128k; see '--units')",
 	}
 
+	// native multipart upload (`ais put` of a single large file)
+	mptThresholdFlag = cli.StringFlag{
+		Name: "multipart-threshold",
+		Usage: "Upload files greater than or equal to the specified size in parallel parts, via native multipart upload\n" +
+			indent1 + "\t(ais:// buckets only; default: 5GiB; zero disables; interrupted upload resumes when the same command is re-run)",
+	}
+	mptPartSizeFlag = cli.StringFlag{
+		Name:  "part-size",
+		Usage: "Multipart upload part size in IEC or SI units, or \"raw\" bytes (default: 128MiB; see '--multipart-threshold')",
+	}
+
 	blobThresholdFlag = cli.StringFlag{
 		Name: "blob-threshold",
 		Usage: "Utilize built-in blob-downloader for remote objects greater than the specified (threshold) size\n" +
@@ -994,7 +1073,7 @@ var (
 	descRoleFlag      = cli.StringFlag{Name: "description,desc", Usage: "Role description"}
 	clusterRoleFlag   = cli.StringFlag{Name: "cluster", Usage: "Associate role with the specified AIS cluster"}
 	clusterTokenFlag  = cli.StringFlag{Name: "cluster", Usage: "Issue token for the cluster"}
-	bucketRoleFlag    = cli.StringFlag{Name: "bucket", Usage: "Associate a role with the specified bucket"}
+	bucketRoleFlag    = cli.StringFlag{Name: "bucket", Usage: "Associate a role with the specified bucket or prefix (e.g., ais://datasets/team-a/)"}
 	clusterFilterFlag = cli.StringFlag{
 		Name:  "cluster",
 		Usage: "Comma-separated list of AIS cluster IDs (type ',' for an empty cluster ID)",
@@ -1083,6 +1162,40 @@ var (
 			indent4 + "\tvalid time units: " + timeUnits,
 		Value: 24 * time.Hour,
 	}
+	apiKeyFlag = cli.StringFlag{
+		Name:  "api-key",
+		Usage: "Log in with service account's API key (exchange the key for a short-lived token)",
+	}
+	apiKeyExpireFlag = DurationFlag{
+		Name: "expire,e",
+		Usage: "API key expiration time (default: never expires);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	apiKeyRolesFlag = cli.StringFlag{
+		Name:  "roles",
+		Usage: "Comma-separated subset of the service account's roles (default: all roles)",
+	}
+	apiKeyBucketFlag = cli.StringFlag{
+		Name: "bucket",
+		Usage: "Restrict API key to the specified bucket or prefix (e.g., ais://datasets/team-a/)\n" +
+			indent4 + "\tand permissions (command line arguments); requires " + qflprn(clusterRoleFlag),
+	}
+	descAccountFlag = cli.StringFlag{Name: "description,desc", Usage: "Service account description"}
+
+	// audit log
+	auditUserFlag   = cli.StringFlag{Name: "user", Usage: "Show only the calls made by the specified user (or service account)"}
+	auditActionFlag = cli.StringFlag{Name: "action", Usage: "Show only the specified action (e.g., 'destroy-bck', 'login')"}
+	auditSinceFlag  = DurationFlag{
+		Name: "since",
+		Usage: "Show only the calls made during the specified (most recent) time interval, e.g. '30m', '24h';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	auditAuthNFlag = cli.BoolFlag{Name: "authn", Usage: "Show AuthN audit records (instead of those recorded by AIS gateways)"}
+	auditLimitFlag = cli.IntFlag{
+		Name:  "limit",
+		Value: audit.DefaultLimit,
+		Usage: "Maximum number of (most recent) records to show",
+	}
 
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
@@ -47,6 +47,8 @@ var clusterFeatDesc = [...]string{
 	"when checking whether objects are identical trust only cryptographically secure checksums",
 	"when versioning info is requested, use ListObjectVersions API (beware: extremely slow, versioned S3 buckets only)",
 	"include (bucket, xaction) Prometheus variable labels with every GET and PUT transaction",
+	"when not running in Kubernetes: run ETL transformers as local processes (one per target)",
+	"(*) maintain persistent per-bucket index of in-cluster object names to serve list-objects (see 'ais ls --indexed')",
 
 	// "none" ====================
 }
@@ -261,7 +261,8 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	}
 
 	// when prefix crosses shard boundary
-	if external, internal := splitPrefixShardBoundary(prefix); internal != "" {
+	// (with '--archive', aistore lists the shard's content server-side, one page at a time)
+	if external, internal := splitPrefixShardBoundary(prefix); internal != "" && !listArch {
 		origPrefix := prefix
 		prefix = external
 		lstFilter._add(func(obj *cmn.LsoEnt) bool { return strings.HasPrefix(obj.Name, origPrefix) })
@@ -338,6 +339,20 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch, printEmpt
 	if flagIsSet(c, noDirsFlag) {
 		msg.SetFlag(apc.LsNoDirs)
 	}
+	if flagIsSet(c, lsIndexedFlag) {
+		if flagIsSet(c, listNotCachedFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(lsIndexedFlag), qflprn(listNotCachedFlag))
+		}
+		msg.SetFlag(apc.LsIndexed | apc.LsCached)
+		addCachedCol = false
+	}
+
+	// server-side filter
+	if flt, err := parseLsoFilter(c, listArch); err != nil {
+		return err
+	} else if !flt.IsEmpty() {
+		msg.Filter = flt
+	}
 
 	var (
 		props    []string
@@ -679,6 +694,68 @@ func newLstFilter(c *cli.Context) (flt *lstFilter, prefix string, _ error) {
 	return flt, prefix, nil
 }
 
+// server-side (apc.LsoFilter) counterpart of the above
+func parseLsoFilter(c *cli.Context, listArch bool) (flt *apc.LsoFilter, err error) {
+	flt = &apc.LsoFilter{NameGlob: parseStrFlag(c, lsGlobFlag)}
+
+	// (when listing archived content or showing unmatched names, regex remains client-side only)
+	if !listArch && !flagIsSet(c, showUnmatchedFlag) {
+		flt.NameRegex = parseStrFlag(c, regexLsAnyFlag)
+	}
+	if flagIsSet(c, lsMinSizeFlag) {
+		if flt.MinSize, err = parseSizeFlag(c, lsMinSizeFlag); err != nil {
+			return nil, err
+		}
+	}
+	if flagIsSet(c, lsMaxSizeFlag) {
+		if flt.MaxSize, err = parseSizeFlag(c, lsMaxSizeFlag); err != nil {
+			return nil, err
+		}
+	}
+	if flt.MtimeAfter, err = parseLsTimeFlag(c, lsModifiedAfterFlag); err != nil {
+		return nil, err
+	}
+	if flt.MtimeBefore, err = parseLsTimeFlag(c, lsModifiedBeforeFlag); err != nil {
+		return nil, err
+	}
+	if flt.AtimeAfter, err = parseLsTimeFlag(c, lsAccessedAfterFlag); err != nil {
+		return nil, err
+	}
+	if flt.AtimeBefore, err = parseLsTimeFlag(c, lsAccessedBeforeFlag); err != nil {
+		return nil, err
+	}
+	if flagIsSet(c, lsHasCksumFlag) {
+		flt.HasCksum = apc.Ptr(true)
+	}
+	if s := parseStrFlag(c, lsCustomMDFlag); s != "" {
+		flt.Custom = make(map[string]string, 4)
+		for _, kv := range splitCsv(s) {
+			k, v, _ := strings.Cut(kv, "=")
+			flt.Custom[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	return flt, flt.Validate()
+}
+
+// absolute time (RFC3339 or date) or duration ago; returns Unix nanoseconds
+//
+//nolint:gocritic // ignoring hugeParam - following the orig. github.com/urfave style
+func parseLsTimeFlag(c *cli.Context, flag cli.StringFlag) (int64, error) {
+	s := parseStrFlag(c, flag)
+	if s == "" {
+		return 0, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d).UnixNano(), nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UnixNano(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s: expecting RFC3339 time, YYYY-MM-DD date, or duration (e.g. 24h)", flprn(flag))
+}
+
 func (o *lstFilter) _add(f entryFilter) { o.predicates = append(o.predicates, f) }
 func (o *lstFilter) _len() int          { return len(o.predicates) }
 
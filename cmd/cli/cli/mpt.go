@@ -0,0 +1,274 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles native multipart upload of large files.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+
+	"github.com/urfave/cli"
+	"github.com/vbauerster/mpb/v4"
+	"golang.org/x/sync/errgroup"
+)
+
+// `ais put` of a single large file (see mptThresholdFlag):
+// - upload parts in parallel via api.UploadPart
+// - persist the state of the upload (upload ID, part size, source file size and mtime)
+//   under CLI config directory, so that re-running the same command (e.g., after
+//   client crash) would upload only the missing parts
+// - the state is removed once the upload completes
+
+const (
+	dfltMptThreshold = 5 * cos.GiB
+	dfltPartSize     = 128 * cos.MiB
+
+	mptStateDir = "mpt"
+)
+
+type mptState struct {
+	Bck      cmn.Bck `json:"bck"`
+	ObjName  string  `json:"obj"`
+	Path     string  `json:"path"`
+	UploadID string  `json:"upload_id"`
+	Size     int64   `json:"size"`
+	Mtime    int64   `json:"mtime"`
+	PartSize int64   `json:"part_size"`
+}
+
+// whether to upload `finfo` via native multipart upload
+func useMpt(c *cli.Context, bck cmn.Bck, finfo os.FileInfo) (bool, error) {
+	threshold := int64(dfltMptThreshold)
+	if flagIsSet(c, mptThresholdFlag) {
+		var err error
+		if threshold, err = parseSizeFlag(c, mptThresholdFlag); err != nil {
+			return false, err
+		}
+	}
+	if threshold <= 0 || finfo.Size() < threshold || !bck.IsAIS() {
+		return false, nil
+	}
+	// remote backend?
+	props, err := headBucket(bck, true /*don't add*/)
+	if err != nil {
+		return false, err
+	}
+	return props.BackendBck.IsEmpty(), nil
+}
+
+func putMpt(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.FileInfo) error {
+	partSize := int64(dfltPartSize)
+	if flagIsSet(c, mptPartSizeFlag) {
+		var err error
+		if partSize, err = parseSizeFlag(c, mptPartSizeFlag); err != nil {
+			return err
+		}
+		if partSize <= 0 {
+			return fmt.Errorf("invalid %s: expecting positive value", qflprn(mptPartSizeFlag))
+		}
+	}
+	var (
+		size     = finfo.Size()
+		mtime    = finfo.ModTime().UnixNano()
+		numParts int64
+		adjusted bool
+	)
+	if partSize, numParts, adjusted = mptPartSize(size, partSize); adjusted {
+		actionWarn(c, fmt.Sprintf("adjusted part size to %s (max number of parts %d)",
+			cos.ToSizeIEC(partSize, 0), apc.MptMaxParts))
+	}
+
+	// resume or start new
+	var (
+		done   map[int32]bool
+		dir    = filepath.Join(config.ConfigDir, mptStateDir)
+		fname  = mptStateFname(bck, objName, path)
+		state  = loadMptState(dir, fname, size, mtime, partSize)
+		resume bool
+	)
+	if state != nil {
+		parts, err := api.ListMultipartParts(apiBP, bck, objName, state.UploadID)
+		if err == nil {
+			done = state.uploaded(parts)
+			resume = true
+		} else if herr, ok := err.(*cmn.ErrHTTP); !ok || herr.Status != http.StatusNotFound {
+			actionWarn(c, fmt.Sprintf("failed to list parts of the upload %q: %v - starting new upload", state.UploadID, err))
+		}
+		if !resume {
+			// no longer active (e.g., target restarted)
+			_ = cos.RemoveFile(filepath.Join(dir, fname))
+		}
+	}
+	if resume {
+		fmt.Fprintf(c.App.Writer, "Resuming upload %q: %d out of %d parts already uploaded\n",
+			state.UploadID, len(done), numParts)
+	} else {
+		uploadID, err := api.CreateMultipartUpload(apiBP, bck, objName)
+		if err != nil {
+			return err
+		}
+		state = &mptState{
+			Bck:      bck,
+			ObjName:  objName,
+			Path:     path,
+			UploadID: uploadID,
+			Size:     size,
+			Mtime:    mtime,
+			PartSize: partSize,
+		}
+		err = cos.CreateDir(dir)
+		if err == nil {
+			err = jsp.SaveAppConfig(dir, fname, state)
+		}
+		if err != nil {
+			actionWarn(c, fmt.Sprintf("failed to save upload state (the upload won't be resumable): %v", err))
+		}
+	}
+
+	// upload
+	var (
+		progress *mpb.Progress
+		bars     []*mpb.Bar
+		cb       func(int64)
+	)
+	if flagIsSet(c, progressFlag) {
+		var doneSize int64
+		for num := range done {
+			_, n := state.section(num)
+			doneSize += n
+		}
+		args := barArgs{barType: sizeArg, barText: objName, total: size}
+		progress, bars = simpleBar(args)
+		bars[0].IncrInt64(doneSize)
+		cb = func(n int64) { bars[0].IncrInt64(n) } // (per uploaded part)
+	}
+	numWorkers := min(int64(parseIntFlag(c, numPutWorkersFlag)), numParts)
+	group := &errgroup.Group{}
+	group.SetLimit(max(int(numWorkers), 1))
+	for num := int32(1); int64(num) <= numParts; num++ {
+		if done[num] {
+			continue
+		}
+		group.Go(func() error {
+			return putPart(c, state, num, cb)
+		})
+	}
+	err := group.Wait()
+	if progress != nil {
+		if err != nil {
+			bars[0].Abort(true)
+		}
+		progress.Wait()
+	}
+	if err != nil {
+		return fmt.Errorf("%v\n(upload %q remains active - to resume, run the same command again)", err, state.UploadID)
+	}
+
+	// complete
+	if err := api.CompleteMultipartUpload(apiBP, bck, objName, state.UploadID, nil); err != nil {
+		return err
+	}
+	_ = cos.RemoveFile(filepath.Join(dir, fname))
+	return nil
+}
+
+func putPart(c *cli.Context, state *mptState, num int32, cb func(int64)) error {
+	var (
+		off, size = state.section(num)
+		iters     = 1 + parseRetriesFlag(c, putRetriesFlag, false /*warn*/)
+		err       error
+	)
+	for i := range iters {
+		var fh *cos.FileSectionHandle
+		if fh, err = cos.NewFileSectionHandle(state.Path, off, size); err != nil {
+			return err
+		}
+		args := &api.PutPartArgs{
+			UploadID: state.UploadID,
+			PartNum:  int(num),
+			PutArgs: api.PutArgs{
+				BaseParams: apiBP,
+				Bck:        state.Bck,
+				ObjName:    state.ObjName,
+				Reader:     fh,
+				Size:       uint64(size),
+			},
+		}
+		if _, err = api.UploadPart(args); err == nil {
+			if cb != nil {
+				cb(size)
+			}
+			return nil
+		}
+		var errCreate *cmn.ErrCreateHreq
+		if errors.As(err, &errCreate) {
+			return err
+		}
+		if i < iters-1 {
+			fmt.Fprintf(c.App.ErrWriter, "[#%d] part %d: %v - retrying...\n", i+1, num, stripErr(err))
+			briefPause(1)
+		}
+	}
+	return fmt.Errorf("part %d: %v", num, stripErr(err))
+}
+
+// (part size, number of parts) given the max number of parts
+func mptPartSize(size, partSize int64) (int64, int64, bool) {
+	numParts := (size + partSize - 1) / partSize
+	if numParts <= apc.MptMaxParts {
+		return partSize, numParts, false
+	}
+	partSize = (size + apc.MptMaxParts - 1) / apc.MptMaxParts
+	return partSize, (size + partSize - 1) / partSize, true
+}
+
+// previously saved state of the same upload, if any; stale state (different source
+// or part size) gets removed
+func loadMptState(dir, fname string, size, mtime, partSize int64) *mptState {
+	state := &mptState{}
+	if err := jsp.LoadAppConfig(dir, fname, state); err != nil {
+		return nil
+	}
+	if state.UploadID == "" || state.Size != size || state.Mtime != mtime || state.PartSize != partSize {
+		_ = cos.RemoveFile(filepath.Join(dir, fname))
+		return nil
+	}
+	return state
+}
+
+// (offset, size) of the given part in the source file
+func (s *mptState) section(num int32) (off, size int64) {
+	off = int64(num-1) * s.PartSize
+	return off, min(s.PartSize, s.Size-off)
+}
+
+// parts that do not need to be uploaded again
+func (s *mptState) uploaded(parts []apc.MptPart) map[int32]bool {
+	done := make(map[int32]bool, len(parts))
+	for _, part := range parts {
+		if part.Num < 1 {
+			continue
+		}
+		if off, size := s.section(part.Num); off < s.Size && part.Size == size {
+			done[part.Num] = true
+		}
+	}
+	return done
+}
+
+// one state file per (destination, source)
+func mptStateFname(bck cmn.Bck, objName, path string) string {
+	return cos.ChecksumB2S(cos.UnsafeB(bck.Cname(objName)+"|"+path), cos.ChecksumMD5) + ".json"
+}
@@ -0,0 +1,81 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestMptPartSize(t *testing.T) {
+	tests := []struct {
+		size, partSize  int64
+		expSize, expNum int64
+		expAdjusted     bool
+	}{
+		{10 * cos.GiB, 128 * cos.MiB, 128 * cos.MiB, 80, false},
+		{10*cos.GiB + 1, 128 * cos.MiB, 128 * cos.MiB, 81, false},
+		{5 * cos.GiB, 5 * cos.GiB, 5 * cos.GiB, 1, false},
+		{apc.MptMaxParts * 2, 1, 2, apc.MptMaxParts, true},
+		{apc.MptMaxParts*2 + 1, 1, 3, (apc.MptMaxParts*2 + 3) / 3, true},
+	}
+	for i, test := range tests {
+		partSize, numParts, adjusted := mptPartSize(test.size, test.partSize)
+		tassert.Errorf(t, partSize == test.expSize && numParts == test.expNum && adjusted == test.expAdjusted,
+			"%d: expected (%d, %d, %t), got (%d, %d, %t)", i, test.expSize, test.expNum, test.expAdjusted, partSize, numParts, adjusted)
+		tassert.Errorf(t, numParts <= apc.MptMaxParts && (numParts-1)*partSize < test.size && numParts*partSize >= test.size,
+			"%d: %d parts of size %d do not cover %d", i, numParts, partSize, test.size)
+	}
+}
+
+func TestMptStateResume(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		bck   = cmn.Bck{Name: "abc", Provider: apc.AIS}
+		fname = mptStateFname(bck, "obj", "/tmp/src")
+		state = &mptState{Bck: bck, ObjName: "obj", Path: "/tmp/src", UploadID: "id1", Size: 25, Mtime: 100, PartSize: 10}
+	)
+	tassert.Errorf(t, loadMptState(dir, fname, 25, 100, 10) == nil, "expecting no state")
+	tassert.CheckFatal(t, jsp.SaveAppConfig(dir, fname, state))
+
+	// same source, same part size
+	loaded := loadMptState(dir, fname, 25, 100, 10)
+	tassert.Fatalf(t, loaded != nil && loaded.UploadID == "id1", "expecting to resume id1, got %+v", loaded)
+
+	// parts 1 and 3 uploaded; part 2 of unexpected size, part 4 out of range
+	done := loaded.uploaded([]apc.MptPart{{Num: 1, Size: 10}, {Num: 2, Size: 7}, {Num: 3, Size: 5}, {Num: 4, Size: 10}, {Num: 0}})
+	tassert.Errorf(t, len(done) == 2 && done[1] && done[3], "expecting parts 1 and 3, got %v", done)
+
+	off, size := loaded.section(3)
+	tassert.Errorf(t, off == 20 && size == 5, "last part: expecting (20, 5), got (%d, %d)", off, size)
+
+	// source modified: stale state gets removed
+	tassert.Errorf(t, loadMptState(dir, fname, 25, 101, 10) == nil, "expecting no state (modified source)")
+	tassert.Errorf(t, loadMptState(dir, fname, 25, 100, 10) == nil, "expecting stale state to be removed")
+
+	// different part size
+	tassert.CheckFatal(t, jsp.SaveAppConfig(dir, fname, state))
+	tassert.Errorf(t, loadMptState(dir, fname, 25, 100, 5) == nil, "expecting no state (different part size)")
+
+	// one state file per (destination, source)
+	names := []string{
+		fname,
+		mptStateFname(bck, "obj", "/tmp/other"),
+		mptStateFname(bck, "obj2", "/tmp/src"),
+		mptStateFname(cmn.Bck{Name: "abc2", Provider: apc.AIS}, "obj", "/tmp/src"),
+	}
+	for i := range names {
+		tassert.Errorf(t, filepath.Ext(names[i]) == ".json", "unexpected %q", names[i])
+		for j := range i {
+			tassert.Errorf(t, names[i] != names[j], "%d and %d: same state file %q", i, j, names[i])
+		}
+	}
+}
@@ -376,6 +376,9 @@ func propVal(op *cmn.ObjectProps, name string) (v string, err error) {
 		}
 	case apc.GetPropsEC:
 		v = teb.FmtEC(op.EC.Generation, op.EC.DataSlices, op.EC.ParitySlices, op.EC.IsECCopy)
+		if op.EC.Profile != "" {
+			v += " profile " + op.EC.Profile
+		}
 	case apc.GetPropsCustom:
 		if custom := op.GetCustomMD(); len(custom) == 0 {
 			v = teb.NotSetVal
@@ -384,6 +387,8 @@ func propVal(op *cmn.ObjectProps, name string) (v string, err error) {
 		}
 	case apc.GetPropsLocation:
 		v = op.Location
+	case apc.GetPropsTags:
+		v, _ = op.GetCustomKey(cmn.TagsObjMD)
 	case apc.GetPropsStatus:
 		// no "object status" in `cmn.ObjectProps` - nothing to do (see also: `cmn.LsoEnt`)
 	default:
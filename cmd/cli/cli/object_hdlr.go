@@ -127,6 +127,8 @@ var (
 		commandPut: append(
 			listRangeProgressWaitFlags,
 			chunkSizeFlag,
+			mptThresholdFlag,
+			mptPartSizeFlag,
 			numPutWorkersFlag,
 			dryRunFlag,
 			recursFlag,
@@ -29,7 +29,6 @@ import (
 )
 
 // [TODO]
-// - '--checksum' option (slow)
 // - '--fix' option (***)
 // - multiple buckets vs one-log-per-scrub-metric - a problem
 // - async execution with '--wait' option
@@ -41,6 +40,7 @@ const (
 	logTitleVerChanged = "Name,Size,Custom"
 	logTitleMisplaced  = "Name,Size,Atime,Location"
 	logTitleCopies     = "Name,Size,Copies"
+	logTitleBadCksum   = "Name,Size,Checksum"
 	logDelim           = `","`
 
 	logMaxLn = 256
@@ -98,6 +98,7 @@ var (
 		smallSizeFlag,
 		largeSizeFlag,
 		scrubObjCachedFlag,
+		scrubCksumFlag,
 		allColumnsFlag,
 	)
 )
@@ -218,6 +219,9 @@ func (ctx *scrCtx) iniLogs() {
 		case teb.ScrMissingCp:
 			log.title = logTitleCopies
 			log.do = log.copies
+		case teb.ScrBadCksum:
+			log.title = logTitleBadCksum
+			log.do = log.badCksum
 		}
 	}
 }
@@ -281,7 +285,7 @@ func (ctx *scrCtx) prnt() error {
 		out[i] = (*teb.ScrBp)(scr)
 	}
 	all := teb.ScrubHelper{All: out}
-	tab := all.MakeTab(ctx.units, ctx.haveRemote.Load(), flagIsSet(ctx.c, allColumnsFlag))
+	tab := all.MakeTab(ctx.units, ctx.haveRemote.Load(), flagIsSet(ctx.c, scrubCksumFlag), flagIsSet(ctx.c, allColumnsFlag))
 
 	return teb.Print(out, tab.Template(flagIsSet(ctx.c, noHeaderFlag)))
 }
@@ -341,6 +345,12 @@ func (ctx *scrCtx) ls(bck cmn.Bck) (*scrBp, error) {
 		lsmsg.SetFlag(apc.LsCached)
 	}
 
+	// slow: recompute in-cluster checksums
+	if flagIsSet(ctx.c, scrubCksumFlag) {
+		lsmsg.SetFlag(apc.LsValidateCksum)
+		lsmsg.AddProps(apc.GetPropsChecksum)
+	}
+
 	pageSize, maxPages, limit, err := _setPage(ctx.c, bck)
 	if err != nil {
 		return nil, err
@@ -493,6 +503,12 @@ func (scr *scrBp) upd(parent *scrCtx, en *cmn.LsoEnt) {
 		scr.Stats[teb.ScrVremoved].Siz += en.Size
 		scr.log(parent, en, teb.ScrVremoved)
 	}
+
+	if en.IsAnyFlagSet(apc.EntryBadCksum) {
+		scr.Stats[teb.ScrBadCksum].Cnt++
+		scr.Stats[teb.ScrBadCksum].Siz += en.Size
+		scr.log(parent, en, teb.ScrBadCksum)
+	}
 }
 
 // NOTE: exit upon (unlikely) failure
@@ -603,3 +619,20 @@ func (log *_log) copies(scr *scrBp, en *cmn.LsoEnt) {
 	fmt.Fprintln(log.fh, sb.String())
 	log.cnt++
 }
+
+// logTitleBadCksum = "Name,Size,Checksum"
+func (log *_log) badCksum(scr *scrBp, en *cmn.LsoEnt) {
+	sb := &scr.Line
+	sb.Reset(logMaxLn)
+	sb.WriteByte('"')
+
+	scr.cname(en.Name)
+
+	sb.WriteString(logDelim)
+	sb.WriteString(strconv.FormatInt(en.Size, 10))
+	sb.WriteString(logDelim)
+	sb.WriteString(en.Checksum)
+	sb.WriteByte('"')
+	fmt.Fprintln(log.fh, sb.String())
+	log.cnt++
+}
@@ -17,8 +17,11 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
@@ -99,6 +102,10 @@ var (
 			jsonFlag,
 			noHeaderFlag,
 		),
+		cmdFdoms: {
+			jsonFlag,
+			noHeaderFlag,
+		},
 		cmdBucket: {
 			jsonFlag,
 			compactPropFlag,
@@ -114,6 +121,20 @@ var (
 			verboseFlag,
 			jsonFlag,
 		},
+		cmdShowRepl: {
+			noHeaderFlag,
+			unitsFlag,
+			jsonFlag,
+		},
+		cmdShowAudit: {
+			auditUserFlag,
+			auditActionFlag,
+			auditSinceFlag,
+			auditLimitFlag,
+			auditAuthNFlag,
+			noHeaderFlag,
+			jsonFlag,
+		},
 	}
 
 	showCmd = cli.Command{
@@ -129,6 +150,8 @@ var (
 			showCmdRebalance,
 			showCmdConfig,
 			showCmdRemoteAIS,
+			showCmdRepl,
+			showCmdAudit,
 			showCmdJob,
 			showCmdLog,
 			showTLS,
@@ -180,6 +203,12 @@ var (
 				Action:       showBMDHandler,
 				BashComplete: suggestAllNodes,
 			},
+			{
+				Name:   cmdFdoms,
+				Usage:  "Show failure domains (zones, racks) and erasure coded buckets that cannot be placed in distinct domains",
+				Flags:  sortFlags(showCmdsFlags[cmdFdoms]),
+				Action: showFdomainsHandler,
+			},
 			{
 				Name:      cmdConfig,
 				Usage:     "Show cluster and node configuration",
@@ -213,6 +242,28 @@ var (
 		Flags:     sortFlags(showCmdsFlags[cmdShowRemoteAIS]),
 		Action:    showRemoteAISHandler,
 	}
+	showCmdRepl = cli.Command{
+		Name: cmdShowRepl,
+		Usage: "Show cross-cluster replication status: per bucket and per target, e.g.:\n" +
+			indent1 + "\t- 'ais show replication'\t- all buckets that have replication enabled;\n" +
+			indent1 + "\t- 'ais show replication ais://abc --units raw'\t- given bucket, with raw sizes and durations.",
+		ArgsUsage:    optionalBucketArgument,
+		Flags:        sortFlags(showCmdsFlags[cmdShowRepl]),
+		Action:       showReplHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
+	showCmdAudit = cli.Command{
+		Name: cmdShowAudit,
+		Usage: "Show recent audit records: mutating and access-controlled API calls, most recent first, e.g.:\n" +
+			indent1 + "\t- 'ais show audit'\t- most recent calls handled by all AIS gateways (requires 'audit.enabled' config);\n" +
+			indent1 + "\t- 'ais show audit ais://abc --since 1h'\t- given bucket, during the last hour;\n" +
+			indent1 + "\t- 'ais show audit --user alice --action destroy-bck'\t- given user and action;\n" +
+			indent1 + "\t- 'ais show audit --authn --action login'\t- AuthN logins (requires 'audit.enabled' in AuthN config).",
+		ArgsUsage:    optionalBucketArgument,
+		Flags:        sortFlags(showCmdsFlags[cmdShowAudit]),
+		Action:       showAuditHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
 
 	showCmdJob = cli.Command{
 		Name:         commandJob,
@@ -768,6 +819,57 @@ func showBMDHandler(c *cli.Context) error {
 	return nil
 }
 
+func showFdomainsHandler(c *cli.Context) error {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	bmd, err := api.GetBMD(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	rep := smap.CheckFdomains(bmd)
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(rep, "", teb.Jopts(true))
+	}
+	if len(rep.Domains) == 0 {
+		actionDone(c, "No failure domains: none of the targets is labeled (see `ais cluster "+cmdSetDomain+"`)")
+		return nil
+	}
+
+	doms := make([]string, 0, len(rep.Domains))
+	for dom := range rep.Domains {
+		doms = append(doms, dom)
+	}
+	sort.Strings(doms)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "DOMAIN\tTARGETS")
+	}
+	for _, dom := range doms {
+		fmt.Fprintf(tw, "%s\t%s\n", dom, strings.Join(rep.Domains[dom], ", "))
+	}
+	if len(rep.Unlabeled) > 0 {
+		fmt.Fprintf(tw, "%s\t%s\n", "(unlabeled)", strings.Join(rep.Unlabeled, ", "))
+	}
+	tw.Flush()
+
+	fmt.Fprintln(c.App.Writer)
+	if len(rep.Violations) == 0 {
+		fmt.Fprintln(c.App.Writer, "All erasure coded buckets can be placed in distinct failure domains")
+		return nil
+	}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "BUCKET\tEC\tREQUIRED DOMAINS\tAVAILABLE")
+	for _, v := range rep.Violations {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\n", v.Bck.Cname(""), v.EC, v.Required, v.Avail)
+	}
+	tw.Flush()
+	return fmt.Errorf("%d erasure coded bucket%s cannot be placed in distinct failure domains",
+		len(rep.Violations), cos.Plural(len(rep.Violations)))
+}
+
 func showClusterConfigHandler(c *cli.Context) error {
 	return showClusterConfig(c, c.Args().Get(0))
 }
@@ -1031,3 +1133,169 @@ For details and usage examples, see: docs/cli/config.md`
 	}
 	return nil
 }
+
+func showReplHandler(c *cli.Context) error {
+	var qbck cmn.Bck
+	if c.NArg() > 0 {
+		bck, err := parseBckURI(c, c.Args().Get(0), false)
+		if err != nil {
+			return err
+		}
+		qbck = bck
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	all, err := api.GetReplStats(apiBP)
+	if err != nil {
+		return V(err)
+	}
+
+	// sort by bucket, then by target
+	type row struct {
+		st  *cmn.ReplStats
+		tid string
+	}
+	rows := make([]row, 0, len(all))
+	for tid, tstats := range all {
+		for _, st := range tstats {
+			if qbck.Name != "" && !qbck.Equal(&st.Bck) {
+				continue
+			}
+			rows = append(rows, row{st, tid})
+		}
+	}
+	if flagIsSet(c, jsonFlag) {
+		out := make(map[string][]*cmn.ReplStats, len(all))
+		for _, r := range rows {
+			out[r.tid] = append(out[r.tid], r.st)
+		}
+		return teb.Print(out, "", teb.Jopts(true))
+	}
+	if len(rows) == 0 {
+		if qbck.Name != "" {
+			actionDone(c, "Bucket "+qbck.Cname("")+" does not have replication enabled")
+		} else {
+			actionDone(c, "No buckets with replication enabled")
+		}
+		return nil
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		ci, cj := rows[i].st.Bck.Cname(""), rows[j].st.Bck.Cname("")
+		if ci != cj {
+			return ci < cj
+		}
+		return rows[i].tid < rows[j].tid
+	})
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "BUCKET\tDESTINATION\tTARGET\tPENDING\tLAG\tSHIPPED\tERRORS\tLAST SYNC\tJOB\tLAST ERROR")
+	}
+	for _, r := range rows {
+		var (
+			st       = r.st
+			lag      = teb.NotSetVal
+			lastSync = teb.NotSetVal
+			xid      = teb.NotSetVal
+			lastErr  = teb.NotSetVal
+		)
+		if st.Lag > 0 {
+			lag = teb.FmtDuration(st.Lag, units)
+		}
+		if st.LastSync > 0 {
+			lastSync = teb.FmtTime(time.Unix(0, st.LastSync))
+		}
+		if st.Xid != "" {
+			xid = st.Xid
+		}
+		if st.LastErr != "" {
+			lastErr = st.LastErr
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\t%s\n",
+			st.Bck.Cname(""), st.Dst, meta.Tname(r.tid), teb.FmtSize(st.Pending, units, 2), lag,
+			st.Shipped, st.Errors, lastSync, xid, lastErr)
+	}
+	return tw.Flush()
+}
+
+func showAuditHandler(c *cli.Context) error {
+	q := &audit.Query{
+		User:   parseStrFlag(c, auditUserFlag),
+		Action: parseStrFlag(c, auditActionFlag),
+		Limit:  parseIntFlag(c, auditLimitFlag),
+	}
+	if c.NArg() > 0 {
+		bck, err := parseBckURI(c, c.Args().Get(0), false)
+		if err != nil {
+			return err
+		}
+		q.Bucket = bck.Cname("")
+	}
+	if flagIsSet(c, auditSinceFlag) {
+		q.Since = time.Now().Add(-parseDurationFlag(c, auditSinceFlag)).UnixNano()
+	}
+	var (
+		recs []*audit.Record
+		err  error
+	)
+	if flagIsSet(c, auditAuthNFlag) {
+		if authParams.Client == nil {
+			return errors.New(env.AisAuthURL + " is not set")
+		}
+		recs, err = authn.GetAuditRecords(authParams, q)
+	} else {
+		recs, err = api.GetAuditRecords(apiBP, q)
+	}
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(recs, "", teb.Jopts(true))
+	}
+	if len(recs) == 0 {
+		actionDone(c, "No audit records")
+		return nil
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TIME\tNODE\tUSER\tCLIENT\tREQUEST\tACTION\tBUCKET/OBJECT\tJOB\tSTATUS")
+	}
+	for _, rec := range recs {
+		var (
+			node   = rec.Node
+			user   = teb.NotSetVal
+			action = teb.NotSetVal
+			what   = teb.NotSetVal
+			xid    = teb.NotSetVal
+		)
+		if node != audit.NodeAuthN {
+			node = meta.Pname(node)
+		}
+		if rec.User != "" {
+			user = rec.User
+		}
+		if rec.Action != "" {
+			action = rec.Action
+		}
+		switch {
+		case rec.Object != "":
+			what = rec.Bucket + "/" + rec.Object
+		case rec.Prefix != "":
+			what = rec.Bucket + "/" + rec.Prefix + "*"
+		case rec.Bucket != "":
+			what = rec.Bucket
+		}
+		if rec.Xid != "" {
+			xid = rec.Xid
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s %s\t%s\t%s\t%s\t%d\n",
+			teb.FmtDateTime(time.Unix(0, rec.Time)), node, user, rec.ClientIP, rec.Method, rec.Path,
+			action, what, xid, rec.Code)
+	}
+	return tw.Flush()
+}
@@ -667,6 +667,8 @@ func flattenBackends(backends []string) (flat nvpairList) {
 			nv.Value = "Azure Blob Storage"
 		case apc.OCI:
 			nv.Value = "Oracle Cloud Infrastructure (OCI) Object Storage"
+		case apc.File:
+			nv.Value = "POSIX directory tree"
 		}
 		flat = append(flat, nv)
 	}
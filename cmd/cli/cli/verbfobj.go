@@ -435,6 +435,15 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 	if err != nil {
 		return err
 	}
+	if cksum == nil && !flagIsSet(c, encodeObjnameFlag) {
+		mpt, err := useMpt(c, bck, finfo)
+		if err != nil {
+			return err
+		}
+		if mpt {
+			return putMpt(c, bck, objName, path, finfo)
+		}
+	}
 	fh, err := cos.NewFileHandle(path)
 	if err != nil {
 		return err
@@ -25,6 +25,7 @@ var (
 		apc.GetPropsStatus:   "{{FormatLsObjStatus $obj}}",
 		apc.GetPropsCopies:   "{{$obj.Copies}}",
 		apc.GetPropsCached:   "{{FormatLsObjIsCached $obj}}",
+		apc.GetPropsTags:     "{{$obj.Tags}}",
 	}
 )
 
@@ -87,6 +88,8 @@ func fmtLsObjStatus(en *cmn.LsoEnt) string {
 			return UnknownStatusVal
 		}
 		switch {
+		case en.IsAnyFlagSet(apc.EntryBadCksum):
+			return fred("bad-checksum")
 		case en.IsAnyFlagSet(apc.EntryVerChanged):
 			return fcyan("version-changed")
 		case en.IsAnyFlagSet(apc.EntryVerRemoved):
@@ -25,6 +25,7 @@ const (
 	colLargeSz        = "LARGE"
 	colVchanged       = "VER-CHANGED"
 	colVremoved       = "DELETED"
+	colBadCksum       = "BAD-CHECKSUM" // (when validating checksums)
 )
 
 const (
@@ -37,12 +38,13 @@ const (
 	ScrLargeSz
 	ScrVchanged
 	ScrVremoved
+	ScrBadCksum
 
 	ScrNumStats // NOTE: must be the last
 )
 
 var (
-	ScrCols = [...]string{colObjects, colNotIn, colMisplacedNode, colMisplacedMpath, colMissingCp, colSmallSz, colLargeSz, colVchanged, colVremoved}
+	ScrCols = [...]string{colObjects, colNotIn, colMisplacedNode, colMisplacedMpath, colMissingCp, colSmallSz, colLargeSz, colVchanged, colVremoved, colBadCksum}
 	ScrNums = [ScrNumStats]int64{}
 )
 
@@ -81,7 +83,7 @@ func (h *ScrubHelper) colFirst() string {
 	}
 }
 
-func (h *ScrubHelper) MakeTab(units string, haveRemote, allColumns bool) *Table {
+func (h *ScrubHelper) MakeTab(units string, haveRemote, validCksum, allColumns bool) *Table {
 	debug.Assert(len(ScrCols) == len(ScrNums))
 
 	cols := make([]*header, 1, len(ScrCols)+1)
@@ -103,6 +105,9 @@ func (h *ScrubHelper) MakeTab(units string, haveRemote, allColumns bool) *Table
 		h._hideCol(cols, colVchanged)
 		h._hideCol(cols, colVremoved)
 	}
+	if !validCksum {
+		h._hideCol(cols, colBadCksum)
+	}
 
 	// make tab
 	for _, scr := range h.All {
@@ -27,6 +27,7 @@ const (
 		"{{ range $key, $si := .Smap.Pmap }} " +
 		"{{ $nonElect := $.Smap.NonElectable $si }}" +
 		"{{ if (eq $nonElect true) }} ProxyID: {{$key}}\n{{end}}{{end}}\n" +
+		"{{ with .Smap.StrDomains }}Failure Domains:\t{{.}}\n{{end}}" +
 		"Primary Proxy:\t{{.Smap.Primary.ID}}\n" +
 		"Summary:\tproxies({{len .Smap.Pmap}}), targets({{len .Smap.Tmap}}), cluster map(v{{.Smap.Version}}), cluster ID(\"{{.Smap.UUID}}\")\n"
 
@@ -275,6 +276,27 @@ See '--help' and docs/cli for details.`
 		"{{end}}\n" +
 		"{{end}}"
 
+	AuthNAccessKeyTmpl = "ACCESS KEY ID\tEXPIRES\n" +
+		"{{ range $key := . }}" +
+		"{{ $key.ID }}\t{{ FormatStart $key.Expires }}\n" +
+		"{{end}}"
+
+	AuthNAccountTmpl = "SERVICE ACCOUNT\tROLES\tCREATED\tDESCRIPTION\n" +
+		"{{ range $sa := . }}" +
+		"{{ $sa.ID }}\t{{ range $i, $role := $sa.Roles }}" +
+		"{{ if $i }}, {{ end }}{{ $role.Name }}" +
+		"{{end}}\t{{ FormatStart $sa.Created }}\t{{ $sa.Description }}\n" +
+		"{{end}}"
+
+	AuthNAPIKeyTmpl = "API KEY ID\tNAME\tROLES\tBUCKETS\tEXPIRES\tLAST USED\n" +
+		"{{ range $key := . }}" +
+		"{{ $key.ID }}\t{{ $key.Name }}\t{{ JoinList $key.Roles }}\t" +
+		"{{ range $i, $bck := $key.BucketACLs }}{{ if $i }}, {{ end }}" +
+		"{{ FormatBckName $bck.Bck }}{{ if $bck.Prefix }}/{{ $bck.Prefix }}{{ end }}({{ FormatACL $bck.Access }})" +
+		"{{ else }}-{{ end }}\t" +
+		"{{ FormatStart $key.Expires }}\t{{ if $key.LastUsed.IsZero }}-{{ else }}{{ FormatStart $key.LastUsed }}{{ end }}\n" +
+		"{{end}}"
+
 	AuthNUserVerboseTmpl = "Name\t{{ .ID }}\n" +
 		"Roles\t{{ range $i, $role := .Roles }}{{ if $i }}, {{ end }}{{ $role.Name }}{{ end }}\n" +
 		"{{ range $role := .Roles }}" +
@@ -286,7 +308,7 @@ See '--help' and docs/cli for details.`
 		"{{ if ne (len $role.BucketACLs) 0 }}" +
 		"BUCKET\tPERMISSIONS\n" +
 		"{{ range $bck := $role.BucketACLs }}" +
-		"{{ FormatBckName $bck.Bck }}\t{{ FormatACL $bck.Access }}\n" +
+		"{{ FormatBckName $bck.Bck }}{{ if $bck.Prefix }}/{{ $bck.Prefix }}{{ end }}\t{{ FormatACL $bck.Access }}\n" +
 		"{{end}}{{end}}" +
 		"{{ end }}"
 
@@ -300,7 +322,7 @@ See '--help' and docs/cli for details.`
 		"{{ if ne (len .BucketACLs) 0 }}" +
 		"BUCKET\tPERMISSIONS\n" +
 		"{{ range $bck := .BucketACLs }}" +
-		"{{ FormatBckName $bck.Bck }}\t{{ FormatACL $bck.Access }}\n" +
+		"{{ FormatBckName $bck.Bck }}{{ if $bck.Prefix }}/{{ $bck.Prefix }}{{ end }}\t{{ FormatACL $bck.Access }}\n" +
 		"{{end}}{{end}}"
 
 	// `search`
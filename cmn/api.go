@@ -63,6 +63,10 @@ type (
 		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
 		RateLimit   RateLimitConf   `json:"rate_limit"`                     // adaptive rate limiting (front, back) if enabled
+		Lifecycle   LifecycleConf   `json:"lifecycle" list:"omitempty"`     // object expiration rules (bucket-scope, not inherited)
+		CORS        CORSConf        `json:"cors" list:"omitempty"`          // cross-origin resource sharing (ditto)
+		Replication ReplConf        `json:"replication" list:"omitempty"`   // cross-cluster async replication (ditto)
+		Events      EventsConf      `json:"events" list:"omitempty"`        // object event notifications (ditto)
 	}
 
 	ExtraProps struct {
@@ -140,6 +144,10 @@ type (
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
+		Lifecycle   *LifecycleConfToSet   `json:"lifecycle,omitempty"`
+		CORS        *CORSConfToSet        `json:"cors,omitempty"`
+		Replication *ReplConfToSet        `json:"replication,omitempty"`
+		Events      *EventsConfToSet      `json:"events,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
@@ -236,7 +244,7 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Lifecycle, &bp.CORS, &bp.Replication, &bp.Events} {
 		var err error
 		switch {
 		case pv == &bp.EC:
@@ -388,7 +396,7 @@ type (
 	// ArchiveBckMsg contains parameters to archive mutiple objects from the specified (source) bucket.
 	// Destination bucket may the same as the source or a different one.
 	// --------------------  NOTE on terminology:   ---------------------
-	// "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4) formatted object often also called "shard"
+	// "archive" is any (.tar, .tgz/.tar.gz, .zip, .tar.lz4, .tar.zst) formatted object often also called "shard"
 	//
 	// See also: apc.PutApndArchArgs
 	ArchiveBckMsg struct {
@@ -12,6 +12,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -23,8 +24,21 @@ import (
 
 // archived file entry
 type Entry struct {
-	Name string
-	Size int64 // uncompressed size
+	Name   string
+	Size   int64 // uncompressed size
+	Offset int64 // file data offset: in the TAR stream (compressed TARs: uncompressed stream); in the ZIP file (compressed or stored data)
+}
+
+// count bytes consumed by tar.Reader to compute (data) offsets
+type cntReader struct {
+	r   io.Reader
+	off int64
+}
+
+func (cr *cntReader) Read(b []byte) (n int, err error) {
+	n, err = cr.r.Read(b)
+	cr.off += int64(n)
+	return n, err
 }
 
 func List(fqn string) ([]*Entry, error) {
@@ -52,6 +66,8 @@ func List(fqn string) ([]*Entry, error) {
 		}
 	case ExtTarLz4:
 		lst, err = lsLz4(fh)
+	case ExtTarZst:
+		lst, err = lsZst(fh)
 	default:
 		debug.Assert(false, mime)
 	}
@@ -64,9 +80,25 @@ func List(fqn string) ([]*Entry, error) {
 	return lst, nil
 }
 
-// list: tar, tgz, zip, msgpack
+// SplitPrefix splits list-objects prefix that crosses shard boundary, e.g.:
+// "A.tar/tutorials/" => ("A.tar", "tutorials/"); returns empty shard name otherwise
+func SplitPrefix(prefix string) (shard, inner string) {
+	for _, ext := range FileExtensions {
+		i := strings.Index(prefix, ext+"/")
+		if i <= 0 {
+			continue
+		}
+		return prefix[:i+len(ext)], prefix[i+len(ext)+1:]
+	}
+	return "", ""
+}
+
+// list: tar, tgz, zip, lz4, zst
 func lsTar(reader io.Reader) (lst []*Entry, _ error) {
-	tr := tar.NewReader(reader)
+	var (
+		cr = &cntReader{r: reader}
+		tr = tar.NewReader(cr)
+	)
 	for {
 		hdr, err := tr.Next()
 		if err != nil {
@@ -78,7 +110,8 @@ func lsTar(reader io.Reader) (lst []*Entry, _ error) {
 		if hdr.FileInfo().IsDir() {
 			continue
 		}
-		e := &Entry{Name: hdr.Name, Size: hdr.Size}
+		// NOTE: tar.Reader reads exactly the header block(s), which makes the data offset == bytes consumed
+		e := &Entry{Name: hdr.Name, Size: hdr.Size, Offset: cr.off}
 		lst = append(lst, e)
 	}
 }
@@ -105,6 +138,9 @@ func lsZip(readerAt cos.ReadReaderAt, size int64) (lst []*Entry, err error) {
 			Name: f.FileHeader.Name,
 			Size: int64(f.FileHeader.UncompressedSize64),
 		}
+		if e.Offset, err = f.DataOffset(); err != nil {
+			return nil, err
+		}
 		lst = append(lst, e)
 	}
 	return
@@ -114,3 +150,12 @@ func lsLz4(reader io.Reader) ([]*Entry, error) {
 	lzr := lz4.NewReader(reader)
 	return lsTar(lzr)
 }
+
+func lsZst(reader io.Reader) ([]*Entry, error) {
+	zsr, err := newZstdReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer zsr.Close()
+	return lsTar(zsr)
+}
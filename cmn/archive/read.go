@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -93,6 +94,10 @@ type (
 		tr  tarReader
 		lzr *lz4.Reader
 	}
+	zstReader struct {
+		tr  tarReader
+		zsr *zstd.Decoder
+	}
 )
 
 // interface guard
@@ -101,6 +106,7 @@ var (
 	_ Reader = (*tgzReader)(nil)
 	_ Reader = (*zipReader)(nil)
 	_ Reader = (*lz4Reader)(nil)
+	_ Reader = (*zstReader)(nil)
 )
 
 func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error) {
@@ -114,6 +120,8 @@ func NewReader(mime string, fh io.Reader, size ...int64) (ar Reader, err error)
 		ar = &zipReader{size: size[0]}
 	case ExtTarLz4:
 		ar = &lz4Reader{}
+	case ExtTarZst:
+		ar = &zstReader{}
 	default:
 		debug.Assert(false, mime)
 	}
@@ -324,6 +332,39 @@ func (lzr *lz4Reader) ReadOne(filename string) (cos.ReadCloseSizer, error) {
 	return lzr.tr.ReadOne(filename)
 }
 
+// zstReader
+
+// single-threaded streaming decoder (no background goroutines to leak when not closed)
+func newZstdReader(fh io.Reader) (*zstd.Decoder, error) {
+	return zstd.NewReader(fh, zstd.WithDecoderConcurrency(1))
+}
+
+func (zsr *zstReader) init(fh io.Reader) (err error) {
+	zsr.zsr, err = newZstdReader(fh)
+	if err != nil {
+		return
+	}
+	zsr.tr.baseR.init(zsr.zsr)
+	zsr.tr.tr = tar.NewReader(zsr.zsr)
+	return
+}
+
+func (zsr *zstReader) ReadUntil(rcb ArchRCB, regex, mmode string) error {
+	err := zsr.tr.ReadUntil(rcb, regex, mmode)
+	zsr.zsr.Close()
+	return err
+}
+
+func (zsr *zstReader) ReadOne(filename string) (cos.ReadCloseSizer, error) {
+	reader, err := zsr.tr.ReadOne(filename)
+	if err != nil || reader == nil {
+		zsr.zsr.Close()
+		return nil, err
+	}
+	// ditto (see tgzReader above)
+	return &cslClose{gzr: zsr.zsr.IOReadCloser(), R: reader, N: reader.Size()}, nil
+}
+
 //
 // more limited readers
 //
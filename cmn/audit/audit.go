@@ -0,0 +1,286 @@
+// Package audit provides structured audit trail of mutating and access-controlled API calls
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package audit
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Each AIS gateway (and AuthN) appends audit records to its own node-local log:
+// <log_dir>/audit.jsonl, rotated as audit.jsonl.1 (most recent) through audit.jsonl.<MaxFiles>.
+// Which calls get audited is determined by cmn.AuditConf.
+
+const (
+	FileName = "audit.jsonl"
+	MaxFiles = 8 // rotated, in addition to the current one
+
+	NodeAuthN = "authn" // Record.Node
+)
+
+// query defaults
+const (
+	DefaultLimit = 100
+	MaxLimit     = 10000
+)
+
+type (
+	Record struct {
+		Time     int64  `json:"time"` // unix nanoseconds
+		Node     string `json:"node"` // gateway ID or NodeAuthN
+		User     string `json:"user,omitempty"`
+		ClientIP string `json:"client_ip,omitempty"`
+		Method   string `json:"method"`
+		Path     string `json:"path"`
+		Action   string `json:"action,omitempty"` // apc.ActMsg action (or AuthN operation)
+		Bucket   string `json:"bucket,omitempty"` // bucket cname
+		Object   string `json:"object,omitempty"`
+		Prefix   string `json:"prefix,omitempty"` // multi-object operations
+		Xid      string `json:"xid,omitempty"`    // xaction (job) started by the call, if any
+		Code     int    `json:"code"`             // HTTP status
+	}
+
+	// all filters are optional; records are returned most recent first
+	Query struct {
+		User   string
+		Action string
+		Bucket string // cname
+		Since  int64  // unix nanoseconds
+		Limit  int
+	}
+
+	Log struct {
+		fh   *os.File
+		fqn  string
+		size int64
+		mu   sync.Mutex
+	}
+)
+
+// (the caller's address as seen by the server)
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+///////////
+// Query //
+///////////
+
+func (q *Query) FillFromQuery(query url.Values) (err error) {
+	q.User = query.Get(apc.QparamAuditUser)
+	q.Action = query.Get(apc.QparamAuditAction)
+	q.Bucket = query.Get(apc.QparamAuditBucket)
+	if s := query.Get(apc.QparamAuditSince); s != "" {
+		if q.Since, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return fmt.Errorf("invalid audit query: %s=%q", apc.QparamAuditSince, s)
+		}
+	}
+	if s := query.Get(apc.QparamAuditLimit); s != "" {
+		if q.Limit, err = strconv.Atoi(s); err != nil || q.Limit < 0 {
+			return fmt.Errorf("invalid audit query: %s=%q", apc.QparamAuditLimit, s)
+		}
+	}
+	return nil
+}
+
+func (q *Query) AddToQuery(query url.Values) {
+	if q.User != "" {
+		query.Set(apc.QparamAuditUser, q.User)
+	}
+	if q.Action != "" {
+		query.Set(apc.QparamAuditAction, q.Action)
+	}
+	if q.Bucket != "" {
+		query.Set(apc.QparamAuditBucket, q.Bucket)
+	}
+	if q.Since != 0 {
+		query.Set(apc.QparamAuditSince, strconv.FormatInt(q.Since, 10))
+	}
+	if q.Limit != 0 {
+		query.Set(apc.QparamAuditLimit, strconv.Itoa(q.Limit))
+	}
+}
+
+func (q *Query) Match(rec *Record) bool {
+	return (q.User == "" || rec.User == q.User) &&
+		(q.Action == "" || rec.Action == q.Action) &&
+		(q.Bucket == "" || rec.Bucket == q.Bucket) &&
+		rec.Time >= q.Since
+}
+
+func (q *Query) NumRecords() int {
+	switch {
+	case q.Limit == 0:
+		return DefaultLimit
+	case q.Limit > MaxLimit:
+		return MaxLimit
+	default:
+		return q.Limit
+	}
+}
+
+/////////
+// Log //
+/////////
+
+func NewLog(dir string) *Log {
+	return &Log{fqn: filepath.Join(dir, FileName)}
+}
+
+func (l *Log) Add(rec *Record, maxSize int64) {
+	line := cos.MustMarshal(rec)
+	l.mu.Lock()
+	if err := l.append(line, maxSize); err != nil {
+		nlog.Errorln("failed to write audit record:", err)
+	}
+	l.mu.Unlock()
+}
+
+// under lock
+func (l *Log) append(line []byte, maxSize int64) error {
+	if l.fh == nil {
+		if err := l.open(); err != nil {
+			return err
+		}
+	}
+	if l.size+int64(len(line))+1 > maxSize && l.size > 0 {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.fh.Write(append(line, '\n'))
+	l.size += int64(n)
+	return err
+}
+
+func (l *Log) open() error {
+	if err := cos.CreateDir(filepath.Dir(l.fqn)); err != nil {
+		return err
+	}
+	fh, err := os.OpenFile(l.fqn, os.O_WRONLY|os.O_CREATE|os.O_APPEND, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		fh.Close()
+		return err
+	}
+	l.fh, l.size = fh, finfo.Size()
+	return nil
+}
+
+// under lock
+func (l *Log) rotate() error {
+	l._close()
+	for i := MaxFiles - 1; i > 0; i-- {
+		src := l.rotated(i)
+		if err := os.Rename(src, l.rotated(i+1)); err != nil && !os.IsNotExist(err) {
+			nlog.Errorln("failed to rotate audit log", src, "err:", err)
+		}
+	}
+	if err := os.Rename(l.fqn, l.rotated(1)); err != nil {
+		return err
+	}
+	return l.open()
+}
+
+func (l *Log) rotated(i int) string { return l.fqn + "." + strconv.Itoa(i) }
+
+func (l *Log) Close() {
+	l.mu.Lock()
+	l._close()
+	l.mu.Unlock()
+}
+
+func (l *Log) _close() {
+	if l.fh == nil {
+		return
+	}
+	if err := l.fh.Close(); err != nil {
+		nlog.Errorln("failed to close audit log", l.fqn, "err:", err)
+	}
+	l.fh = nil
+}
+
+// Returns matching records, most recent first, from the current and rotated logs.
+// Reading is done without locking: concurrent rotation may, at worst, cause
+// some of the records to be skipped (or returned twice).
+func (l *Log) Query(q *Query) ([]*Record, error) {
+	var (
+		limit = q.NumRecords()
+		out   = make([]*Record, 0, min(limit, DefaultLimit))
+	)
+	for i := 0; i <= MaxFiles && len(out) < limit; i++ {
+		fqn := l.fqn
+		if i > 0 {
+			fqn = l.rotated(i)
+		}
+		recs, oldest, err := scan(fqn, q, limit-len(out))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return out, err
+		}
+		// within a given file, records are ordered by time
+		for j := len(recs) - 1; j >= 0; j-- {
+			out = append(out, recs[j])
+		}
+		if oldest != 0 && oldest < q.Since {
+			break // older files won't have anything
+		}
+	}
+	return out, nil
+}
+
+// returns up to `limit` most recent matching records, and the time of the oldest record in the file
+func scan(fqn string, q *Query, limit int) (recs []*Record, oldest int64, _ error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer fh.Close()
+
+	scanner := bufio.NewScanner(fh)
+	scanner.Buffer(make([]byte, 0, 4*cos.KiB), cos.MiB)
+	for scanner.Scan() {
+		rec := &Record{}
+		if err := jsoniter.Unmarshal(scanner.Bytes(), rec); err != nil {
+			continue // (e.g., partially written)
+		}
+		if oldest == 0 {
+			oldest = rec.Time
+		}
+		if !q.Match(rec) {
+			continue
+		}
+		recs = append(recs, rec)
+		if len(recs) >= 2*limit {
+			recs = append(recs[:0], recs[len(recs)-limit:]...)
+		}
+	}
+	if len(recs) > limit {
+		recs = recs[len(recs)-limit:]
+	}
+	return recs, oldest, scanner.Err()
+}
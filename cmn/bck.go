@@ -417,6 +417,15 @@ func (b *Bck) IsAIS() bool {
 func (b *Bck) IsRemoteAIS() bool { return b.Provider == apc.AIS && b.Ns.IsRemote() }
 func (b *Bck) IsHT() bool        { return b.Provider == apc.HT }
 
+// file:// bucket or ais:// bucket with file:// backend
+func (b *Bck) IsFile() bool {
+	if b.Provider == apc.File {
+		return true
+	}
+	backend := b.Backend()
+	return backend != nil && backend.Provider == apc.File
+}
+
 func (b *Bck) IsRemote() bool {
 	return apc.IsRemoteProvider(b.Provider) || b.IsRemoteAIS() || b.Backend() != nil
 }
@@ -426,7 +435,7 @@ func (b *Bck) IsRemote() bool {
 //
 
 func (b *Bck) IsBuiltTagged() bool {
-	return b.IsCloud() || b.Provider == apc.HT
+	return b.IsCloud() || b.Provider == apc.HT || b.Provider == apc.File
 }
 
 func (b *Bck) IsCloud() bool {
@@ -443,7 +452,7 @@ func (b *Bck) IsCloud() bool {
 // A subset of remote backends that maintain assorted items of versioning information -
 // the items including ETag, checksum, etc. - that, in turn, can be used to populate `ObjAttrs`
 // * see related: `ObjAttrs.Equal`
-func (b *Bck) HasVersioningMD() bool { return b.IsCloud() || b.IsRemoteAIS() }
+func (b *Bck) HasVersioningMD() bool { return b.IsCloud() || b.IsRemoteAIS() || b.IsFile() }
 
 func (b *Bck) HasProvider() bool { return b.Provider != "" }
 
@@ -528,6 +537,7 @@ func (qbck *QueryBcks) IsAIS() bool       { b := (*Bck)(qbck); return b.IsAIS()
 func (qbck *QueryBcks) IsHT() bool        { b := (*Bck)(qbck); return b.IsHT() }
 func (qbck *QueryBcks) IsRemoteAIS() bool { b := (*Bck)(qbck); return b.IsRemoteAIS() }
 func (qbck *QueryBcks) IsCloud() bool     { return apc.IsCloudProvider(qbck.Provider) }
+func (qbck *QueryBcks) IsFile() bool      { return qbck.Provider == apc.File }
 
 func (qbck *QueryBcks) IsEmpty() bool { b := (*Bck)(qbck); return b.IsEmpty() }
 
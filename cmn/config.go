@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/http"
 	"net/url"
 	"os"
 	"path"
@@ -63,6 +64,8 @@ type (
 		LogDir    string         `json:"log_dir"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
 		HostNet   LocalNetConfig `json:"host_net"`
+		// target's failure domain (zone, rack) - see core/meta/fdomain.go and env.AisFailureDomain
+		FailureDomain string `json:"failure_domain,omitempty"`
 	}
 
 	// ais node: (local) network config
@@ -121,6 +124,7 @@ type (
 		Mirror      MirrorConf      `json:"mirror" allow:"cluster"`
 		Downloader  DownloaderConf  `json:"downloader"`
 		RateLimit   RateLimitConf   `json:"rate_limit"`
+		Audit       AuditConf       `json:"audit"`
 
 		// standalone enumerated features that can be configured
 		// to flip assorted global defaults (see cmn/feat/feat.go)
@@ -159,6 +163,7 @@ type (
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Proxy       *ProxyConfToSet       `json:"proxy,omitempty"`
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit"`
+		Audit       *AuditConfToSet       `json:"audit,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 
 		// LocalConfig
@@ -169,7 +174,10 @@ type (
 		Conf      map[string]any `json:"-"` // backend implementation-dependent (custom marshaling to populate this field)
 		Providers map[string]Ns  `json:"-"` // conditional (build tag) providers set during validation (BackendConf.Validate)
 	}
-	BackendConfAIS map[string][]string // cluster alias -> [urls...]
+	BackendConfAIS  map[string][]string // cluster alias -> [urls...]
+	BackendConfFile struct {
+		Root string `json:"root"` // absolute path; each subdirectory is a file:// bucket
+	}
 
 	MirrorConf struct {
 		Copies  int64 `json:"copies"`       // num copies
@@ -212,17 +220,32 @@ type (
 
 		SbundleMult int `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
 
+		// Optional size-tiered (D, P) profiles; when specified, objects that are sliced
+		// (i.e., not replicated - see `ObjSizeLimit`) are erasure coded using the profile
+		// with the largest `MinSize` that does not exceed the object size; objects smaller
+		// than the smallest `MinSize` are coded with the (`DataSlices`, `ParitySlices`) above.
+		// Changing profiles (or D, P) of a populated bucket takes effect for new writes;
+		// to convert existing objects, run `ec-reencode` (see ec/reencode.go).
+		Profiles []ECProfile `json:"profiles,omitempty" list:"readonly"` // (JSON-only via API or CLI)
+
 		Enabled  bool `json:"enabled"`   // EC is enabled
 		DiskOnly bool `json:"disk_only"` // if true, EC does not use SGL - data goes directly to drives
 	}
+	ECProfile struct {
+		Name         string `json:"name"`
+		MinSize      int64  `json:"min_size"` // applies to objects of this size and larger
+		DataSlices   int    `json:"data_slices"`
+		ParitySlices int    `json:"parity_slices"`
+	}
 	ECConfToSet struct {
-		ObjSizeLimit *int64  `json:"objsize_limit,omitempty"`
-		Compression  *string `json:"compression,omitempty"`
-		SbundleMult  *int    `json:"bundle_multiplier,omitempty"`
-		DataSlices   *int    `json:"data_slices,omitempty"`
-		ParitySlices *int    `json:"parity_slices,omitempty"`
-		Enabled      *bool   `json:"enabled,omitempty"`
-		DiskOnly     *bool   `json:"disk_only,omitempty"`
+		ObjSizeLimit *int64       `json:"objsize_limit,omitempty"`
+		Compression  *string      `json:"compression,omitempty"`
+		SbundleMult  *int         `json:"bundle_multiplier,omitempty"`
+		DataSlices   *int         `json:"data_slices,omitempty"`
+		ParitySlices *int         `json:"parity_slices,omitempty"`
+		Profiles     *[]ECProfile `json:"profiles,omitempty"`
+		Enabled      *bool        `json:"enabled,omitempty"`
+		DiskOnly     *bool        `json:"disk_only,omitempty"`
 	}
 
 	LogConf struct {
@@ -469,11 +492,18 @@ type (
 		// - deleting in-cluster object if its remote ("cached") counterpart does not exist
 		// See also: apc.QparamSync, apc.CopyBckMsg
 		Sync bool `json:"synchronize"`
+
+		// Maximum number of noncurrent (prior) versions to retain when overwriting objects
+		// in ais:// buckets; zero (default) - do not retain.
+		// Noncurrent versions can be listed (S3 ListObjectVersions), read, and deleted by version ID
+		// (see apc.QparamVersionID)
+		MaxVersions int `json:"max_versions"`
 	}
 	VersionConfToSet struct {
 		Enabled         *bool `json:"enabled,omitempty"`
 		ValidateWarmGet *bool `json:"validate_warm_get,omitempty"`
 		Sync            *bool `json:"synchronize,omitempty"`
+		MaxVersions     *int  `json:"max_versions,omitempty"`
 	}
 
 	NetConf struct {
@@ -553,11 +583,15 @@ type (
 	}
 
 	AuthConf struct {
-		Secret  string `json:"secret"`
+		Secret string `json:"secret"`
+		// public keys to verify RS256/ES256 signed tokens: AuthN JWKS URL (e.g., http://authn:52001/v1/jwks)
+		// or local JWKS file; can be used with or without the secret
+		JWKS    string `json:"jwks,omitempty"`
 		Enabled bool   `json:"enabled"`
 	}
 	AuthConfToSet struct {
 		Secret  *string `json:"secret,omitempty"`
+		JWKS    *string `json:"jwks,omitempty"`
 		Enabled *bool   `json:"enabled,omitempty"`
 	}
 
@@ -669,9 +703,27 @@ type (
 		MD   apc.WritePolicy `json:"md"`
 	}
 	WritePolicyConfToSet struct {
-		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
+		Data *apc.WritePolicy `json:"data,omitempty"`
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
+
+	// audit trail of mutating and access-controlled API calls (see cmn/audit)
+	AuditConf struct {
+		// optional space-separated list of actions (apc.Act*) and/or HTTP methods, e.g.:
+		// - "DELETE"
+		// - "destroy-bck evict-remote-bck set-bprops"
+		// empty (default) means: all mutating calls and, if enabled, reads
+		Actions string      `json:"actions"`
+		MaxSize cos.SizeIEC `json:"max_size"` // rotate audit log upon reaching this size
+		Reads   bool        `json:"reads"`    // in addition, audit reads (GET and HEAD)
+		Enabled bool        `json:"enabled"`
+	}
+	AuditConfToSet struct {
+		Actions *string      `json:"actions,omitempty"`
+		MaxSize *cos.SizeIEC `json:"max_size,omitempty"`
+		Reads   *bool        `json:"reads,omitempty"`
+		Enabled *bool        `json:"enabled,omitempty"`
+	}
 )
 
 // global config that can be used to manage:
@@ -783,6 +835,7 @@ var (
 	_ Validator = (*TCBConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
 	_ Validator = (*TracingConf)(nil)
+	_ Validator = (*AuditConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
@@ -1043,6 +1096,16 @@ func (c *BackendConf) Validate() (err error) {
 				}
 			}
 			c.Conf[provider] = aisConf
+		case apc.File:
+			var fileConf BackendConfFile
+			if err := jsoniter.Unmarshal(b, &fileConf); err != nil {
+				return fmt.Errorf("invalid file backend specification: %v", err)
+			}
+			if err := fileConf.Validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = fileConf
+			c.setProvider(provider)
 		case "":
 			continue
 		default:
@@ -1055,7 +1118,7 @@ func (c *BackendConf) Validate() (err error) {
 func (c *BackendConf) setProvider(provider string) {
 	var ns Ns
 	switch provider {
-	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HT:
+	case apc.AWS, apc.Azure, apc.GCP, apc.OCI, apc.HT, apc.File:
 		ns = NsGlobal
 	default:
 		debug.Assert(false, "unknown backend provider "+provider)
@@ -1105,6 +1168,17 @@ func (c *BackendConf) EqualRemAIS(o *BackendConf, sname string) bool {
 	return true
 }
 
+func (c *BackendConfFile) Validate() error {
+	if c.Root == "" {
+		return errors.New("file backend: root directory must be specified")
+	}
+	if !filepath.IsAbs(c.Root) {
+		return fmt.Errorf("file backend: root %q must be an absolute path", c.Root)
+	}
+	c.Root = filepath.Clean(c.Root)
+	return nil
+}
+
 func (c BackendConfAIS) String() (s string) {
 	for a, urls := range c {
 		if s != "" {
@@ -1213,10 +1287,18 @@ func (c *CksumConf) String() string {
 // VersionConf //
 /////////////////
 
+const MaxVersionsLimit = 1000 // upper bound for versioning.max_versions
+
 func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	if c.MaxVersions < 0 || c.MaxVersions > MaxVersionsLimit {
+		return fmt.Errorf("invalid versioning.max_versions %d (expecting range [0, %d])", c.MaxVersions, MaxVersionsLimit)
+	}
+	if !c.Enabled && c.MaxVersions > 0 {
+		return errors.New("versioning.max_versions requires versioning to be enabled")
+	}
 	return nil
 }
 
@@ -1231,6 +1313,9 @@ func (c *VersionConf) String() string {
 	} else {
 		text += "no"
 	}
+	if c.MaxVersions > 0 {
+		text += " | Max versions: " + strconv.Itoa(c.MaxVersions)
+	}
 
 	return text
 }
@@ -1273,6 +1358,8 @@ const (
 
 	MinSliceCount = 1  // minimum number of data or parity slices
 	MaxSliceCount = 32 // maximum --/--
+
+	MaxECProfiles = 8 // maximum number of size-tiered EC profiles per bucket
 )
 
 func (c *ECConf) Validate() error {
@@ -1294,9 +1381,59 @@ func (c *ECConf) Validate() error {
 	if !apc.IsValidCompression(c.Compression) {
 		return fmt.Errorf("invalid ec.compression: %q (expecting one of: %v)", c.Compression, apc.SupportedCompression)
 	}
+	return c.validateProfiles()
+}
+
+func (c *ECConf) validateProfiles() error {
+	if len(c.Profiles) > MaxECProfiles {
+		return fmt.Errorf("too many ec.profiles: %d (max %d)", len(c.Profiles), MaxECProfiles)
+	}
+	names := make(cos.StrSet, len(c.Profiles))
+	for i := range c.Profiles {
+		p := &c.Profiles[i]
+		if p.Name == "" {
+			return fmt.Errorf("ec.profiles[%d]: missing name", i)
+		}
+		if err := cos.CheckAlphaPlus(p.Name, "ec profile name"); err != nil {
+			return err
+		}
+		if names.Contains(p.Name) {
+			return fmt.Errorf("ec.profiles: duplicate name %q", p.Name)
+		}
+		names.Set(p.Name)
+		if p.MinSize <= 0 {
+			return fmt.Errorf("ec.profiles[%s]: invalid min_size %d (expecting positive integer)", p.Name, p.MinSize)
+		}
+		if i > 0 && p.MinSize <= c.Profiles[i-1].MinSize {
+			return fmt.Errorf("ec.profiles[%s]: min_size must be strictly ascending (%d <= %d)",
+				p.Name, p.MinSize, c.Profiles[i-1].MinSize)
+		}
+		if p.DataSlices < MinSliceCount || p.DataSlices > MaxSliceCount {
+			return fmt.Errorf("ec.profiles[%s]: invalid data_slices %d (expected value in range [%d, %d])",
+				p.Name, p.DataSlices, MinSliceCount, MaxSliceCount)
+		}
+		if p.ParitySlices < MinSliceCount || p.ParitySlices > MaxSliceCount {
+			return fmt.Errorf("ec.profiles[%s]: invalid parity_slices %d (expected value in range [%d, %d])",
+				p.Name, p.ParitySlices, MinSliceCount, MaxSliceCount)
+		}
+	}
 	return nil
 }
 
+// Slices returns (D, P) and the name of the profile to erasure code an object of a given size
+// (empty name when not using any of the size-tiered profiles)
+func (c *ECConf) Slices(size int64) (data, parity int, profile string) {
+	data, parity = c.DataSlices, c.ParitySlices
+	for i := range c.Profiles {
+		p := &c.Profiles[i]
+		if size < p.MinSize {
+			break
+		}
+		data, parity, profile = p.DataSlices, p.ParitySlices, p.Name
+	}
+	return data, parity, profile
+}
+
 func (c *ECConf) ValidateAsProps(arg ...any) (err error) {
 	if !c.Enabled {
 		return
@@ -1306,7 +1443,7 @@ func (c *ECConf) ValidateAsProps(arg ...any) (err error) {
 	}
 	targetCnt, ok := arg[0].(int)
 	debug.Assert(ok)
-	required := c.numRequiredTargets()
+	required := c.RequiredTargets()
 	if required <= targetCnt {
 		return
 	}
@@ -1327,19 +1464,34 @@ func (c *ECConf) String() string {
 	if objSizeLimit == ObjSizeToAlwaysReplicate {
 		return fmt.Sprintf("no EC - always producing %d total replicas", c.ParitySlices+1)
 	}
-	return fmt.Sprintf("%d:%d (objsize limit %s)", c.DataSlices, c.ParitySlices, cos.ToSizeIEC(objSizeLimit, 0))
+	s := fmt.Sprintf("%d:%d (objsize limit %s)", c.DataSlices, c.ParitySlices, cos.ToSizeIEC(objSizeLimit, 0))
+	for i := range c.Profiles {
+		p := &c.Profiles[i]
+		s += fmt.Sprintf(", %s: %d:%d (>= %s)", p.Name, p.DataSlices, p.ParitySlices, cos.ToSizeIEC(p.MinSize, 0))
+	}
+	return s
 }
 
-func (c *ECConf) numRequiredTargets() int {
+// number of targets (or, when labeled, distinct failure domains) required to store all slices or replicas
+func (c *ECConf) RequiredTargets() int {
 	if c.ObjSizeLimit == ObjSizeToAlwaysReplicate {
 		return c.ParitySlices + 1
 	}
 	// (data slices + parity slices + 1 target for the _main_ replica)
-	return c.DataSlices + c.ParitySlices + 1
+	n := c.DataSlices + c.ParitySlices + 1
+	for i := range c.Profiles {
+		n = max(n, c.Profiles[i].DataSlices+c.Profiles[i].ParitySlices+1)
+	}
+	return n
 }
 
+// (the minimum across profiles - the actual number is recorded in each object's metafile)
 func (c *ECConf) RequiredRestoreTargets() int {
-	return c.DataSlices
+	n := c.DataSlices
+	for i := range c.Profiles {
+		n = min(n, c.Profiles[i].DataSlices)
+	}
+	return n
 }
 
 /////////////////////
@@ -1349,7 +1501,7 @@ func (c *ECConf) RequiredRestoreTargets() int {
 func (c *WritePolicyConf) Validate() (err error) {
 	err = c.Data.Validate()
 	if err == nil {
-		if !c.Data.IsImmediate() {
+		if c.Data == apc.WriteNever {
 			return fmt.Errorf("invalid write policy for data: %q not implemented yet", c.Data)
 		}
 		err = c.MD.Validate()
@@ -1918,6 +2070,44 @@ func (tac TraceExporterAuthConf) IsEnabled() bool {
 	return tac.TokenFile != "" && tac.TokenHeader != ""
 }
 
+///////////////
+// AuditConf //
+///////////////
+
+const (
+	dfltAuditMaxSize = 64 * cos.MiB
+	minAuditMaxSize  = cos.MiB
+)
+
+func (c *AuditConf) Validate() error {
+	if c.MaxSize == 0 {
+		c.MaxSize = dfltAuditMaxSize
+	}
+	if c.MaxSize < minAuditMaxSize {
+		return fmt.Errorf("invalid audit.max_size %s (expecting >= %s)", c.MaxSize, cos.SizeIEC(minAuditMaxSize))
+	}
+	return nil
+}
+
+// whether a given (completed) API call must be audited:
+// - access denials - always
+// - when configured, only the listed actions and methods (including reads, if listed)
+// - otherwise, all mutating calls and, optionally, reads
+func (c *AuditConf) Selected(method, action string, code int) bool {
+	if code == http.StatusUnauthorized || code == http.StatusForbidden {
+		return true
+	}
+	if c.Actions != "" {
+		for a := range strings.FieldsSeq(c.Actions) {
+			if a == action || strings.EqualFold(a, method) {
+				return true
+			}
+		}
+		return false
+	}
+	return c.Reads || (method != http.MethodGet && method != http.MethodHead)
+}
+
 ///////////////////
 // RateLimitConf: adaptive (back) and bursty (front)
 ///////////////////
@@ -0,0 +1,157 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Bucket CORS (cross-origin resource sharing) configuration:
+// - a list of rules, whereby the first rule that matches request's origin,
+//   method, and (preflight) headers determines `Access-Control-*` response headers
+// - no rules - no CORS (browsers will refuse cross-origin responses)
+// - bucket-scope (not inherited from cluster config)
+// - to set, use `api.SetBucketProps` or S3 PutBucketCors (see ais/s3/cors.go)
+
+const corsMaxRules = 100 // as per S3
+
+type (
+	CORSRule struct {
+		ID             string   `json:"id,omitempty"`
+		AllowedOrigins []string `json:"allowed_origins"`           // e.g. "https://*.example.com", or "*"
+		AllowedMethods []string `json:"allowed_methods"`           // GET, PUT, HEAD, POST, DELETE
+		AllowedHeaders []string `json:"allowed_headers,omitempty"` // preflight Access-Control-Request-Headers
+		ExposeHeaders  []string `json:"expose_headers,omitempty"`
+		MaxAgeSeconds  int      `json:"max_age_seconds,omitempty"`
+	}
+	CORSConf struct {
+		Rules []CORSRule `json:"rules,omitempty" list:"readonly"` // (JSON-only: via API or CLI '{"cors": {...}}')
+	}
+	CORSConfToSet struct {
+		Rules *[]CORSRule `json:"rules,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*CORSConf)(nil)
+
+func (c *CORSConf) IsActive() bool { return len(c.Rules) > 0 }
+
+func (c *CORSConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > corsMaxRules {
+		return fmt.Errorf("cors: too many rules (%d > %d)", len(c.Rules), corsMaxRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rule *CORSRule) validate(idx int) error {
+	tag := rule.ID
+	if tag == "" {
+		tag = fmt.Sprintf("#%d", idx+1)
+	}
+	if len(rule.AllowedOrigins) == 0 {
+		return fmt.Errorf("cors rule %s: allowed origins cannot be empty", tag)
+	}
+	if len(rule.AllowedMethods) == 0 {
+		return fmt.Errorf("cors rule %s: allowed methods cannot be empty", tag)
+	}
+	for _, o := range rule.AllowedOrigins {
+		if strings.Count(o, "*") > 1 {
+			return fmt.Errorf("cors rule %s: origin %q can contain at most one wildcard", tag, o)
+		}
+	}
+	for i, m := range rule.AllowedMethods {
+		m = strings.ToUpper(m)
+		switch m {
+		case http.MethodGet, http.MethodPut, http.MethodHead, http.MethodPost, http.MethodDelete:
+			rule.AllowedMethods[i] = m
+		default:
+			return fmt.Errorf("cors rule %s: unsupported method %q", tag, m)
+		}
+	}
+	for _, h := range rule.AllowedHeaders {
+		if strings.Count(h, "*") > 1 {
+			return fmt.Errorf("cors rule %s: header %q can contain at most one wildcard", tag, h)
+		}
+	}
+	if rule.MaxAgeSeconds < 0 {
+		return errors.New("cors rule " + tag + ": negative max-age")
+	}
+	return nil
+}
+
+// returns the first rule that allows given origin, method, and (preflight only) request headers
+func (c *CORSConf) Match(origin, method string, reqHeaders []string) *CORSRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.match(origin, method, reqHeaders) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (rule *CORSRule) match(origin, method string, reqHeaders []string) bool {
+	var ok bool
+	for _, o := range rule.AllowedOrigins {
+		if ok = wildcardMatch(o, origin, false); ok {
+			break
+		}
+	}
+	if !ok {
+		return false
+	}
+	ok = false
+	for _, m := range rule.AllowedMethods {
+		if ok = m == method; ok {
+			break
+		}
+	}
+	if !ok {
+		return false
+	}
+outer:
+	for _, h := range reqHeaders {
+		for _, a := range rule.AllowedHeaders {
+			if wildcardMatch(a, h, true) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// true if the rule allows any origin (in which case `Access-Control-Allow-Origin: *`)
+func (rule *CORSRule) AnyOrigin() bool {
+	for _, o := range rule.AllowedOrigins {
+		if o == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// "*" matches all; otherwise, at most one '*' matching any (possibly empty) substring
+func wildcardMatch(pattern, s string, caseInsensitive bool) bool {
+	if caseInsensitive {
+		pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+	}
+	i := strings.IndexByte(pattern, '*')
+	if i < 0 {
+		return pattern == s
+	}
+	prefix, suffix := pattern[:i], pattern[i+1:]
+	return len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
@@ -7,6 +7,7 @@ package cos
 import (
 	"crypto/md5" //nolint:gosec // G501 have to support Cloud's MD5
 	"crypto/sha256"
+	"crypto/sha3"
 	"crypto/sha512"
 	"encoding"
 	"encoding/hex"
@@ -19,13 +20,12 @@ import (
 	onexxh "github.com/OneOfOne/xxhash"
 	cesxxh "github.com/cespare/xxhash/v2"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/zeebo/blake3"
 )
 
 // [NOTE]
-// - currently, we have only two crypto-secure types: sha256 and sha512 (SHA-2 family)
+// - crypto-secure types: sha256 and sha512 (SHA-2 family), sha3-256 (SHA-3), and blake3
 // - see related object comparison logic in cmn/objattrs
-// - now that SHA-3 is in the standard library, it can be easily added (as in: ck.H = sha3.New512())
-//   not adding it yet, though, as there's no pressing need
 
 // supported checksums
 const (
@@ -34,8 +34,10 @@ const (
 	ChecksumCesXxh = "xxhash2"
 	ChecksumMD5    = "md5"
 	ChecksumCRC32C = "crc32c"
-	ChecksumSHA256 = "sha256" // crypto.SHA512_256 (SHA-2)
-	ChecksumSHA512 = "sha512" // crypto.SHA512 (SHA-2)
+	ChecksumSHA256 = "sha256"   // crypto.SHA512_256 (SHA-2)
+	ChecksumSHA512 = "sha512"   // crypto.SHA512 (SHA-2)
+	ChecksumSHA3   = "sha3-256" // crypto.SHA3_256 (SHA-3)
+	ChecksumBLAKE3 = "blake3"   // BLAKE3 (256-bit output)
 )
 
 const (
@@ -75,6 +77,8 @@ var checksums = StrSet{
 	ChecksumCRC32C: {},
 	ChecksumSHA256: {},
 	ChecksumSHA512: {},
+	ChecksumSHA3:   {},
+	ChecksumBLAKE3: {},
 }
 
 // interface guard
@@ -134,6 +138,10 @@ func (ck *CksumHash) Init(ty string) {
 		ck.H = sha256.New()
 	case ChecksumSHA512:
 		ck.H = sha512.New()
+	case ChecksumSHA3:
+		ck.H = sha3.New256()
+	case ChecksumBLAKE3:
+		ck.H = blake3.New()
 	default:
 		AssertMsg(false, "unknown checksum type: "+ty)
 	}
@@ -232,6 +240,16 @@ func NewCRC32C() hash.Hash {
 	return crc32.New(crc32.MakeTable(crc32.Castagnoli))
 }
 
+// cryptographically secure (see also: feat.TrustCryptoSafeChecksums)
+func IsCryptoSafeCksum(ty string) bool {
+	switch ty {
+	case ChecksumSHA256, ChecksumSHA512, ChecksumSHA3, ChecksumBLAKE3:
+		return true
+	default:
+		return false
+	}
+}
+
 func SupportedChecksums() (types []string) {
 	types = make([]string, 0, len(checksums))
 	for ty := range checksums {
@@ -0,0 +1,33 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checksum", func() {
+	DescribeTable("compute known checksums",
+		func(ty, expected string) {
+			Expect(cos.ValidateCksumType(ty)).To(Succeed())
+			Expect(cos.ChecksumB2S([]byte("abc"), ty)).To(Equal(expected))
+		},
+		Entry(cos.ChecksumSHA256, cos.ChecksumSHA256, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+		Entry(cos.ChecksumSHA3, cos.ChecksumSHA3, "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"),
+		Entry(cos.ChecksumBLAKE3, cos.ChecksumBLAKE3, "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85"),
+	)
+
+	It("should classify crypto-safe checksums", func() {
+		for _, ty := range []string{cos.ChecksumSHA256, cos.ChecksumSHA512, cos.ChecksumSHA3, cos.ChecksumBLAKE3} {
+			Expect(cos.IsCryptoSafeCksum(ty)).To(BeTrue(), ty)
+		}
+		for _, ty := range []string{cos.ChecksumNone, cos.ChecksumOneXxh, cos.ChecksumCesXxh, cos.ChecksumMD5, cos.ChecksumCRC32C} {
+			Expect(cos.IsCryptoSafeCksum(ty)).To(BeFalse(), ty)
+		}
+	})
+})
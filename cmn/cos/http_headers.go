@@ -44,6 +44,23 @@ const (
 	HdrHSTS = "Strict-Transport-Security"
 
 	HdrLastModified = "Last-Modified" // RFC1123GMT or, same, http.TimeFormat ("Mon, 02 Jan 2006 15:04:05 GMT")
+
+	// conditional requests, Ref: https://www.rfc-editor.org/rfc/rfc9110#section-13.1
+	HdrIfMatch           = "If-Match"
+	HdrIfNoneMatch       = "If-None-Match"
+	HdrIfModifiedSince   = "If-Modified-Since"
+	HdrIfUnmodifiedSince = "If-Unmodified-Since"
+
+	// CORS, Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS
+	HdrOrigin           = "Origin"
+	HdrVary             = "Vary"
+	HdrACRequestMethod  = "Access-Control-Request-Method"
+	HdrACRequestHeaders = "Access-Control-Request-Headers"
+	HdrACAllowOrigin    = "Access-Control-Allow-Origin"
+	HdrACAllowMethods   = "Access-Control-Allow-Methods"
+	HdrACAllowHeaders   = "Access-Control-Allow-Headers"
+	HdrACExposeHeaders  = "Access-Control-Expose-Headers"
+	HdrACMaxAge         = "Access-Control-Max-Age"
 )
 
 //
@@ -63,7 +80,11 @@ const (
 	S3VersionHeader = "x-amz-version-id"
 
 	// s3 api request headers
-	S3HdrObjSrc = "x-amz-copy-source"
+	S3HdrObjSrc     = "x-amz-copy-source"
+	S3HdrObjTagging = "x-amz-tagging" // URL-encoded, e.g. "k1=v1&k2=v2"
+
+	// s3 api response headers
+	S3HdrTaggingCount = "x-amz-tagging-count"
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
 	S3UnsignedPayload  = "UNSIGNED-PAYLOAD"
@@ -247,6 +247,12 @@ type (
 		size   int64    // [0, size)
 	}
 
+	ErrPrecondition struct {
+		hdr         string // failed conditional header, e.g. "If-Match"
+		cname       string
+		notModified bool // GET: 304 (not modified) vs 412 (precondition failed)
+	}
+
 	ErrTooManyRequests struct {
 		err    error
 		status int // not (yet) used
@@ -272,6 +278,7 @@ var (
 	ErrXactRenewAbort   = errors.New("renewal abort")
 	ErrXactUserAbort    = errors.New("user abort")              // via apc.ActXactStop
 	ErrXactICNotifAbort = errors.New("IC(notifications) abort") // ditto
+	ErrXactSuspended    = errors.New("suspended")               // resumable xaction: to resume later (see xact/xckpt)
 )
 
 // ErrFailedTo
@@ -1007,6 +1014,38 @@ func IsErrRangeNotSatisfiable(err error) bool {
 	return ok
 }
 
+// ErrPrecondition
+// http.StatusPreconditionFailed = 412 // RFC 9110, 15.5.13
+// http.StatusNotModified = 304        // RFC 9110, 15.4.5
+
+func NewErrPrecondition(hdr, cname string, notModified bool) *ErrPrecondition {
+	return &ErrPrecondition{hdr, cname, notModified}
+}
+
+func (e *ErrPrecondition) Error() string {
+	if e.notModified {
+		return e.cname + ": not modified (" + e.hdr + ")"
+	}
+	return e.cname + ": precondition failed (" + e.hdr + ")"
+}
+
+func (e *ErrPrecondition) Status() int {
+	if e.notModified {
+		return http.StatusNotModified
+	}
+	return http.StatusPreconditionFailed
+}
+
+func IsErrPrecondition(err error) bool {
+	_, ok := err.(*ErrPrecondition)
+	return ok
+}
+
+func IsErrNotModified(err error) bool {
+	e, ok := err.(*ErrPrecondition)
+	return ok && e.notModified
+}
+
 // ErrTooManyRequests (429, 503)
 
 func NewErrTooManyRequests(err error, status int) *ErrTooManyRequests {
@@ -0,0 +1,113 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket (object) event notifications: a list of rules, each selecting event types
+// and objects (by name prefix and/or suffix), and specifying where to deliver:
+// - HTTP(S) webhook (POST, one JSON-encoded event per request, with retries)
+// - target-local rotating JSONL log
+// See ext/evnotif for delivery, and ais/s3/notification.go for S3 `?notification` mapping.
+//
+// Event notifications is a bucket-scope property - not inherited from cluster config.
+
+// event types
+const (
+	EvObjCreated = "created"  // PUT, APPEND, promote, archive, copy, transform
+	EvObjDeleted = "deleted"  // DELETE
+	EvObjRenamed = "renamed"  // rename (the event carries both names)
+	EvObjEvicted = "evicted"  // remote buckets only: in-cluster replica removed
+	EvObjColdGet = "cold-get" // remote buckets only: object fetched from remote backend and stored in-cluster
+)
+
+const evMaxRules = 100 // as per S3 (configurations per bucket)
+
+var EvObjAll = []string{EvObjCreated, EvObjDeleted, EvObjRenamed, EvObjEvicted, EvObjColdGet}
+
+type (
+	EventRule struct {
+		ID      string   `json:"id"`
+		Events  []string `json:"events"` // one or more of the EvObj* enum (above)
+		Prefix  string   `json:"prefix,omitempty"`
+		Suffix  string   `json:"suffix,omitempty"`
+		Webhook string   `json:"webhook,omitempty"` // HTTP(S) endpoint
+		Log     bool     `json:"log,omitempty"`     // append to the target's local event log
+	}
+	EventsConf struct {
+		Rules   []EventRule `json:"rules,omitempty" list:"readonly"` // (JSON-only: via API or CLI '{"events": {...}}')
+		Enabled bool        `json:"enabled"`
+	}
+	EventsConfToSet struct {
+		Rules   *[]EventRule `json:"rules,omitempty"`
+		Enabled *bool        `json:"enabled,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*EventsConf)(nil)
+
+func (c *EventsConf) IsActive() bool { return c.Enabled && len(c.Rules) > 0 }
+
+func (c *EventsConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > evMaxRules {
+		return fmt.Errorf("events: too many rules (%d > %d)", len(c.Rules), evMaxRules)
+	}
+	ids := make(cos.StrSet, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if ids.Contains(rule.ID) {
+			return fmt.Errorf("events: duplicate rule ID %q", rule.ID)
+		}
+		ids.Add(rule.ID)
+	}
+	if c.Enabled && len(c.Rules) == 0 {
+		return errors.New("events: cannot enable event notifications with no rules")
+	}
+	return nil
+}
+
+func (rule *EventRule) validate() error {
+	if rule.ID == "" {
+		return errors.New("events: rule ID cannot be empty")
+	}
+	if len(rule.Events) == 0 {
+		return fmt.Errorf("events rule %q: no event types (expecting one or more of %v)", rule.ID, EvObjAll)
+	}
+	for _, ev := range rule.Events {
+		if !cos.StringInSlice(ev, EvObjAll) {
+			return fmt.Errorf("events rule %q: invalid event type %q (expecting one of %v)", rule.ID, ev, EvObjAll)
+		}
+	}
+	if rule.Webhook == "" && !rule.Log {
+		return fmt.Errorf("events rule %q: no destination (expecting webhook and/or log)", rule.ID)
+	}
+	if rule.Webhook != "" {
+		u, err := url.Parse(rule.Webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("events rule %q: invalid webhook URL %q", rule.ID, rule.Webhook)
+		}
+	}
+	return nil
+}
+
+// returns true if the rule selects a given event
+func (rule *EventRule) Match(ev, objName string) bool {
+	if !cos.StringInSlice(ev, rule.Events) {
+		return false
+	}
+	return strings.HasPrefix(objName, rule.Prefix) && strings.HasSuffix(objName, rule.Suffix)
+}
@@ -43,6 +43,8 @@ const (
 	TrustCryptoSafeChecksums  // when checking whether objects are identical trust only cryptographically secure checksums
 	S3ListObjectVersions      // when versioning info is requested, use ListObjectVersions API (beware: extremely slow, versioned S3 buckets only)
 	EnableDetailedPromMetrics // include (bucket, xaction) Prometheus variable labels with every GET and PUT transaction
+	LocalETL                  // when not running in Kubernetes: run ETL transformers as local processes (one per target)
+	ListIndex                 // maintain persistent per-bucket index of in-cluster object names to serve list-objects (see apc.LsIndexed)
 )
 
 var Cluster = [...]string{
@@ -66,6 +68,8 @@ var Cluster = [...]string{
 	"Trust-Crypto-Safe-Checksums",
 	"S3-ListObjectVersions",
 	"Enable-Detailed-Prom-Metrics",
+	"Local-ETL",
+	"Enable-List-Index",
 
 	// "none" ====================
 }
@@ -78,6 +82,7 @@ var Bucket = [...]string{
 	"Streaming-Cold-GET",
 	"S3-Use-Path-Style", // https://aws.amazon.com/blogs/aws/amazon-s3-path-deprecation-plan-the-rest-of-the-story
 	"S3-ListObjectVersions",
+	"Enable-List-Index",
 
 	// "none" ====================
 }
@@ -33,6 +33,15 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// per-bucket list-objects index (see fs/lsidx)
+	LsIndex = ".ais.lsidx"
+
+	// per-bucket replication change log (directory, see fs/replq)
+	ReplQueue = ".ais.replq"
+
+	// progress checkpoints of resumable xactions (directory, see xact/xckpt)
+	XactCkpt = ".ais.xckpt"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
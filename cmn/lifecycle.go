@@ -0,0 +1,127 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket lifecycle: a list of rules that get periodically evaluated by
+// the (target-local) x-lifecycle (xact/xs/lifecycle.go).
+// Each rule selects objects by name prefix and/or custom metadata (tags), and
+// removes (or evicts) those that were last modified more than `Age` ago.
+//
+// Lifecycle is a bucket-scope property - not inherited from cluster config.
+// Can be set via native API (`api.SetBucketProps`), CLI (`ais bucket props set`),
+// or S3 PutBucketLifecycleConfiguration (see ais/s3/lifecycle.go).
+
+// LifecycleRule.Action enum
+const (
+	LcActDelete = "delete" // remove the object (for remote buckets, remove it remotely as well)
+	LcActEvict  = "evict"  // remote buckets only: remove in-cluster replica, keep the remote object
+)
+
+const lcMaxRules = 1000 // as per S3
+
+type (
+	LifecycleRule struct {
+		ID       string       `json:"id"`
+		Prefix   string       `json:"prefix,omitempty"`
+		Tags     cos.StrKVs   `json:"tags,omitempty"` // object tags (see cmn/objtags.go), all must match
+		Action   string       `json:"action,omitempty"`
+		Age      cos.Duration `json:"age"` // since object's last modification
+		Disabled bool         `json:"disabled,omitempty"`
+	}
+	LifecycleConf struct {
+		Rules   []LifecycleRule `json:"rules,omitempty" list:"readonly"` // (JSON-only: via API or CLI '{"lifecycle": {...}}')
+		Enabled bool            `json:"enabled"`
+	}
+	LifecycleConfToSet struct {
+		Rules   *[]LifecycleRule `json:"rules,omitempty"`
+		Enabled *bool            `json:"enabled,omitempty"`
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*LifecycleConf)(nil)
+
+func (c *LifecycleConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > lcMaxRules {
+		return fmt.Errorf("lifecycle: too many rules (%d > %d)", len(c.Rules), lcMaxRules)
+	}
+	ids := make(cos.StrSet, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if ids.Contains(rule.ID) {
+			return fmt.Errorf("lifecycle: duplicate rule ID %q", rule.ID)
+		}
+		ids.Add(rule.ID)
+	}
+	if c.Enabled && len(c.Rules) == 0 {
+		return errors.New("lifecycle: cannot enable lifecycle with no rules")
+	}
+	return nil
+}
+
+// enabled and has at least one active rule
+func (c *LifecycleConf) IsActive() bool {
+	if !c.Enabled {
+		return false
+	}
+	for i := range c.Rules {
+		if !c.Rules[i].Disabled {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *LifecycleRule) validate() error {
+	if rule.ID == "" {
+		return errors.New("lifecycle: rule ID cannot be empty")
+	}
+	switch rule.Action {
+	case "":
+		rule.Action = LcActDelete
+	case LcActDelete, LcActEvict:
+	default:
+		return fmt.Errorf("lifecycle rule %q: invalid action %q (expecting %q or %q)", rule.ID, rule.Action, LcActDelete, LcActEvict)
+	}
+	if rule.Age <= 0 {
+		return fmt.Errorf("lifecycle rule %q: age must be positive, got %v", rule.ID, rule.Age)
+	}
+	for k := range rule.Tags {
+		if k == "" {
+			return fmt.Errorf("lifecycle rule %q: empty tag key", rule.ID)
+		}
+	}
+	return nil
+}
+
+// returns true if the rule selects a given object;
+// `age` is the time elapsed since the object's last modification,
+// `getTag` - object tag accessor (see cmn/objtags.go)
+func (rule *LifecycleRule) Match(objName string, age int64, getTag func(string) (string, bool)) bool {
+	if rule.Disabled || age < int64(rule.Age) {
+		return false
+	}
+	if rule.Prefix != "" && !strings.HasPrefix(objName, rule.Prefix) {
+		return false
+	}
+	for k, v := range rule.Tags {
+		if val, ok := getTag(k); !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
@@ -36,6 +36,10 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// user-defined object tags, URL-encoded (see cmn/objtags.go)
+	// (reserved name - cannot be set as custom metadata)
+	TagsObjMD = "ais.tags"
+
 	// RFC3339; see also: cos.HdrLastModified formatted RFC1123GMT
 	LsoLastModified = "LastModified"
 
@@ -56,10 +60,11 @@ type ObjectProps struct {
 		Copies int      `json:"copies,omitempty"`
 	} `json:"mirror"`
 	EC struct {
-		Generation   int64 `json:"generation"`
-		DataSlices   int   `json:"data"`
-		ParitySlices int   `json:"parity"`
-		IsECCopy     bool  `json:"replicated"`
+		Generation   int64  `json:"generation"`
+		DataSlices   int    `json:"data"`
+		ParitySlices int    `json:"parity"`
+		Profile      string `json:"profile,omitempty"` // size-tiered EC profile, if any (see ECConf.Profiles)
+		IsECCopy     bool   `json:"replicated"`
 	} `json:"ec"`
 	Present bool `json:"present"`
 }
@@ -301,7 +306,7 @@ func (oa *ObjAttrs) CheckEq(rem cos.OAH) error {
 
 			switch {
 			case Rom.Features().IsSet(feat.TrustCryptoSafeChecksums):
-				sameCksum = (cksumType == cos.ChecksumSHA256 || cksumType == cos.ChecksumSHA512)
+				sameCksum = cos.IsCryptoSafeCksum(cksumType)
 			default:
 				debug.Assert(cksumType != cos.ChecksumNone)
 				sameCksum = cksumType != cos.ChecksumCRC32C
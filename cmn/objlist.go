@@ -23,15 +23,17 @@ type (
 	// `Flags` is a bit field where `EntryStatusBits` bits [0-4] are reserved for object status
 	// (all statuses are mutually exclusive)
 	LsoEnt struct {
-		Name     string `json:"name" msg:"n"`                            // object name
-		Checksum string `json:"checksum,omitempty" msg:"cs,omitempty"`   // checksum
-		Atime    string `json:"atime,omitempty" msg:"a,omitempty"`       // last access time; formatted as ListObjsMsg.TimeFormat
-		Version  string `json:"version,omitempty" msg:"v,omitempty"`     // e.g., GCP int64 generation, AWS version (string), etc.
-		Location string `json:"location,omitempty" msg:"t,omitempty"`    // [tnode:mountpath]
-		Custom   string `json:"custom-md,omitempty" msg:"m,omitempty"`   // custom metadata: ETag, MD5, CRC, user-defined ...
-		Size     int64  `json:"size,string,omitempty" msg:"s,omitempty"` // size in bytes
-		Copies   int16  `json:"copies,omitempty" msg:"c,omitempty"`      // ## copies (NOTE: for non-replicated object copies == 1)
-		Flags    uint16 `json:"flags,omitempty" msg:"f,omitempty"`       // enum { EntryIsCached, EntryIsDir, EntryInArch, ...}
+		Name     string `json:"name" msg:"n"`                              // object name
+		Checksum string `json:"checksum,omitempty" msg:"cs,omitempty"`     // checksum
+		Atime    string `json:"atime,omitempty" msg:"a,omitempty"`         // last access time; formatted as ListObjsMsg.TimeFormat
+		Version  string `json:"version,omitempty" msg:"v,omitempty"`       // e.g., GCP int64 generation, AWS version (string), etc.
+		Location string `json:"location,omitempty" msg:"t,omitempty"`      // [tnode:mountpath]
+		Custom   string `json:"custom-md,omitempty" msg:"m,omitempty"`     // custom metadata: ETag, MD5, CRC, user-defined ...
+		Tags     string `json:"tags,omitempty" msg:"g,omitempty"`          // object tags, URL-encoded (see cmn/objtags.go)
+		Size     int64  `json:"size,string,omitempty" msg:"s,omitempty"`   // size in bytes
+		Offset   int64  `json:"offset,string,omitempty" msg:"o,omitempty"` // archived file's data offset (see archive.Entry)
+		Copies   int16  `json:"copies,omitempty" msg:"c,omitempty"`        // ## copies (NOTE: for non-replicated object copies == 1)
+		Flags    uint16 `json:"flags,omitempty" msg:"f,omitempty"`         // enum { EntryIsCached, EntryIsDir, EntryInArch, ...}
 	}
 
 	LsoEntries []*LsoEnt
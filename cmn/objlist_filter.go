@@ -0,0 +1,138 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"net/http"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+type (
+	// compiled (ready-to-evaluate) apc.LsoFilter
+	// NOTE: not thread-safe - one instance per list-objects walk
+	LsoFilter struct {
+		apc.LsoFilter
+		regex *regexp.Regexp
+		md    cos.StrKVs // scratch, to parse LsoEnt.Custom
+	}
+
+	// object attributes that LsoFilter evaluates;
+	// zero Atime (Mtime) means "not known"
+	LsoFilterAttrs struct {
+		Custom   func(key string) (string, bool)
+		Name     string
+		Size     int64
+		Atime    int64
+		Mtime    int64
+		HasCksum bool
+	}
+)
+
+func NewLsoFilter(f *apc.LsoFilter) (*LsoFilter, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	flt := &LsoFilter{LsoFilter: *f}
+	if f.NameRegex != "" {
+		flt.regex = regexp.MustCompile(f.NameRegex) // validated above
+	}
+	return flt, nil
+}
+
+func (flt *LsoFilter) needsMtime() bool { return flt.MtimeAfter != 0 || flt.MtimeBefore != 0 }
+
+func (flt *LsoFilter) MatchName(name string) bool {
+	if flt.regex != nil && !flt.regex.MatchString(name) {
+		return false
+	}
+	if flt.NameGlob != "" {
+		if ok, _ := path.Match(flt.NameGlob, name); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (flt *LsoFilter) Match(a *LsoFilterAttrs) bool {
+	if !flt.MatchName(a.Name) {
+		return false
+	}
+	if a.Size < flt.MinSize || (flt.MaxSize > 0 && a.Size > flt.MaxSize) {
+		return false
+	}
+	if !inWindow(a.Atime, flt.AtimeAfter, flt.AtimeBefore) || !inWindow(a.Mtime, flt.MtimeAfter, flt.MtimeBefore) {
+		return false
+	}
+	if flt.HasCksum != nil && *flt.HasCksum != a.HasCksum {
+		return false
+	}
+	for k, v := range flt.Custom {
+		if a.Custom == nil {
+			return false
+		}
+		val, ok := a.Custom(k)
+		if !ok || (v != "" && val != v) {
+			return false
+		}
+	}
+	return true
+}
+
+// evaluate list-objects entry as is - i.e., when there's no (in-cluster) object metadata
+func (flt *LsoFilter) MatchEnt(en *LsoEnt) bool {
+	if !flt.MatchName(en.Name) {
+		return false
+	}
+	if flt.NameOnly() {
+		return true
+	}
+	a := LsoFilterAttrs{Name: en.Name, Size: en.Size, HasCksum: en.Checksum != ""}
+	if len(flt.Custom) > 0 || flt.needsMtime() {
+		if flt.md == nil {
+			flt.md = make(cos.StrKVs, 8)
+		}
+		clear(flt.md)
+		S2CustomMD(flt.md, en.Custom, en.Version)
+		a.Custom = flt.getmd
+		a.Mtime = LastModified(flt.getmd)
+	}
+	return flt.Match(&a)
+}
+
+func (flt *LsoFilter) getmd(key string) (v string, ok bool) {
+	v, ok = flt.md[key]
+	return v, ok
+}
+
+// parse remote LastModified, if present
+func LastModified(get func(key string) (string, bool)) int64 {
+	if v, ok := get(LsoLastModified); ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t.UnixNano()
+		}
+	}
+	if v, ok := get(cos.HdrLastModified); ok {
+		if t, err := time.Parse(http.TimeFormat, v); err == nil {
+			return t.UnixNano()
+		}
+	}
+	return 0
+}
+
+func inWindow(t, after, before int64) bool {
+	if after == 0 && before == 0 {
+		return true
+	}
+	if t == 0 {
+		return false
+	}
+	return t >= after && (before == 0 || t < before)
+}
@@ -81,6 +81,9 @@ func (be *LsoEnt) CopyWithProps(propsSet cos.StrSet) (ne *LsoEnt) {
 	if propsSet.Contains(apc.GetPropsCopies) {
 		ne.Copies = be.Copies
 	}
+	if propsSet.Contains(apc.GetPropsTags) {
+		ne.Tags = be.Tags
+	}
 	return
 }
 
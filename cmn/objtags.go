@@ -0,0 +1,69 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object tags (user-defined key/value labels):
+// - stored in LOM custom metadata under `TagsObjMD`
+// - in their URL-encoded form "k1=v1&k2=v2" (same as S3 `x-amz-tagging` header)
+// - limits as per https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+
+const (
+	MaxObjTags     = 10
+	maxObjTagKey   = 128
+	maxObjTagValue = 256
+)
+
+func ParseObjTags(s string) (cos.StrKVs, error) {
+	if s == "" {
+		return nil, nil
+	}
+	q, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object tags %q: %v", s, err)
+	}
+	tags := make(cos.StrKVs, len(q))
+	for k, vs := range q {
+		if len(vs) > 1 {
+			return nil, fmt.Errorf("invalid object tags: duplicate key %q", k)
+		}
+		tags[k] = vs[0]
+	}
+	return tags, ValidateObjTags(tags)
+}
+
+func ValidateObjTags(tags cos.StrKVs) error {
+	if len(tags) > MaxObjTags {
+		return fmt.Errorf("invalid object tags: too many (%d > %d)", len(tags), MaxObjTags)
+	}
+	for k, v := range tags {
+		if k == "" || len(k) > maxObjTagKey {
+			return fmt.Errorf("invalid object tag key %q (expecting length between 1 and %d)", k, maxObjTagKey)
+		}
+		if len(v) > maxObjTagValue {
+			return fmt.Errorf("invalid object tag %q: value too long (%d > %d)", k, len(v), maxObjTagValue)
+		}
+	}
+	return nil
+}
+
+// (sorted by key)
+func ObjTags2S(tags cos.StrKVs) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	q := make(url.Values, len(tags))
+	for k, v := range tags {
+		q.Set(k, v)
+	}
+	return q.Encode()
+}
@@ -0,0 +1,84 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Cross-cluster asynchronous bucket replication.
+//
+// When enabled, each target records PUT, DELETE, and rename events of the
+// bucket's objects in a durable per-bucket change log (fs/replq). The
+// (target-local) x-replicate ships the recorded changes, in order, to the
+// destination bucket in the attached remote AIS cluster - via the remote-AIS
+// backend (see `apc.RemAIS` and ais/backend/ais.go).
+//
+// Replication is a bucket-scope property - not inherited from cluster config.
+// Only the changes made after replication gets enabled are replicated; to sync
+// the existing content, copy the bucket once (e.g., `ais bucket cp`).
+
+type (
+	ReplConf struct {
+		Dst     string `json:"dst"`              // destination bucket in a remote AIS cluster, e.g. "ais://@remais/bucket"
+		Prefix  string `json:"prefix,omitempty"` // replicate only the objects whose names start with this prefix
+		Enabled bool   `json:"enabled"`
+	}
+	ReplConfToSet struct {
+		Dst     *string `json:"dst,omitempty"`
+		Prefix  *string `json:"prefix,omitempty"`
+		Enabled *bool   `json:"enabled,omitempty"`
+	}
+
+	// per-bucket replication status on a given target (see apc.WhatReplStats)
+	ReplStats struct {
+		Bck      Bck    `json:"bck"`
+		Dst      string `json:"dst"`
+		Xid      string `json:"xid,omitempty"`       // x-replicate, if running
+		LastErr  string `json:"last_err,omitempty"`  // most recent shipping error
+		Pending  int64  `json:"pending"`             // size (bytes) of the not yet replicated part of the change log
+		Lag      int64  `json:"lag"`                 // age (nanoseconds) of the oldest not yet replicated change
+		Shipped  int64  `json:"shipped"`             // number of replicated changes since target startup
+		Errors   int64  `json:"errors"`              // number of failed attempts, ditto
+		LastSync int64  `json:"last_sync,omitempty"` // when (unix nano) the change log was last replicated in full
+	}
+)
+
+// interface guard
+var _ PropsValidator = (*ReplConf)(nil)
+
+func (c *ReplConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Dst == "" {
+		return errors.New("replication: cannot enable replication with no destination bucket")
+	}
+	_, err := c.DstBck()
+	return err
+}
+
+// parse and validate destination bucket
+func (c *ReplConf) DstBck() (Bck, error) {
+	bck, objName, err := ParseBckObjectURI(c.Dst, ParseURIOpts{})
+	if err != nil {
+		return bck, fmt.Errorf("replication: invalid destination %q: %v", c.Dst, err)
+	}
+	if objName != "" || bck.Name == "" {
+		return bck, fmt.Errorf("replication: invalid destination %q: expecting bucket name", c.Dst)
+	}
+	if !bck.IsRemoteAIS() {
+		return bck, fmt.Errorf("replication: destination %q must be a bucket in a remote AIS cluster (e.g., \"ais://@remais/bucket\")", c.Dst)
+	}
+	return bck, bck.ValidateName()
+}
+
+// whether a given object is subject to replication
+func (c *ReplConf) Match(objName string) bool {
+	return c.Enabled && strings.HasPrefix(objName, c.Prefix)
+}
@@ -0,0 +1,91 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAuditSelected(t *testing.T) {
+	tests := []struct {
+		conf   cmn.AuditConf
+		method string
+		action string
+		code   int
+		expect bool
+	}{
+		{cmn.AuditConf{}, http.MethodDelete, apc.ActDestroyBck, http.StatusOK, true},
+		{cmn.AuditConf{}, http.MethodGet, "", http.StatusOK, false},
+		{cmn.AuditConf{}, http.MethodGet, "", http.StatusForbidden, true},
+		{cmn.AuditConf{Reads: true}, http.MethodHead, "", http.StatusOK, true},
+		{cmn.AuditConf{Actions: "delete"}, http.MethodDelete, apc.ActDestroyBck, http.StatusOK, true},
+		{cmn.AuditConf{Actions: "delete"}, http.MethodPut, apc.ActMoveBck, http.StatusOK, false},
+		{cmn.AuditConf{Actions: "list " + apc.ActMoveBck}, http.MethodGet, apc.ActList, http.StatusOK, true},
+		{cmn.AuditConf{Actions: apc.ActMoveBck}, http.MethodPut, "", http.StatusUnauthorized, true},
+	}
+	for i, test := range tests {
+		got := test.conf.Selected(test.method, test.action, test.code)
+		tassert.Errorf(t, got == test.expect, "%d: %+v (%s, %q, %d): expected %t, got %t",
+			i, test.conf, test.method, test.action, test.code, test.expect, got)
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	const (
+		num     = 1000
+		maxSize = 16 * 1024
+	)
+	dir := t.TempDir()
+	alog := audit.NewLog(dir)
+	for i := range num {
+		rec := &audit.Record{
+			Time:   int64(i + 1),
+			Node:   "p1",
+			User:   "user" + strconv.Itoa(i%4),
+			Method: http.MethodPut,
+			Path:   "/v1/buckets/b",
+			Bucket: "ais://b" + strconv.Itoa(i%2),
+			Code:   http.StatusOK,
+		}
+		alog.Add(rec, maxSize)
+	}
+	alog.Close()
+
+	// rotated
+	finfo, err := os.Stat(filepath.Join(dir, audit.FileName+".1"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, finfo.Size() <= maxSize, "rotated log size %d exceeds %d", finfo.Size(), maxSize)
+
+	// most recent first, across rotated files
+	recs, err := alog.Query(&audit.Query{Limit: num})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(recs) > 0, "no records")
+	for i := 1; i < len(recs); i++ {
+		tassert.Fatalf(t, recs[i-1].Time > recs[i].Time, "records out of order: %d, %d", recs[i-1].Time, recs[i].Time)
+	}
+	tassert.Errorf(t, recs[0].Time == num, "expected most recent record %d, got %d", num, recs[0].Time)
+
+	// filters and limit
+	recs, err = alog.Query(&audit.Query{User: "user3", Bucket: "ais://b1", Limit: 10})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(recs) == 10, "expected 10 records, got %d", len(recs))
+	for _, rec := range recs {
+		tassert.Errorf(t, rec.User == "user3" && rec.Bucket == "ais://b1", "unexpected record %+v", rec)
+	}
+	tassert.Errorf(t, recs[0].Time == num, "expected most recent record %d, got %d", num, recs[0].Time)
+
+	recs, err = alog.Query(&audit.Query{Since: num - 9})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(recs) == 10, "expected 10 records since %d, got %d", num-9, len(recs))
+}
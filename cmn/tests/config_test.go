@@ -72,3 +72,76 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBackendFile(t *testing.T) {
+	tests := []struct {
+		conf  any
+		valid bool
+	}{
+		{map[string]any{"root": "/mnt/nfs/"}, true},
+		{map[string]any{"root": "mnt/nfs"}, false},
+		{map[string]any{}, false},
+	}
+	for _, test := range tests {
+		c := cmn.BackendConf{Conf: map[string]any{apc.File: test.conf}}
+		err := c.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "%v: expecting valid=%t, got %v", test.conf, test.valid, err)
+		if err != nil {
+			continue
+		}
+		fconf, ok := c.Get(apc.File).(cmn.BackendConfFile)
+		tassert.Fatalf(t, ok, "expecting %T, got %T", fconf, c.Get(apc.File))
+		tassert.Errorf(t, fconf.Root == "/mnt/nfs", "unexpected root %q", fconf.Root)
+		_, ok = c.Providers[apc.File]
+		tassert.Errorf(t, ok, "expecting %q provider", apc.File)
+	}
+}
+
+func TestECProfiles(t *testing.T) {
+	conf := cmn.ECConf{
+		Enabled:      true,
+		ObjSizeLimit: 256 * 1024,
+		DataSlices:   2,
+		ParitySlices: 2,
+		Compression:  apc.CompressNever,
+		Profiles: []cmn.ECProfile{
+			{Name: "large", MinSize: 64 * 1024 * 1024, DataSlices: 8, ParitySlices: 2},
+			{Name: "huge", MinSize: 4 * 1024 * 1024 * 1024, DataSlices: 16, ParitySlices: 4},
+		},
+	}
+	tassert.CheckFatal(t, conf.Validate())
+
+	tests := []struct {
+		size         int64
+		data, parity int
+		profile      string
+	}{
+		{1024 * 1024, 2, 2, ""},
+		{64*1024*1024 - 1, 2, 2, ""},
+		{64 * 1024 * 1024, 8, 2, "large"},
+		{1024 * 1024 * 1024, 8, 2, "large"},
+		{4 * 1024 * 1024 * 1024, 16, 4, "huge"},
+	}
+	for _, test := range tests {
+		d, p, name := conf.Slices(test.size)
+		tassert.Errorf(t, d == test.data && p == test.parity && name == test.profile,
+			"size %d: expected (%d, %d, %q), got (%d, %d, %q)", test.size, test.data, test.parity, test.profile, d, p, name)
+	}
+
+	// the largest profile defines the number of required targets
+	tassert.Errorf(t, conf.ValidateAsProps(20) != nil, "expecting error: not enough targets")
+	tassert.CheckError(t, conf.ValidateAsProps(21))
+
+	invalid := [][]cmn.ECProfile{
+		{{Name: "a", MinSize: 100, DataSlices: 2, ParitySlices: 2}, {Name: "b", MinSize: 100, DataSlices: 4, ParitySlices: 2}},
+		{{Name: "a", MinSize: 100, DataSlices: 2, ParitySlices: 2}, {Name: "a", MinSize: 200, DataSlices: 4, ParitySlices: 2}},
+		{{Name: "a", MinSize: 0, DataSlices: 2, ParitySlices: 2}},
+		{{Name: "", MinSize: 100, DataSlices: 2, ParitySlices: 2}},
+		{{Name: "a", MinSize: 100, DataSlices: 0, ParitySlices: 2}},
+		{{Name: "a", MinSize: 100, DataSlices: 2, ParitySlices: cmn.MaxSliceCount + 1}},
+	}
+	for i, profiles := range invalid {
+		conf.Profiles = profiles
+		tassert.Errorf(t, conf.Validate() != nil, "%d: expecting invalid profiles %+v", i, profiles)
+	}
+}
@@ -77,10 +77,12 @@ var _ = Describe("IterFields", func() {
 					"ec.compression":       "",
 					"ec.bundle_multiplier": 0,
 					"ec.disk_only":         false,
+					"ec.profiles":          []cmn.ECProfile(nil),
 
 					"versioning.enabled":           false,
 					"versioning.validate_warm_get": false,
 					"versioning.synchronize":       false,
+					"versioning.max_versions":      0,
 
 					"checksum.type":              cos.ChecksumOneXxh,
 					"checksum.validate_warm_get": false,
@@ -149,6 +151,7 @@ var _ = Describe("IterFields", func() {
 					"ec.compression":       (*string)(nil),
 					"ec.bundle_multiplier": (*int)(nil),
 					"ec.disk_only":         (*bool)(nil),
+					"ec.profiles":          (*[]cmn.ECProfile)(nil),
 
 					"rate_limit.backend.enabled":            (*bool)(nil),
 					"rate_limit.frontend.enabled":           (*bool)(nil),
@@ -164,6 +167,7 @@ var _ = Describe("IterFields", func() {
 					"versioning.enabled":           (*bool)(nil),
 					"versioning.validate_warm_get": (*bool)(nil),
 					"versioning.synchronize":       (*bool)(nil),
+					"versioning.max_versions":      (*int)(nil),
 
 					"checksum.type":              apc.Ptr(cos.ChecksumOneXxh),
 					"checksum.validate_warm_get": (*bool)(nil),
@@ -181,6 +185,17 @@ var _ = Describe("IterFields", func() {
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   apc.Ptr(apc.WriteDelayed),
 
+					"lifecycle.rules":   (*[]cmn.LifecycleRule)(nil),
+					"lifecycle.enabled": (*bool)(nil),
+					"cors.rules":        (*[]cmn.CORSRule)(nil),
+
+					"replication.dst":     (*string)(nil),
+					"replication.prefix":  (*string)(nil),
+					"replication.enabled": (*bool)(nil),
+
+					"events.rules":   (*[]cmn.EventRule)(nil),
+					"events.enabled": (*bool)(nil),
+
 					"extra.hdfs.ref_directory": (*string)(nil),
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
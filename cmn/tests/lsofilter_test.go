@@ -0,0 +1,108 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLsoFilterValidate(t *testing.T) {
+	tests := []struct {
+		flt   apc.LsoFilter
+		valid bool
+	}{
+		{apc.LsoFilter{}, true},
+		{apc.LsoFilter{MinSize: 10, MaxSize: 100}, true},
+		{apc.LsoFilter{MinSize: 100, MaxSize: 10}, false},
+		{apc.LsoFilter{MinSize: -1}, false},
+		{apc.LsoFilter{MtimeAfter: 20, MtimeBefore: 10}, false},
+		{apc.LsoFilter{AtimeAfter: 10, AtimeBefore: 10}, false},
+		{apc.LsoFilter{NameRegex: "^a(b|c)"}, true},
+		{apc.LsoFilter{NameRegex: "^a(b|c"}, false},
+		{apc.LsoFilter{NameGlob: "dir/*.jpg"}, true},
+		{apc.LsoFilter{NameGlob: "dir/[a-"}, false},
+		{apc.LsoFilter{Custom: map[string]string{"": "v"}}, false},
+	}
+	for i, test := range tests {
+		err := test.flt.Validate()
+		tassert.Fatalf(t, (err == nil) == test.valid, "test %d: %+v: unexpected %v", i, test.flt, err)
+	}
+}
+
+func TestLsoFilterMatch(t *testing.T) {
+	var (
+		now  = time.Now()
+		hour = int64(time.Hour)
+		md   = map[string]string{"source": "camera-7", "reviewed": "yes"}
+		attr = cmn.LsoFilterAttrs{
+			Name:     "images/001.jpg",
+			Size:     1024,
+			Atime:    now.UnixNano(),
+			Mtime:    now.UnixNano() - 2*hour,
+			HasCksum: true,
+			Custom:   func(k string) (v string, ok bool) { v, ok = md[k]; return v, ok },
+		}
+		yes, no = true, false
+	)
+	tests := []struct {
+		flt   apc.LsoFilter
+		match bool
+	}{
+		{apc.LsoFilter{MinSize: 1024}, true},
+		{apc.LsoFilter{MinSize: 1025}, false},
+		{apc.LsoFilter{MaxSize: 1024}, true},
+		{apc.LsoFilter{MaxSize: 1023}, false},
+		{apc.LsoFilter{MtimeAfter: now.UnixNano() - 3*hour}, true},
+		{apc.LsoFilter{MtimeAfter: now.UnixNano() - hour}, false},
+		{apc.LsoFilter{MtimeBefore: now.UnixNano() - hour}, true},
+		{apc.LsoFilter{AtimeBefore: now.UnixNano()}, false},
+		{apc.LsoFilter{NameRegex: "^images/0"}, true},
+		{apc.LsoFilter{NameRegex: "png$"}, false},
+		{apc.LsoFilter{NameGlob: "images/*.jpg"}, true},
+		{apc.LsoFilter{NameGlob: "*.jpg"}, false},
+		{apc.LsoFilter{HasCksum: &yes}, true},
+		{apc.LsoFilter{HasCksum: &no}, false},
+		{apc.LsoFilter{Custom: map[string]string{"source": "camera-7", "reviewed": ""}}, true},
+		{apc.LsoFilter{Custom: map[string]string{"source": "camera-8"}}, false},
+		{apc.LsoFilter{Custom: map[string]string{"approved": ""}}, false},
+	}
+	for i, test := range tests {
+		flt, err := cmn.NewLsoFilter(&test.flt)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, flt.Match(&attr) == test.match, "test %d: %+v: expected match=%t", i, test.flt, test.match)
+	}
+}
+
+func TestLsoFilterMatchEnt(t *testing.T) {
+	mtime := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	en := &cmn.LsoEnt{
+		Name:    "a/b/c.txt",
+		Size:    100,
+		Version: "v3",
+		Custom:  cmn.CustomProps2S(cmn.ETag, "abc", cmn.LsoLastModified, mtime.Format(time.RFC3339)),
+	}
+	tests := []struct {
+		flt   apc.LsoFilter
+		match bool
+	}{
+		{apc.LsoFilter{MtimeAfter: mtime.UnixNano()}, true},
+		{apc.LsoFilter{MtimeAfter: mtime.UnixNano() + 1}, false},
+		{apc.LsoFilter{Custom: map[string]string{cmn.ETag: "abc"}}, true},
+		{apc.LsoFilter{Custom: map[string]string{cmn.VersionObjMD: "v3"}}, true},
+		{apc.LsoFilter{AtimeAfter: 1}, false}, // remote entry: atime unknown
+		{apc.LsoFilter{MinSize: 101}, false},
+		{apc.LsoFilter{NameGlob: "a/*/c.txt"}, true},
+	}
+	for i, test := range tests {
+		flt, err := cmn.NewLsoFilter(&test.flt)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, flt.MatchEnt(en) == test.match, "test %d: %+v: expected match=%t", i, test.flt, test.match)
+	}
+}
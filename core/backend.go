@@ -61,4 +61,10 @@ type (
 		// bucket inventory
 		GetBucketInv(bck *meta.Bck, ctx *LsoInvCtx) (ecode int, err error)
 	}
+
+	// optional: backends that support object tagging (currently, AWS);
+	// `tags` is URL-encoded (see cmn/objtags.go), empty to delete all tags
+	ObjTagger interface {
+		PutObjTags(ctx context.Context, lom *LOM, tags string) (ecode int, err error)
+	}
 )
@@ -13,6 +13,8 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/fs/lsidx"
 )
 
 const (
@@ -71,7 +73,12 @@ func (lom *LOM) Open() (fh cos.LomReader, err error) {
 
 func (lom *LOM) Create() (cos.LomWriter, error) {
 	debug.Assert(lom.isLockedExcl(), lom.Cname()) // caller must wlock
-	return lom._cf(lom.FQN)
+	fh, err := lom._cf(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	lom.updLsidx(false) // (writing in place - no finalizing rename)
+	return fh, nil
 }
 
 func (lom *LOM) CreateWork(wfqn string) (cos.LomWriter, error) { return lom._cf(wfqn) } // -> lom
@@ -163,6 +170,9 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 			err = erc
 		}
 	}
+	if err == nil {
+		lom.updLsidx(true /*del*/)
+	}
 	lom.md.lid = 0
 	return err
 }
@@ -187,6 +197,7 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 	err := lom.RenameToMain(wfqn)
 	switch {
 	case err == nil:
+		lom.updLsidx(false)
 		return nil
 	case cos.IsErrMv(err):
 		return err
@@ -200,6 +211,7 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 		if err == nil {
 			lom.md.lid = lom.md.lid.setlmfl(lmflFntl)
 			lom.SetCustomKey(cmn.OrigFntl, saved[0])
+			lom.updLsidx(false)
 		} else {
 			debug.Assert(!cos.IsErrFntl(err))
 			lom.PopFntl(saved)
@@ -210,3 +222,21 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 		return cmn.NewErrFailedTo(T, "finalize", lom.Cname(), err)
 	}
 }
+
+// list-objects index (feat.ListIndex): only HRW (main) replicas count
+func (lom *LOM) updLsidx(del bool) {
+	if !lom.IsFeatureSet(feat.ListIndex) || !lom.IsHRW() {
+		return
+	}
+	name := lom.ObjName
+	if lom.IsFntl() {
+		if orig := lom.OrigFntl(); orig != nil {
+			name = orig[1]
+		}
+	}
+	if del {
+		lsidx.Del(lom.Bucket(), lom.Bprops().BID, name)
+	} else {
+		lsidx.Add(lom.Bucket(), lom.Bprops().BID, name)
+	}
+}
@@ -250,8 +250,7 @@ func (lom *LOM) IncVersion() error {
 	debug.Assert(lom.Bck().IsAIS())
 	v := lom.md.Version()
 	if v == "" {
-		lom.SetVersion(lomInitialVersion)
-		return nil
+		return lom.iniVersion()
 	}
 	ver, err := strconv.Atoi(v)
 	if err != nil {
@@ -261,6 +260,25 @@ func (lom *LOM) IncVersion() error {
 	return nil
 }
 
+// (re)created object: continue numbering past retained noncurrent versions, if any -
+// version IDs must not collide with those of the deleted object
+func (lom *LOM) iniVersion() error {
+	lom.SetVersion(lomInitialVersion)
+	if lom.VersionConf().MaxVersions <= 0 {
+		return nil
+	}
+	versions, err := lom.NoncurrentVersions()
+	if err != nil || len(versions) == 0 {
+		return err
+	}
+	ver, err := strconv.ParseUint(versions[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s: %v", lom, err) // (unlikely)
+	}
+	lom.SetVersion(strconv.FormatUint(ver+1, 10))
+	return nil
+}
+
 // Returns stored checksum (if present) and computed checksum (if requested)
 // MAY compute and store a missing (xxhash) checksum.
 // If xattr checksum is different than lom's metadata checksum, returns error
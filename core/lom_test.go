@@ -71,6 +71,7 @@ var _ = Describe("LOM", func() {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ObjVersionType, &fs.ObjVersionContentResolver{}, true)
 
 	bmd := mock.NewBaseBownerMock(
 		meta.NewBck(
@@ -1023,6 +1024,63 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("noncurrent versions", func() {
+		const testFileSize = 456
+		var (
+			objName = "obj/with/versions"
+			fqn     = mis[0].MakePathFQN(&localBckB, fs.ObjectType, objName)
+			wfqn    = mis[0].MakePathFQN(&localBckB, fs.WorkfileType, objName)
+		)
+
+		overwrite := func() {
+			createTestFile(wfqn, testFileSize/2)
+			Expect(os.Rename(wfqn, fqn)).NotTo(HaveOccurred())
+		}
+
+		It("should retain current version after the object is overwritten", func() {
+			lom := filePut(fqn, testFileSize)
+			hash := getTestFileHash(fqn)
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			ver := lom.Version()
+
+			Expect(lom.LinkVersion()).NotTo(HaveOccurred())
+			overwrite()
+			Expect(lom.RetainVersion()).NotTo(HaveOccurred())
+
+			vlom, err := lom.LoadVersion(ver)
+			Expect(err).NotTo(HaveOccurred())
+			defer core.FreeLOM(vlom)
+			Expect(vlom.Version()).To(Equal(ver))
+			Expect(vlom.Lsize()).To(BeEquivalentTo(testFileSize))
+			Expect(getTestFileHash(vlom.FQN)).To(Equal(hash))
+			Expect(getTestFileHash(fqn)).NotTo(Equal(hash))
+
+			versions, err := lom.NoncurrentVersions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(Equal([]string{ver}))
+		})
+
+		It("should keep current object intact when not overwritten", func() {
+			lom := filePut(fqn, testFileSize)
+			hash := getTestFileHash(fqn)
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			ver := lom.Version()
+
+			Expect(lom.LinkVersion()).NotTo(HaveOccurred())
+			lom.UnlinkVersion() // e.g., failed to finalize the new content
+
+			Expect(getTestFileHash(fqn)).To(Equal(hash))
+			_, err := lom.LoadVersion(ver)
+			Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+			Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+			Expect(lom.Version()).To(Equal(ver))
+		})
+	})
+
 	Describe("local and cloud bucket with the same name", func() {
 		It("should have different fqn", func() {
 			testObject := "foldr/test-obj.ext"
@@ -0,0 +1,191 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// noncurrent object versions (ais:// buckets with `versioning.max_versions` > 0)
+// - when overwritten, the current object becomes noncurrent version (content type fs.ObjVersionType)
+//   that keeps its data and metadata, including the version itself
+// - noncurrent version is created on the object's mountpath but can be found on any other
+//   mountpath of the same target (e.g., after mountpath was added or removed)
+// - all operations on noncurrent versions are protected by the object's lock
+//
+
+// FQN of the noncurrent version on the object's (HRW) mountpath
+func (lom *LOM) VersionFQN(ver string) string { return lom._versionFQN(lom.mi, ver) }
+
+func (lom *LOM) _versionFQN(mi *fs.Mountpath, ver string) string {
+	return mi.MakePathFQN(lom.Bucket(), fs.ObjVersionType, lom.versionName(ver))
+}
+
+func (lom *LOM) versionName(ver string) string {
+	return fs.CSM.Resolver(fs.ObjVersionType).GenUniqueFQN(lom.ObjName, ver)
+}
+
+// Retaining the current (loaded and wlocked) object as noncurrent version is a two-step
+// sequence that never leaves the object's data with a single (and temporary) name:
+// 1. prior to finalizing the new content: LinkVersion hard-links the current object
+// 2. once the new content is in place: RetainVersion (re)writes the version's metadata
+// If finalizing fails the caller unlinks the version (and the current object stays intact).
+
+func (lom *LOM) LinkVersion() error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	ver := lom.md.Version()
+	if _, err := strconv.ParseUint(ver, 10, 64); err != nil {
+		return fmt.Errorf("%s: cannot retain non-numeric version %q", lom.Cname(), ver)
+	}
+	if fs.IsFntl(lom.versionName(ver)) {
+		return fmt.Errorf("%s: cannot retain version %s (name too long)", lom.Cname(), ver)
+	}
+	vfqn := lom.VersionFQN(ver)
+	err := os.Link(lom.FQN, vfqn)
+	if os.IsNotExist(err) {
+		if err = cos.CreateDir(filepath.Dir(vfqn)); err == nil {
+			err = os.Link(lom.FQN, vfqn)
+		}
+	} else if os.IsExist(err) {
+		// never overwrite retained versions (see IncVersion)
+		err = fmt.Errorf("%s: version %s already exists", lom.Cname(), ver)
+	}
+	return err
+}
+
+// NOTE: must be called after the current object's FQN points to the new content -
+// the version shares (data and xattrs) with the current object until then
+func (lom *LOM) RetainVersion() error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	var (
+		vfqn = lom.VersionFQN(lom.md.Version())
+		md   = lom.md
+	)
+	// (re)write metadata: may be dirty, must not reference copies
+	md.copies = nil
+	buf := md.pack(g.maxLmeta.Load())
+	err := fs.SetXattr(vfqn, XattrLOM, buf)
+	g.smm.Free(buf)
+	if err != nil {
+		// the version is lost but the (new) current object is not affected
+		lom.UnlinkVersion()
+	}
+	return err
+}
+
+func (lom *LOM) UnlinkVersion() {
+	if err := cos.RemoveFile(lom.VersionFQN(lom.md.Version())); err != nil {
+		nlog.Errorln(lom.Cname(), "failed to remove noncurrent version:", err)
+	}
+}
+
+// returns noncurrent versions, the most recent first
+func (lom *LOM) NoncurrentVersions() (versions []string, _ error) {
+	var (
+		base   = filepath.Base(lom.VersionFQN("0"))
+		prefix = base[:len(base)-1] // (minus "0")
+	)
+	for _, mi := range fs.GetAvail() {
+		dir := filepath.Dir(lom._versionFQN(mi, "0"))
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, ent := range entries {
+			name := ent.Name()
+			if ent.IsDir() || !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			ver := name[len(prefix):]
+			if _, err := strconv.ParseUint(ver, 10, 64); err != nil {
+				continue
+			}
+			if !slices.Contains(versions, ver) {
+				versions = append(versions, ver)
+			}
+		}
+	}
+	slices.SortFunc(versions, func(a, b string) int {
+		va, _ := strconv.ParseUint(a, 10, 64)
+		vb, _ := strconv.ParseUint(b, 10, 64)
+		return cmp.Compare(vb, va)
+	})
+	return versions, nil
+}
+
+// loads noncurrent version; the caller must free the returned LOM
+// (the returned LOM is read-only: it must not be cached, persisted, etc.)
+func (lom *LOM) LoadVersion(ver string) (*LOM, error) {
+	fqn, err := lom.findVersion(ver)
+	if err != nil {
+		return nil, err
+	}
+	vlom := lom.CloneMD(fqn)
+	vlom.md = lmeta{}
+	if err := vlom.LoadMetaFromFS(); err != nil {
+		FreeLOM(vlom)
+		return nil, err
+	}
+	vlom.setbid(vlom.Bprops().BID)
+	return vlom, nil
+}
+
+func (lom *LOM) RemoveVersion(ver string) error {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	fqn, err := lom.findVersion(ver)
+	if err != nil {
+		return err
+	}
+	return cos.RemoveFile(fqn)
+}
+
+// removes the oldest noncurrent versions to keep at most `limit`
+func (lom *LOM) TrimVersions(limit int) (n int, _ error) {
+	debug.Assert(lom.isLockedExcl(), lom.Cname())
+	versions, err := lom.NoncurrentVersions()
+	if err != nil || len(versions) <= limit {
+		return 0, err
+	}
+	for _, ver := range versions[limit:] {
+		if err := lom.RemoveVersion(ver); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// HRW mountpath first
+func (lom *LOM) findVersion(ver string) (string, error) {
+	fqn := lom.VersionFQN(ver)
+	if err := cos.Stat(fqn); err == nil {
+		return fqn, nil
+	}
+	for _, mi := range fs.GetAvail() {
+		if mi == lom.mi {
+			continue
+		}
+		fqn := lom._versionFQN(mi, ver)
+		if err := cos.Stat(fqn); err == nil {
+			return fqn, nil
+		}
+	}
+	return "", cos.NewErrNotFound(T, lom.Cname()+" version "+ver)
+}
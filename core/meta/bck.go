@@ -54,6 +54,7 @@ func (b *Bck) IsAIS() bool                  { return (*cmn.Bck)(b).IsAIS() }
 func (b *Bck) HasProvider() bool            { return (*cmn.Bck)(b).HasProvider() }
 func (b *Bck) IsHT() bool                   { return (*cmn.Bck)(b).IsHT() }
 func (b *Bck) IsCloud() bool                { return (*cmn.Bck)(b).IsCloud() }
+func (b *Bck) IsFile() bool                 { return (*cmn.Bck)(b).IsFile() }
 func (b *Bck) IsRemote() bool               { return (*cmn.Bck)(b).IsRemote() }
 func (b *Bck) IsRemoteAIS() bool            { return (*cmn.Bck)(b).IsRemoteAIS() }
 func (b *Bck) IsQuery() bool                { return (*cmn.Bck)(b).IsQuery() }
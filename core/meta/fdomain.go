@@ -0,0 +1,177 @@
+// Package meta: cluster-level metadata
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Failure domains (zones, racks, power circuits, etc.)
+//
+// Each target can be (optionally) labeled with a failure domain - at join time via
+// local config or environment (see env.AisFailureDomain), or at runtime via
+// `apc.ActSetFdomain`. Once at least one target is labeled, HRW placement of
+// EC slices and replicas (see HrwTargetList) selects targets from distinct domains.
+// Unlabeled targets, if any, are treated as single-target domains of their own.
+
+type (
+	// FdomainViolation: erasure coded bucket that requires more distinct failure domains
+	// than the cluster currently has
+	FdomainViolation struct {
+		Bck      cmn.Bck `json:"bck"`
+		EC       string  `json:"ec"`        // EC config (for display)
+		Required int     `json:"required"`  // number of distinct domains required to place all slices (or replicas)
+		Avail    int     `json:"available"` // number of distinct domains with active targets
+	}
+	FdomainReport struct {
+		Domains    map[string][]string `json:"domains"`              // domain => target IDs
+		Unlabeled  []string            `json:"unlabeled,omitempty"`  // IDs of the targets that have no domain
+		Violations []FdomainViolation  `json:"violations,omitempty"` // (empty when all is good)
+	}
+)
+
+func ValidateFdomain(domain string) error {
+	if domain == "" {
+		return nil
+	}
+	return cos.CheckAlphaPlus(domain, "failure domain")
+}
+
+// unlabeled target is its own failure domain
+func (d *Snode) fdomain() string {
+	if d.Domain != "" {
+		return d.Domain
+	}
+	return d.DaeID
+}
+
+// whether at least one target is labeled
+func (m *Smap) HasDomains() bool {
+	for _, tsi := range m.Tmap {
+		if tsi.Domain != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// number of distinct failure domains that contain active targets
+// (same as CountActiveTs when there are no labels)
+func (m *Smap) CountActiveDomains() int {
+	doms := make(cos.StrSet, len(m.Tmap))
+	for _, tsi := range m.Tmap {
+		if !tsi.InMaintOrDecomm() {
+			doms.Set(tsi.fdomain())
+		}
+	}
+	return len(doms)
+}
+
+// with failure domains, (HRW) candidates are limited to one target per active domain -
+// use it to degrade (rather than fail) when looking for the targets to restore slices or
+// replicas, e.g. when an entire domain is down
+func (m *Smap) MaxDomainTargets(count int) int {
+	if !m.HasDomains() {
+		return count
+	}
+	return min(count, m.CountActiveDomains())
+}
+
+// e.g. "rack1[t1,t2] rack2[t3]" (empty when there are no labels)
+func (m *Smap) StrDomains() string {
+	if !m.HasDomains() {
+		return ""
+	}
+	var (
+		sb   strings.Builder
+		rep  = m.fdomains()
+		doms = make([]string, 0, len(rep.Domains))
+	)
+	for dom := range rep.Domains {
+		doms = append(doms, dom)
+	}
+	sort.Strings(doms)
+	for i, dom := range doms {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(dom)
+		sb.WriteByte('[')
+		sb.WriteString(strings.Join(rep.Domains[dom], ","))
+		sb.WriteByte(']')
+	}
+	if len(rep.Unlabeled) > 0 {
+		sb.WriteString(" (unlabeled: ")
+		sb.WriteString(strings.Join(rep.Unlabeled, ","))
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+func (m *Smap) fdomains() *FdomainReport {
+	rep := &FdomainReport{Domains: make(map[string][]string, 4)}
+	for tid, tsi := range m.Tmap {
+		if tsi.Domain == "" {
+			rep.Unlabeled = append(rep.Unlabeled, tid)
+			continue
+		}
+		rep.Domains[tsi.Domain] = append(rep.Domains[tsi.Domain], tid)
+	}
+	for _, tids := range rep.Domains {
+		sort.Strings(tids)
+	}
+	sort.Strings(rep.Unlabeled)
+	return rep
+}
+
+// CheckFdomains reports erasure coded buckets whose placement cannot satisfy
+// the distinct-failure-domains constraint
+func (m *Smap) CheckFdomains(bmd *BMD) *FdomainReport {
+	var (
+		rep   = m.fdomains()
+		avail = m.CountActiveDomains()
+	)
+	bmd.Range(nil, nil, func(bck *Bck) bool {
+		ecConf := &bck.Props.EC
+		if !ecConf.Enabled {
+			return false
+		}
+		if required := ecConf.RequiredTargets(); required > avail {
+			rep.Violations = append(rep.Violations, FdomainViolation{
+				Bck:      *bck.Bucket(),
+				EC:       ecConf.String(),
+				Required: required,
+				Avail:    avail,
+			})
+		}
+		return false
+	})
+	sort.Slice(rep.Violations, func(i, j int) bool {
+		return rep.Violations[i].Bck.Cname("") < rep.Violations[j].Bck.Cname("")
+	})
+	return rep
+}
+
+// whether given nodes (e.g., locations of EC slices) belong to distinct failure domains
+func (m *Smap) DistinctDomains(tids []string) error {
+	doms := make(map[string]string, len(tids))
+	for _, tid := range tids {
+		tsi := m.GetTarget(tid)
+		if tsi == nil {
+			continue
+		}
+		dom := tsi.fdomain()
+		if other, ok := doms[dom]; ok {
+			return fmt.Errorf("%s and %s share failure domain %q", Tname(other), tsi.StringEx(), dom)
+		}
+		doms[dom] = tid
+	}
+	return nil
+}
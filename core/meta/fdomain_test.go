@@ -0,0 +1,138 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Failure domains", func() {
+	// 3 racks x 3 targets
+	newSmap := func(labeled bool) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, 9), Pmap: make(meta.NodeMap)}
+		for i := range 9 {
+			si := &meta.Snode{}
+			si.Init(fmt.Sprintf("t%d", i), apc.Target)
+			if labeled {
+				si.Domain = fmt.Sprintf("rack%d", i%3)
+			}
+			smap.Tmap[si.ID()] = si
+		}
+		return smap
+	}
+	domains := func(smap *meta.Smap, sis meta.Nodes) []string {
+		doms := make([]string, 0, len(sis))
+		for _, si := range sis {
+			doms = append(doms, smap.Tmap[si.ID()].Domain)
+		}
+		return doms
+	}
+
+	It("should count unlabeled targets as distinct domains", func() {
+		smap := newSmap(false)
+		Expect(smap.HasDomains()).To(BeFalse())
+		Expect(smap.CountActiveDomains()).To(Equal(9))
+		Expect(smap.StrDomains()).To(BeEmpty())
+
+		smap = newSmap(true)
+		Expect(smap.HasDomains()).To(BeTrue())
+		Expect(smap.CountActiveDomains()).To(Equal(3))
+	})
+
+	It("should place HRW list in distinct domains", func() {
+		var (
+			smap      = newSmap(true)
+			unlabeled = newSmap(false)
+		)
+		for i := range 100 {
+			uname := fmt.Sprintf("bck/obj-%d", i)
+			sis, err := smap.HrwTargetList(&uname, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(3))
+			Expect(domains(smap, sis)).To(ConsistOf("rack0", "rack1", "rack2"))
+
+			// the first (main) target does not depend on labels
+			tsi, err := unlabeled.HrwName2T([]byte(uname))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0].ID()).To(Equal(tsi.ID()))
+		}
+	})
+
+	It("should fail when there are not enough domains", func() {
+		smap := newSmap(true)
+		uname := "bck/obj"
+		_, err := smap.HrwTargetList(&uname, 4)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(cmn.ErrNotEnoughTargets.Error()))
+
+		// as many as possible
+		sis, err := smap.HrwTargetList(&uname, 9)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sis).To(HaveLen(3))
+	})
+
+	It("should degrade when one domain is down", func() {
+		smap := newSmap(true)
+		for _, si := range smap.Tmap {
+			if si.Domain == "rack1" {
+				si.Flags = si.Flags.Set(meta.SnodeMaint)
+			}
+		}
+		Expect(smap.CountActiveDomains()).To(Equal(2))
+		Expect(smap.MaxDomainTargets(4)).To(Equal(2))
+		Expect(smap.MaxDomainTargets(1)).To(Equal(1))
+		Expect(newSmap(false).MaxDomainTargets(4)).To(Equal(4))
+
+		for i := range 100 {
+			uname := fmt.Sprintf("bck/obj-%d", i)
+			_, err := smap.HrwTargetList(&uname, 3)
+			Expect(err).To(HaveOccurred())
+
+			// e.g., restoring EC (d=2, p=1) slices
+			sis, err := smap.HrwTargetList(&uname, smap.MaxDomainTargets(2+1+1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis).To(HaveLen(2))
+			Expect(domains(smap, sis)).To(ConsistOf("rack0", "rack2"))
+		}
+	})
+
+	It("should detect slices that share a domain", func() {
+		smap := newSmap(true)
+		Expect(smap.DistinctDomains([]string{"t0", "t1", "t2"})).NotTo(HaveOccurred())
+		Expect(smap.DistinctDomains([]string{"t0", "t3"})).To(HaveOccurred())
+	})
+
+	It("should report EC buckets that cannot be placed", func() {
+		var (
+			smap = newSmap(true)
+			bmd  = &meta.BMD{Providers: make(meta.Providers)}
+			ok   = meta.NewBck("ok", apc.AIS, cmn.NsGlobal)
+			bad  = meta.NewBck("bad", apc.AIS, cmn.NsGlobal)
+		)
+		for _, bck := range []*meta.Bck{ok, bad} {
+			bck.Props = &cmn.Bprops{}
+			bck.Props.EC.Enabled = true
+		}
+		ok.Props.EC.DataSlices, ok.Props.EC.ParitySlices = 1, 1
+		bad.Props.EC.DataSlices, bad.Props.EC.ParitySlices = 2, 2
+		bmd.Add(ok)
+		bmd.Add(bad)
+
+		rep := smap.CheckFdomains(bmd)
+		Expect(rep.Domains).To(HaveLen(3))
+		Expect(rep.Unlabeled).To(BeEmpty())
+		Expect(rep.Violations).To(HaveLen(1))
+		Expect(rep.Violations[0].Bck.Name).To(Equal("bad"))
+		Expect(rep.Violations[0].Required).To(Equal(5))
+		Expect(rep.Violations[0].Avail).To(Equal(3))
+	})
+})
@@ -153,6 +153,10 @@ type hrwList struct {
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+//
+// When targets are labeled with failure domains, the returned targets are guaranteed
+// to belong to distinct domains (see fdomain.go); the first (HRW) target is always
+// the same as the one returned by HrwName2T.
 
 func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error) {
 	const fmterr = "%v: required %d, available %d, %s"
@@ -163,6 +167,9 @@ func (smap *Smap) HrwTargetList(uname *string, count int) (sis Nodes, err error)
 	}
 	b := cos.UnsafeBptr(uname)
 	digest := onexxh.Checksum64S(*b, cos.MLCG32)
+	if smap.HasDomains() {
+		return smap.hrwDomainList(digest, count, cnt)
+	}
 	hlist := newHrwList(count)
 
 	for _, tsi := range smap.Tmap {
@@ -208,3 +215,35 @@ func (hl *hrwList) add(weight uint64, sinfo *Snode) {
 		idx--
 	}
 }
+
+// distinct failure domains: walk all targets in HRW order, and take at most one per domain
+func (smap *Smap) hrwDomainList(digest uint64, count, cnt int) (Nodes, error) {
+	hlist := newHrwList(cnt)
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		hlist.add(xoshiro256.Hash(tsi.digest()^digest), tsi)
+	}
+	var (
+		all  = hlist.get()
+		sis  = make(Nodes, 0, count)
+		seen = make(cos.StrSet, count)
+	)
+	for _, tsi := range all {
+		dom := tsi.fdomain()
+		if seen.Contains(dom) {
+			continue
+		}
+		seen.Set(dom)
+		sis = append(sis, tsi)
+		if len(sis) == count {
+			return sis, nil
+		}
+	}
+	if count == cnt {
+		return sis, nil // as many as possible (see above)
+	}
+	return nil, fmt.Errorf("%v: required %d targets in distinct failure domains, available %d, %s",
+		cmn.ErrNotEnoughTargets, count, len(sis), smap)
+}
@@ -78,7 +78,8 @@ type (
 		DaeID      string     `json:"daemon_id"`
 		name       string
 		PubExtra   []NetInfo    `json:"pub_extra,omitempty"`
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Domain     string       `json:"domain,omitempty"` // failure domain (zone, rack) - see fdomain.go
+		Flags      cos.BitFlags `json:"flags"`            // enum { SnodeNonElectable, SnodeIC, ... }
 		IDDigest   uint64       `json:"id_digest"`
 	}
 
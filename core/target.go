@@ -54,6 +54,9 @@ type (
 		Lom      *LOM
 		Msg      *apc.BlobMsg
 		Wfqn     string
+		// standalone (user-started) blob download: checkpoint progress
+		// to be able to resume after restart (see xact/xckpt)
+		Resumable bool
 	}
 )
 
@@ -42,6 +42,7 @@ type (
 
 		// abrt
 		IsAborted() bool
+		IsSuspended() bool // resumable (see xact/xckpt)
 		AbortErr() error
 		AbortedAfter(time.Duration) error
 		ChanAbort() <-chan error
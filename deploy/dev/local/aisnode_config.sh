@@ -181,6 +181,12 @@ cat > $AIS_CONF_FILE <<EOL
 		"data": "${WRITE_POLICY_DATA:-}",
 		"md": "${WRITE_POLICY_MD:-}"
 	},
+	"audit": {
+		"actions":  "",
+		"max_size": "64MiB",
+		"reads":    false,
+		"enabled":  ${AIS_AUDIT_ENABLED:-false}
+	},
 	"rate_limit": {
 		"backend": {
 			"num_retries":       3,
@@ -18,6 +18,12 @@ cat > "$AIS_AUTHN_CONF_DIR/authn.json" <<EOL
 	},
 	"timeout": {
 		"default_timeout": "30s"
+	},
+	"audit": {
+		"actions":  "",
+		"max_size": "64MiB",
+		"reads":    false,
+		"enabled":  ${AIS_AUTHN_AUDIT_ENABLED:-false}
 	}
 }
 EOL
@@ -208,6 +208,7 @@ func Init() {
 	xreg.RegBckXact(&putFactory{})
 	xreg.RegBckXact(&rspFactory{})
 	xreg.RegBckXact(&encFactory{})
+	xreg.RegBckXact(&reencFactory{})
 
 	if err := initManager(); err != nil {
 		cos.ExitLog("Failed to initialize EC manager:", err)
@@ -460,8 +461,9 @@ func WriteSliceAndMeta(hdr *transport.ObjHdr, args *WriteArgs) error {
 			nlog.Errorf("nested error: save replica -> remove metafile: %v", rmErr)
 		}
 	}()
+	var oldMeta *Metadata
 	if args.Generation != 0 {
-		if oldMeta, oldErr := LoadMetadata(ctMeta.FQN()); oldErr == nil && oldMeta.Generation > args.Generation {
+		if oldMeta, _ = LoadMetadata(ctMeta.FQN()); oldMeta != nil && oldMeta.Generation > args.Generation {
 			return nil
 		}
 	}
@@ -477,16 +479,33 @@ func WriteSliceAndMeta(hdr *transport.ObjHdr, args *WriteArgs) error {
 			ctMeta.ObjectName(), ctMeta.Bucket())
 		return err
 	}
-	err = validateBckBID(&hdr.Bck, args.BID)
-	return err
+	if err = validateBckBID(&hdr.Bck, args.BID); err != nil {
+		return err
+	}
+	// re-encoded from replicated to sliced: remove the replica of the previous generation
+	if oldMeta != nil && oldMeta.IsCopy {
+		removeStaleCT(&hdr.Bck, hdr.ObjName, fs.ObjectType)
+	}
+	return nil
+}
+
+func removeStaleCT(bck *cmn.Bck, objName, ctType string) {
+	fqn, _, err := core.HrwFQN(bck, ctType, objName)
+	if err != nil {
+		return
+	}
+	if err := cos.RemoveFile(fqn); err != nil {
+		nlog.Warningln("failed to remove stale", ctType, fqn, "err:", err)
+	}
 }
 
 // WriteReplicaAndMeta saves replica and its metafile
 func WriteReplicaAndMeta(lom *core.LOM, args *WriteArgs) error {
+	var oldMeta *Metadata
 	lom.Lock(false)
 	if args.Generation != 0 {
 		ctMeta := core.NewCTFromLOM(lom, fs.ECMetaType)
-		if oldMeta, oldErr := LoadMetadata(ctMeta.FQN()); oldErr == nil && oldMeta.Generation > args.Generation {
+		if oldMeta, _ = LoadMetadata(ctMeta.FQN()); oldMeta != nil && oldMeta.Generation > args.Generation {
 			lom.Unlock(false)
 			return nil
 		}
@@ -510,6 +529,10 @@ func WriteReplicaAndMeta(lom *core.LOM, args *WriteArgs) error {
 	}
 	ctMeta.Unlock(true)
 	if err == nil {
+		// re-encoded from sliced to replicated: remove the slice of the previous generation
+		if oldMeta != nil && !oldMeta.IsCopy && oldMeta.SliceID != 0 {
+			removeStaleCT(lom.Bucket(), lom.ObjName, fs.ECSliceType)
+		}
 		return nil
 	}
 
@@ -157,7 +157,7 @@ func (c *getJogger) copyMissingReplicas(ctx *restoreCtx, reader cos.ReadOpenClos
 		return err
 	}
 	smap := core.T.Sowner().Get()
-	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), ctx.meta.Parity+1)
+	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), smap.MaxDomainTargets(ctx.meta.Parity+1))
 	if err != nil {
 		return err
 	}
@@ -674,7 +674,7 @@ func (*getJogger) emptyTargets(ctx *restoreCtx) ([]string, error) {
 	}
 	// Generate the list of targets that should have a slice.
 	smap := core.T.Sowner().Get()
-	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), sliceCnt+1)
+	targets, err := smap.HrwTargetList(ctx.lom.UnamePtr(), smap.MaxDomainTargets(sliceCnt+1))
 	if err != nil {
 		nlog.Warningln(err)
 		return nil, err
@@ -17,7 +17,11 @@ import (
 	onexxh "github.com/OneOfOne/xxhash"
 )
 
-const MDVersionLast = 1 // current version of metadata
+// metadata format versions
+const (
+	MDVersionV1   = 1 // pre-profiles
+	MDVersionLast = 2 // current version: adds EC profile name (see cmn.ECProfile)
+)
 
 // Metadata - EC information stored in metafiles for every encoded object
 type Metadata struct {
@@ -29,6 +33,7 @@ type Metadata struct {
 	CksumValue  string           `json:"slice_cksum"`   // slice checksum of the slice if EC is used
 	FullReplica string           `json:"replica_node"`  // daemon ID where full(main) replica is
 	Daemons     cos.MapStrUint16 `json:"nodes"`         // Locations of all slices: DaemonID <-> SliceID
+	Profile     string           `json:"profile"`       // name of the size-tiered EC profile used to encode (empty = default D/P)
 	Data        int              `json:"data_slices"`   // the number of data slices
 	Parity      int              `json:"parity_slices"` // the number of parity slices
 	SliceID     int              `json:"slice_id"`      // 0 for full replica, 1 to N for slices
@@ -42,6 +47,15 @@ var (
 	_ cos.Packer   = (*Metadata)(nil)
 )
 
+// v1 unless encoded with a (non-default) size-tiered profile - to keep the metafiles
+// readable by the targets that do not support profiles (e.g., during rolling upgrade)
+func mdVersion(profile string) uint32 {
+	if profile == "" {
+		return MDVersionV1
+	}
+	return MDVersionLast
+}
+
 func NewMetadata() *Metadata {
 	return &Metadata{MDVersion: MDVersionLast}
 }
@@ -126,10 +140,14 @@ func (md *Metadata) Unpack(unpacker *cos.ByteUnpack) (err error) {
 	}
 	switch md.MDVersion {
 	case MDVersionLast:
-		err = md.unpackLastVersion(unpacker)
+		if err = md.unpackV1(unpacker); err == nil {
+			md.Profile, err = unpacker.ReadString()
+		}
+	case MDVersionV1:
+		err = md.unpackV1(unpacker)
 	default:
-		err = fmt.Errorf("unsupported metadata format version %d. Only %d supported",
-			md.MDVersion, MDVersionLast)
+		err = fmt.Errorf("unsupported metadata format version %d. Only %d and %d supported",
+			md.MDVersion, MDVersionV1, MDVersionLast)
 	}
 	if err != nil {
 		return
@@ -146,7 +164,7 @@ func (md *Metadata) Unpack(unpacker *cos.ByteUnpack) (err error) {
 	return err
 }
 
-func (md *Metadata) unpackLastVersion(unpacker *cos.ByteUnpack) (err error) {
+func (md *Metadata) unpackV1(unpacker *cos.ByteUnpack) (err error) {
 	var i16 uint16
 	if md.Generation, err = unpacker.ReadInt64(); err != nil {
 		return err
@@ -202,6 +220,9 @@ func (md *Metadata) Pack(packer *cos.BytePack) {
 	packer.WriteString(md.CksumType)
 	packer.WriteString(md.CksumValue)
 	packer.WriteMapStrUint16(md.Daemons)
+	if md.MDVersion >= MDVersionLast {
+		packer.WriteString(md.Profile)
+	}
 	h := onexxh.Checksum64S(packer.Bytes(), cos.MLCG32)
 	packer.WriteUint64(h)
 }
@@ -211,8 +232,12 @@ func (md *Metadata) PackedSize() int {
 	for k := range md.Daemons {
 		daemonListSz += cos.PackedStrLen(k) + cos.SizeofI16
 	}
-	return cos.SizeofI32 + cos.SizeofI64*2 + cos.SizeofI16*3 + 1 /*isCopy*/ +
+	sz := cos.SizeofI32 + cos.SizeofI64*2 + cos.SizeofI16*3 + 1 /*isCopy*/ +
 		cos.PackedStrLen(md.ObjCksum) + cos.PackedStrLen(md.ObjVersion) +
 		cos.PackedStrLen(md.CksumType) + cos.PackedStrLen(md.CksumValue) +
 		cos.PackedStrLen(md.FullReplica) + daemonListSz + cos.SizeofI64 /*md cksum*/
+	if md.MDVersion >= MDVersionLast {
+		sz += cos.PackedStrLen(md.Profile)
+	}
+	return sz
 }
@@ -0,0 +1,89 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newTestMeta(version uint32, profile string) *Metadata {
+	return &Metadata{
+		MDVersion:   version,
+		Generation:  1234,
+		Size:        cos.MiB,
+		Data:        4,
+		Parity:      2,
+		SliceID:     3,
+		FullReplica: "t1",
+		ObjCksum:    "abc",
+		ObjVersion:  "2",
+		CksumType:   cos.ChecksumOneXxh,
+		CksumValue:  "def",
+		Daemons:     cos.MapStrUint16{"t1": 0, "t2": 1, "t3": 2},
+		Profile:     profile,
+	}
+}
+
+func TestMDVersion(t *testing.T) {
+	tassert.Errorf(t, mdVersion("") == MDVersionV1, "default (D, P) must be written as v1")
+	tassert.Errorf(t, mdVersion("large") == MDVersionLast, "profiles require v%d", MDVersionLast)
+}
+
+func TestMetaPackUnpack(t *testing.T) {
+	tests := []struct {
+		version uint32
+		profile string // packed
+		expect  string // unpacked
+	}{
+		{MDVersionV1, "", ""},
+		{MDVersionV1, "large", ""}, // (v1 has no profile)
+		{MDVersionLast, "", ""},
+		{MDVersionLast, "large", "large"},
+	}
+	for _, test := range tests {
+		md := newTestMeta(test.version, test.profile)
+		b := md.NewPack()
+		tassert.Fatalf(t, len(b) == md.PackedSize(), "v%d: packed %d, expected size %d", test.version, len(b), md.PackedSize())
+
+		out, err := MetaFromReader(bytes.NewReader(b), int64(len(b)))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, out.MDVersion == test.version, "expecting v%d, got v%d", test.version, out.MDVersion)
+		tassert.Errorf(t, out.Profile == test.expect, "v%d: expecting profile %q, got %q", test.version, test.expect, out.Profile)
+		tassert.Errorf(t, out.Generation == md.Generation && out.Size == md.Size && out.Data == md.Data &&
+			out.Parity == md.Parity && out.SliceID == md.SliceID && out.FullReplica == md.FullReplica &&
+			out.ObjCksum == md.ObjCksum && out.ObjVersion == md.ObjVersion && out.CksumValue == md.CksumValue &&
+			len(out.Daemons) == len(md.Daemons), "v%d: %+v vs %+v", test.version, out, md)
+	}
+
+	// v1 metafile (e.g., written prior to upgrade) and v2 without profile differ only in the trailing profile name
+	v1, v2 := newTestMeta(MDVersionV1, ""), newTestMeta(MDVersionLast, "")
+	tassert.Errorf(t, v2.PackedSize()-v1.PackedSize() == cos.PackedStrLen(""), "v1 %d vs v2 %d", v1.PackedSize(), v2.PackedSize())
+
+	// load from file
+	fqn := filepath.Join(t.TempDir(), "meta")
+	tassert.CheckFatal(t, os.WriteFile(fqn, v1.NewPack(), 0o644))
+	out, err := LoadMetadata(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, out.MDVersion == MDVersionV1 && out.Daemons["t3"] == 2, "unexpected %+v", out)
+}
+
+func TestMetaUnpackErrors(t *testing.T) {
+	// damaged
+	b := newTestMeta(MDVersionLast, "large").NewPack()
+	b[10]++
+	_, err := MetaFromReader(bytes.NewReader(b), int64(len(b)))
+	tassert.Errorf(t, err != nil, "expecting checksum error")
+
+	// unsupported version
+	b = newTestMeta(MDVersionLast+1, "").NewPack()
+	_, err = MetaFromReader(bytes.NewReader(b), int64(len(b)))
+	tassert.Errorf(t, err != nil, "expecting unsupported version error")
+}
@@ -136,8 +136,8 @@ func (c *putJogger) _do(req *request, lom *core.LOM) {
 			}
 			return
 		}
-		ecConf := lom.Bprops().EC
-		memRequired := lom.Lsize() * int64(ecConf.DataSlices+ecConf.ParitySlices) / int64(ecConf.ParitySlices)
+		data, parity, _ := lom.Bprops().EC.Slices(lom.Lsize())
+		memRequired := lom.Lsize() * int64(data+parity) / int64(parity)
 		c.toDisk = useDisk(memRequired, c.parent.config)
 	}
 
@@ -219,17 +219,24 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		nlog.Infof("Encoding %q...", lom)
 	}
 	var (
-		ecConf     = lom.Bprops().EC
-		reqTargets = ecConf.ParitySlices + 1
+		ecConf     = &lom.Bprops().EC
+		data       = ecConf.DataSlices
+		parity     = ecConf.ParitySlices
+		profile    string
+		reqTargets int
 		smap       = core.T.Sowner().Get()
 	)
 	if !req.IsCopy {
-		reqTargets += ecConf.DataSlices
+		data, parity, profile = ecConf.Slices(lom.Lsize())
 	}
-	targetCnt := smap.CountActiveTs()
+	reqTargets = parity + 1
+	if !req.IsCopy {
+		reqTargets += data
+	}
+	targetCnt := smap.CountActiveDomains()
 	if targetCnt < reqTargets {
 		return fmt.Errorf("%v: given EC config (d=%d, p=%d), %d targets required to encode %s (have %d, %s)",
-			cmn.ErrNotEnoughTargets, ecConf.DataSlices, ecConf.ParitySlices, reqTargets, lom, targetCnt, smap.StringEx())
+			cmn.ErrNotEnoughTargets, data, parity, reqTargets, lom, targetCnt, smap.StringEx())
 	}
 
 	var (
@@ -237,12 +244,16 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		generation            = mono.NanoTime()
 		cksumType, cksumValue = lom.Checksum().Get()
 	)
+	// previous generation, if any (re-encoding)
+	prev, _ := LoadMetadata(ctMeta.FQN())
+
 	md := &Metadata{
-		MDVersion:   MDVersionLast,
+		MDVersion:   mdVersion(profile),
 		Generation:  generation,
 		Size:        lom.Lsize(),
-		Data:        ecConf.DataSlices,
-		Parity:      ecConf.ParitySlices,
+		Data:        data,
+		Parity:      parity,
+		Profile:     profile,
 		IsCopy:      req.IsCopy,
 		ObjCksum:    cksumValue,
 		CksumType:   cksumType,
@@ -289,14 +300,48 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		}
 		return fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
 	}
+	if prev != nil {
+		c.cleanupStale(lom, prev, md)
+	}
 	return nil
 }
 
+// upon re-encoding: remove slices and replicas of the previous generation
+// from the targets that are not part of the new one
+func (c *putJogger) cleanupStale(lom *core.LOM, prev, md *Metadata) {
+	nodes := staleTargets(core.T.Sowner().Get(), prev, md)
+	if len(nodes) == 0 {
+		return
+	}
+	request := newIntraReq(reqDel, nil, lom.Bck()).NewPack(g.smm)
+	o := transport.AllocSend()
+	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqDel}
+	o.Hdr.Bck.Copy(lom.Bucket())
+	o.Callback = c.ctSendCallback
+	c.parent.IncPending()
+	if err := c.parent.mgr.req().Send(o, nil, nodes...); err != nil {
+		nlog.Warningln("failed to cleanup stale slices of", lom.Cname(), "err:", err)
+	}
+}
+
+// targets that have slices (or replicas) of the previous generation but not of the new one
+func staleTargets(smap *meta.Smap, prev, md *Metadata) (nodes []*meta.Snode) {
+	for tid := range prev.Daemons {
+		if _, ok := md.Daemons[tid]; ok || tid == core.T.SID() {
+			continue
+		}
+		if tsi := smap.GetTarget(tid); tsi != nil {
+			nodes = append(nodes, tsi)
+		}
+	}
+	return nodes
+}
+
 func (*putJogger) newCtx(lom *core.LOM, md *Metadata) (ctx *encodeCtx, err error) {
 	ctx = allocCtx()
 	ctx.lom = lom
-	ctx.dataSlices = lom.Bprops().EC.DataSlices
-	ctx.paritySlices = lom.Bprops().EC.ParitySlices
+	ctx.dataSlices = md.Data
+	ctx.paritySlices = md.Parity
 	ctx.md = md
 
 	totalCnt := ctx.paritySlices + ctx.dataSlices
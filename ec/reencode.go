@@ -0,0 +1,177 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-ec-reencode: walks erasure coded bucket and converts each (locally stored HRW) object
+// whose metafile does not match the bucket's current EC configuration - (D, P), size-tiered
+// profiles (see cmn.ECProfile), and replicate vs. encode (see ObjSizeLimit).
+// When targets are labeled with failure domains, the same applies to objects
+// whose slices (or replicas) share a domain (see meta.Smap.DistinctDomains).
+//
+// Re-encoding is online: the main replica remains in place and available throughout;
+// the new generation of slices (or replicas) overwrites the previous one, after which
+// the targets that are no longer part of the new generation get cleaned up
+// (see putJogger.cleanupStale).
+
+type (
+	reencFactory struct {
+		xreg.RenewBase
+		xctn *XactBckReencode
+	}
+	XactBckReencode struct {
+		wg   sync.WaitGroup // pending (async) encodings
+		smap *meta.Smap
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactBckReencode)(nil)
+	_ xreg.Renewable = (*reencFactory)(nil)
+)
+
+//////////////////
+// reencFactory //
+//////////////////
+
+func (*reencFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &reencFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *reencFactory) Start() error {
+	bck := p.Bck
+	if !bck.Props.EC.Enabled {
+		return fmt.Errorf("EC is disabled for %s", bck.Cname(""))
+	}
+	if len(fs.GetAvail()) == 0 {
+		return cmn.ErrNoMountpaths
+	}
+	xctn := newXactBckReencode(p.UUID(), bck)
+	p.xctn = xctn
+	go xctn.Run(nil)
+	return nil
+}
+
+func (*reencFactory) Kind() string     { return apc.ActECReencode }
+func (p *reencFactory) Get() core.Xact { return p.xctn }
+
+func (*reencFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+/////////////////////
+// XactBckReencode //
+/////////////////////
+
+func newXactBckReencode(uuid string, bck *meta.Bck) (r *XactBckReencode) {
+	r = &XactBckReencode{smap: core.T.Sowner().Get()}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.do,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActECReencode, bck.Props.EC.String(), bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactBckReencode) Run(*sync.WaitGroup) {
+	ECM.incActive(r)
+	nlog.Infoln(r.Name())
+
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil {
+		r.AddErr(err)
+	}
+	r.wg.Wait()
+	r.Finish()
+}
+
+func (r *XactBckReencode) do(lom *core.LOM, _ []byte) error {
+	if _, local, err := lom.HrwTarget(r.smap); err != nil || !local {
+		return err
+	}
+	md, err := ObjectMetadata(lom.Bck(), lom.ObjName)
+	if err != nil && !os.IsNotExist(err) {
+		nlog.Warningln(r.Name(), "failed to load metafile of", lom.Cname(), "err:", err, "- re-encoding")
+		md = nil
+	}
+	if md != nil && r.conforms(lom, md) {
+		return nil
+	}
+
+	r.wg.Add(1)
+	if err := ECM.EncodeObject(lom, r.done); err != nil {
+		r.done(lom, err)
+		if err == ErrorECDisabled {
+			r.Abort(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// whether the object's current generation matches bucket's EC configuration
+// and (when the targets are labeled) is spread across distinct failure domains
+func (r *XactBckReencode) conforms(lom *core.LOM, md *Metadata) bool {
+	var (
+		ecConf = &lom.Bprops().EC
+		size   = lom.Lsize()
+	)
+	if IsECCopy(size, ecConf) {
+		if !md.IsCopy || md.Parity != ecConf.ParitySlices {
+			return false
+		}
+	} else {
+		data, parity, profile := ecConf.Slices(size)
+		if md.IsCopy || md.Data != data || md.Parity != parity || md.Profile != profile {
+			return false
+		}
+	}
+	if !r.smap.HasDomains() {
+		return true
+	}
+	tids := make([]string, 0, len(md.Daemons))
+	for tid := range md.Daemons {
+		tids = append(tids, tid)
+	}
+	return r.smap.DistinctDomains(tids) == nil
+}
+
+func (r *XactBckReencode) done(lom *core.LOM, err error) {
+	switch err {
+	case nil:
+		r.LomAdd(lom)
+	case errSkipped:
+	default:
+		r.AddErr(cmn.NewErrFailedTo(core.T, "re-encode", lom.Cname(), err), 0)
+	}
+	r.wg.Done()
+}
+
+func (r *XactBckReencode) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
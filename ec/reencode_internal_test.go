@@ -0,0 +1,119 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// 3 racks x 2 targets (unless unlabeled)
+func newTestSmap(labeled bool) *meta.Smap {
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, 6), Pmap: make(meta.NodeMap)}
+	for i := range 6 {
+		si := &meta.Snode{}
+		si.Init(fmt.Sprintf("t%d", i), apc.Target)
+		if labeled {
+			si.Domain = fmt.Sprintf("rack%d", i%3)
+		}
+		smap.Tmap[si.ID()] = si
+	}
+	return smap
+}
+
+func TestReencodeConforms(t *testing.T) {
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+
+	bck := meta.NewBck("reenc", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x31})
+	bck.Props.EC = cmn.ECConf{
+		Enabled:      true,
+		DataSlices:   2,
+		ParitySlices: 1,
+		ObjSizeLimit: cos.KiB,
+		Profiles:     []cmn.ECProfile{{Name: "large", MinSize: cos.MiB, DataSlices: 4, ParitySlices: 2}},
+	}
+	mock.NewTarget(mock.NewBaseBownerMock(bck))
+
+	newLOM := func(size int64) *core.LOM {
+		lom := core.AllocLOM("obj")
+		tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+		lom.SetSize(size)
+		return lom
+	}
+	var (
+		r       = &XactBckReencode{smap: newTestSmap(false)}
+		small   = newLOM(100)
+		medium  = newLOM(cos.KiB * 10)
+		large   = newLOM(cos.MiB * 2)
+		daemons = cos.MapStrUint16{"t0": 0, "t1": 1, "t2": 2}
+	)
+	defer func() {
+		core.FreeLOM(small)
+		core.FreeLOM(medium)
+		core.FreeLOM(large)
+	}()
+	tests := []struct {
+		lom *core.LOM
+		md  *Metadata
+		ok  bool
+	}{
+		{small, &Metadata{IsCopy: true, Parity: 1}, true},
+		{small, &Metadata{IsCopy: true, Parity: 2}, false},
+		{small, &Metadata{Data: 2, Parity: 1}, false}, // (encoded but must be replicated)
+		{medium, &Metadata{Data: 2, Parity: 1}, true},
+		{medium, &Metadata{Data: 3, Parity: 1}, false},
+		{medium, &Metadata{IsCopy: true, Parity: 1}, false},
+		{medium, &Metadata{Data: 2, Parity: 1, Profile: "large"}, false},
+		{large, &Metadata{Data: 4, Parity: 2, Profile: "large"}, true},
+		{large, &Metadata{Data: 2, Parity: 1}, false}, // (encoded prior to adding profiles)
+		{large, &Metadata{Data: 4, Parity: 2}, false},
+	}
+	for i, test := range tests {
+		test.md.Daemons = daemons
+		ok := r.conforms(test.lom, test.md)
+		tassert.Errorf(t, ok == test.ok, "%d: %s (size %d), %+v: expecting conforms=%t", i, test.lom.Cname(), test.lom.Lsize(), test.md, test.ok)
+	}
+
+	// failure domains
+	r.smap = newTestSmap(true)
+	md := &Metadata{Data: 2, Parity: 1, Daemons: daemons}
+	tassert.Errorf(t, r.conforms(medium, md), "expecting t0, t1, t2 in distinct domains")
+	md.Daemons = cos.MapStrUint16{"t0": 0, "t1": 1, "t3": 2}
+	tassert.Errorf(t, !r.conforms(medium, md), "t0 and t3 share a domain - must be re-encoded")
+}
+
+func TestStaleTargets(t *testing.T) {
+	mock.NewTarget(mock.NewBaseBownerMock())
+	var (
+		smap = newTestSmap(false)
+		sid  = core.T.SID()
+		prev = &Metadata{Daemons: cos.MapStrUint16{sid: 0, "t1": 1, "t2": 2, "t3": 3, "t-gone": 4}}
+		md   = &Metadata{Daemons: cos.MapStrUint16{sid: 0, "t1": 1, "t4": 2}}
+	)
+	nodes := staleTargets(smap, prev, md)
+	tids := make([]string, 0, len(nodes))
+	for _, tsi := range nodes {
+		tids = append(tids, tsi.ID())
+	}
+	tassert.Fatalf(t, len(tids) == 2, "expecting t2 and t3, got %v", tids)
+	for _, tid := range tids {
+		tassert.Errorf(t, tid == "t2" || tid == "t3", "unexpected stale %s", tid)
+	}
+
+	// same placement
+	tassert.Errorf(t, len(staleTargets(smap, md, md)) == 0, "expecting nothing to clean up")
+}
@@ -109,21 +109,29 @@ func (b *etlBootstrapper) createServiceSpec() {
 }
 
 func (b *etlBootstrapper) setupConnection(schema string) (err error) {
-	// Retrieve host IP of the pod.
-	var hostIP string
-	if hostIP, err = b._getHost(); err != nil {
-		return err
-	}
+	if isLocal() {
+		lp := lprocs.get(b.pod.Name)
+		if lp == nil {
+			return cmn.NewErrETL(b.errCtx, "local process not found")
+		}
+		b.podAddr = lp.addr
+	} else {
+		// Retrieve host IP of the pod.
+		var hostIP string
+		if hostIP, err = b._getHost(); err != nil {
+			return err
+		}
 
-	// Retrieve assigned port by the service.
-	var nodePort int
-	if nodePort, err = b._getPort(); err != nil {
-		return err
+		// Retrieve assigned port by the service.
+		var nodePort int
+		if nodePort, err = b._getPort(); err != nil {
+			return err
+		}
+		b.podAddr = hostIP + ":" + strconv.Itoa(nodePort)
 	}
 
 	// the pod must be reachable via its tcp addr
 	var ecode int
-	b.podAddr = hostIP + ":" + strconv.Itoa(nodePort)
 	if ecode, err = b.dial(); err != nil {
 		if cmn.Rom.FastV(4, cos.SmoduleETL) {
 			nlog.Warningf("%s: failed to dial %s [%+v, %s]", b.msg, b.podAddr, b.errCtx, b.uri)
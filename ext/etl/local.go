@@ -0,0 +1,407 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/sys"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Local ETL runtime (feature flag `feat.LocalETL`, non-Kubernetes deployments only):
+// - the same pod spec (and the same `InitSpecMsg`) is used to run the transformer as a child process of the target
+// - the process command line is the container's (command + args), with K8s-style $(VAR) expansion
+// - the process listens on `LocalPortEnv` port allocated by the target (and provided via environment)
+// - alternatively, `LocalAddrAnnotation` specifies the address of an already running (operator-managed) transformer
+// - readiness is determined by the container's readinessProbe (HTTP GET) - same as in K8s
+// - the process' stdout and stderr are retained in memory (up to `maxLocalLogs`) for `ais etl view-logs`
+// - communicators (hpush://, hpull://, io://, ws://) remain unchanged
+
+const (
+	LocalAddrAnnotation = "local_address" // host:port of a running transformer; when specified, the target won't spawn the process
+	LocalPortEnv        = "AIS_ETL_PORT"  // the port the (spawned) transformer must listen on
+)
+
+const (
+	maxLocalLogs      = 4 * cos.MiB
+	localStopTimeout  = 10 * time.Second
+	localProbeTimeout = 5 * time.Second // (compare with _updReady)
+)
+
+type (
+	localProc struct {
+		cmd      *exec.Cmd
+		logs     *localLogs
+		exited   chan struct{}
+		addr     string // host:port
+		probe    string // readiness probe path
+		exitCode int
+		err      error
+		stopping bool
+		// metrics
+		cpuTotal  uint64 // ms
+		cpuSample int64  // mono
+		mu        sync.Mutex
+	}
+	localProcs struct {
+		m   map[string]*localProc // by pod name
+		mtx sync.Mutex
+	}
+	// bounded in-memory (stdout, stderr) log
+	localLogs struct {
+		b  []byte
+		mu sync.Mutex
+	}
+)
+
+var lprocs = localProcs{m: make(map[string]*localProc, 4)}
+
+// ETL can run (or, at least, be configured) on this node
+func Enabled() bool {
+	return k8s.IsK8s() || cmn.Rom.Features().IsSet(feat.LocalETL)
+}
+
+func isLocal() bool { return !k8s.IsK8s() }
+
+//
+// bootstrapper: local counterparts of the K8s (create pod, wait ready, setup connection) sequence
+//
+
+func (b *etlBootstrapper) startProc(pw *podWatcher) error {
+	if !cmn.Rom.Features().IsSet(feat.LocalETL) {
+		return cmn.NewErrETL(b.errCtx, k8s.ErrK8sRequired.Error()+" (or, feature flag \"Local-ETL\")")
+	}
+	ctr := &b.pod.Spec.Containers[0]
+	lp := &localProc{probe: ctr.ReadinessProbe.HTTPGet.Path, exited: make(chan struct{})}
+
+	// operator-managed
+	if addr := b.pod.Annotations[LocalAddrAnnotation]; addr != "" {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return cmn.NewErrETLf(b.errCtx, "invalid %s annotation %q: %v", LocalAddrAnnotation, addr, err)
+		}
+		lp.addr = addr
+		lprocs.add(b.pod.Name, lp)
+		return nil
+	}
+
+	argv := append(append([]string{}, ctr.Command...), ctr.Args...) // (io:// - see _updPodCommand)
+	if len(argv) == 0 {
+		return cmn.NewErrETLf(b.errCtx, "container %q: expecting either command or %s annotation", ctr.Name, LocalAddrAnnotation)
+	}
+
+	port, err := freePort()
+	if err != nil {
+		return cmn.NewErrETL(b.errCtx, err.Error())
+	}
+	host := core.T.Snode().PubNet.Hostname
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	lp.addr = net.JoinHostPort(host, strconv.Itoa(port))
+
+	env := b._procEnv(ctr, port)
+	for i := range argv {
+		argv[i] = k8sExpand(argv[i], env)
+	}
+
+	lp.logs = &localLogs{}
+	lp.cmd = exec.Command(argv[0], argv[1:]...) //nolint:gosec // (admin-only; enabled by feature flag)
+	lp.cmd.Dir = ctr.WorkingDir
+	lp.cmd.Stdout, lp.cmd.Stderr = lp.logs, lp.logs
+	lp.cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	for k, v := range env {
+		lp.cmd.Env = append(lp.cmd.Env, k+"="+v)
+	}
+	if err := lp.cmd.Start(); err != nil {
+		return cmn.NewErrETLf(b.errCtx, "failed to start %q: %v", argv[0], err)
+	}
+	lprocs.add(b.pod.Name, lp)
+	go lp.wait(pw, ctr.Name)
+
+	if cmn.Rom.FastV(4, cos.SmoduleETL) {
+		nlog.Infof("started local %s: pid %d, %q, listening on %s", b.msg.String(), lp.cmd.Process.Pid, argv, lp.addr)
+	}
+	return nil
+}
+
+// environment: a few basic variables inherited from the target, container's env, and the same
+// AIS-provided variables as in K8s (see _setPodEnv)
+func (b *etlBootstrapper) _procEnv(ctr *corev1.Container, port int) map[string]string {
+	env := make(map[string]string, len(ctr.Env)+8)
+	for _, k := range []string{"PATH", "HOME", "TMPDIR", "LANG", "VIRTUAL_ENV", "PYTHONPATH"} {
+		if v, ok := os.LookupEnv(k); ok {
+			env[k] = v
+		}
+	}
+	for _, ev := range ctr.Env { // (already includes AIS_TARGET_URL et al.)
+		env[ev.Name] = ev.Value
+	}
+	env[LocalPortEnv] = strconv.Itoa(port)
+	return env
+}
+
+func (b *etlBootstrapper) waitProcReady(ctx context.Context) error {
+	var (
+		timeout  = b.msg.Timeout.D()
+		interval = cos.ProbingFrequency(timeout)
+		lp       = lprocs.get(b.pod.Name)
+	)
+	if lp == nil {
+		return cmn.NewErrETL(b.errCtx, "local process not found")
+	}
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, /*immediate*/
+		func(context.Context) (bool, error) {
+			select {
+			case <-lp.exited:
+				return false, fmt.Errorf("process exited (code %d): %v", lp.exitCode, lp.err)
+			default:
+			}
+			return lp.ready(), nil
+		},
+	)
+	if err != nil {
+		return cmn.NewErrETL(b.errCtx, err.Error())
+	}
+	return nil
+}
+
+//
+// localProc
+//
+
+// wait for the process to exit; unless stopped via `Stop` (and `cleanupEntities`), the
+// termination is treated the same way as the ETL pod's container terminating unexpectedly
+func (lp *localProc) wait(pw *podWatcher, cname string) {
+	err := lp.cmd.Wait()
+
+	lp.mu.Lock()
+	lp.err = err
+	lp.exitCode = lp.cmd.ProcessState.ExitCode()
+	stopping := lp.stopping
+	lp.mu.Unlock()
+	close(lp.exited)
+
+	if stopping {
+		return
+	}
+	exitCode := int32(lp.exitCode)
+	if exitCode == 0 {
+		exitCode = -1 // (not expected to terminate)
+	}
+	nlog.Errorln("local ETL process", lp.cmd.Process.Pid, "terminated:", err)
+	pw.terminated(cname, "ProcessExited", lp.logs.tail(1024), exitCode)
+}
+
+func (lp *localProc) ready() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), localProbeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+lp.addr+lp.probe, http.NoBody)
+	if err != nil {
+		return false
+	}
+	resp, err := core.T.DataClient().Do(req)
+	if err != nil {
+		return false
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusBadRequest
+}
+
+func (lp *localProc) running() bool {
+	if lp.cmd == nil {
+		return lp.ready() // operator-managed
+	}
+	select {
+	case <-lp.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// SIGTERM the process group, wait, and SIGKILL if need be
+func (lp *localProc) stop() {
+	if lp.cmd == nil {
+		return
+	}
+	lp.mu.Lock()
+	lp.stopping = true
+	lp.mu.Unlock()
+
+	pid := lp.cmd.Process.Pid
+	if err := syscall.Kill(-pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		nlog.Warningln("failed to terminate local ETL process", pid, err)
+	}
+	select {
+	case <-lp.exited:
+	case <-time.After(localStopTimeout):
+		nlog.Warningln("local ETL process", pid, "did not terminate in", localStopTimeout, "- killing it")
+		syscall.Kill(-pid, syscall.SIGKILL)
+		<-lp.exited
+	}
+}
+
+// (compare with K8s pod phase)
+func (lp *localProc) health() string {
+	if lp.running() {
+		return string(corev1.PodRunning)
+	}
+	return string(corev1.PodFailed)
+}
+
+func (lp *localProc) metrics() (float64 /*cores*/, int64 /*mem*/, error) {
+	if lp.cmd == nil {
+		return 0, 0, errors.New("metrics are not available for operator-managed local ETL")
+	}
+	if !lp.running() {
+		return 0, 0, cos.NewErrNotFound(core.T, "local ETL process (pid "+strconv.Itoa(lp.cmd.Process.Pid)+")")
+	}
+	stats, err := sys.ProcessStats(lp.cmd.Process.Pid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// CPU usage in cores: since the previous call (or since the process started)
+	lp.mu.Lock()
+	now := mono.NanoTime()
+	if lp.cpuSample == 0 {
+		lp.cpuSample = now - int64(time.Second)
+	}
+	var (
+		ms    = stats.CPU.Total - min(stats.CPU.Total, lp.cpuTotal)
+		cores = float64(ms) / float64(time.Duration(now-lp.cpuSample).Milliseconds()+1)
+	)
+	lp.cpuTotal, lp.cpuSample = stats.CPU.Total, now
+	lp.mu.Unlock()
+
+	return cores, int64(stats.Mem.Resident), nil
+}
+
+////////////////
+// localProcs //
+////////////////
+
+func (lps *localProcs) add(name string, lp *localProc) {
+	lps.mtx.Lock()
+	lps.m[name] = lp
+	lps.mtx.Unlock()
+}
+
+func (lps *localProcs) get(name string) (lp *localProc) {
+	lps.mtx.Lock()
+	lp = lps.m[name]
+	lps.mtx.Unlock()
+	return lp
+}
+
+// (local counterpart of deleteEntity)
+func (lps *localProcs) del(name string) {
+	lps.mtx.Lock()
+	lp, ok := lps.m[name]
+	delete(lps.m, name)
+	lps.mtx.Unlock()
+	if ok {
+		lp.stop()
+	}
+}
+
+///////////////
+// localLogs //
+///////////////
+
+func (ll *localLogs) Write(p []byte) (int, error) {
+	ll.mu.Lock()
+	ll.b = append(ll.b, p...)
+	if l := len(ll.b); l > maxLocalLogs {
+		ll.b = append(ll.b[:0], ll.b[l-maxLocalLogs/2:]...)
+	}
+	ll.mu.Unlock()
+	return len(p), nil
+}
+
+func (ll *localLogs) bytes() []byte {
+	ll.mu.Lock()
+	b := append([]byte(nil), ll.b...)
+	ll.mu.Unlock()
+	return b
+}
+
+func (ll *localLogs) tail(n int) string {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+	if len(ll.b) > n {
+		return string(ll.b[len(ll.b)-n:])
+	}
+	return string(ll.b)
+}
+
+//
+// helpers
+//
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return 0, fmt.Errorf("failed to allocate local port: %v", err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	cos.Close(l)
+	return port, nil
+}
+
+// K8s-style $(VAR) expansion: undefined variables remain as is; $$ escapes
+func k8sExpand(s string, env map[string]string) string {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 >= len(s) {
+			out = append(out, s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '$':
+			out = append(out, '$')
+			i++
+		case '(':
+			j := i + 2
+			for j < len(s) && s[j] != ')' {
+				j++
+			}
+			if j == len(s) {
+				out = append(out, s[i:]...)
+				return string(out)
+			}
+			if v, ok := env[s[i+2:j]]; ok {
+				out = append(out, v...)
+			} else {
+				out = append(out, s[i:j+1]...)
+			}
+			i = j
+		default:
+			out = append(out, s[i])
+		}
+	}
+	return string(out)
+}
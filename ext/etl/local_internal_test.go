@@ -0,0 +1,131 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("LocalRuntime", func() {
+	newBoot := func(name string, command []string, annotations map[string]string) *etlBootstrapper {
+		pod := &corev1.Pod{}
+		pod.SetName(name)
+		pod.Annotations = annotations
+		pod.Spec.Containers = []corev1.Container{{
+			Name:           "server",
+			Command:        command,
+			Env:            []corev1.EnvVar{{Name: "GREETING", Value: "hello"}},
+			ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/health"}}},
+		}}
+		return &etlBootstrapper{
+			errCtx: &cmn.ETLErrCtx{ETLName: name},
+			msg:    InitSpecMsg{InitMsgBase: InitMsgBase{EtlName: name, CommTypeX: Hpush, Timeout: cos.Duration(5 * time.Second)}},
+			pod:    pod,
+		}
+	}
+
+	BeforeEach(func() {
+		_ = mock.NewTarget(mock.NewBaseBownerMock(meta.NewBck("local", apc.AIS, cmn.NsGlobal, &cmn.Bprops{})))
+		config := cmn.GCO.BeginUpdate()
+		config.Features = feat.LocalETL
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+	})
+
+	AfterEach(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Features = 0
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+	})
+
+	It("should expand K8s-style variables", func() {
+		env := map[string]string{"A": "1", "B": "two"}
+		Expect(k8sExpand("--port=$(A)", env)).To(Equal("--port=1"))
+		Expect(k8sExpand("$(A)$(B)", env)).To(Equal("1two"))
+		Expect(k8sExpand("$(C) $$(A) $A", env)).To(Equal("$(C) $(A) $A"))
+		Expect(k8sExpand("$(A", env)).To(Equal("$(A"))
+	})
+
+	It("should retain the most recent logs", func() {
+		ll := &localLogs{}
+		chunk := []byte(strings.Repeat("x", cos.MiB-1) + "\n")
+		for range 5 {
+			ll.Write(chunk)
+		}
+		ll.Write([]byte("last"))
+		b := ll.bytes()
+		Expect(len(b)).To(BeNumerically("<=", maxLocalLogs))
+		Expect(ll.tail(4)).To(Equal("last"))
+	})
+
+	It("should spawn, capture output, and stop local process", func() {
+		boot := newBoot("local-spawn", []string{"sh", "-c", "echo $(GREETING) $(" + LocalPortEnv + "); exec sleep 60"}, nil)
+		pw := newPodWatcher(boot.pod.Name, boot)
+		Expect(pw.start()).NotTo(HaveOccurred())
+		defer pw.stop(false)
+
+		Expect(boot.startProc(pw)).NotTo(HaveOccurred())
+		lp := lprocs.get(boot.pod.Name)
+		Expect(lp).NotTo(BeNil())
+		Expect(lp.health()).To(Equal(string(corev1.PodRunning)))
+
+		_, port, _ := strings.Cut(lp.addr, ":")
+		Eventually(func() string { return string(lp.logs.bytes()) }, 5*time.Second).
+			Should(Equal("hello " + port + "\n"))
+
+		Expect(cleanupEntities(boot.errCtx, boot.pod.Name, boot.pod.Name)).NotTo(HaveOccurred())
+		Expect(lp.exited).To(BeClosed())
+		Expect(lprocs.get(boot.pod.Name)).To(BeNil())
+	})
+
+	It("should connect to operator-managed transformer", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/health" {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer srv.Close()
+
+		addr := strings.TrimPrefix(srv.URL, "http://")
+		boot := newBoot("local-addr", nil, map[string]string{LocalAddrAnnotation: addr})
+		pw := newPodWatcher(boot.pod.Name, boot)
+		Expect(pw.start()).NotTo(HaveOccurred())
+		defer pw.stop(false)
+
+		Expect(boot.startProc(pw)).NotTo(HaveOccurred())
+		Expect(boot.waitProcReady(context.Background())).NotTo(HaveOccurred())
+		Expect(boot.setupConnection("http://")).NotTo(HaveOccurred())
+		Expect(boot.uri).To(Equal("http://" + addr))
+		Expect(lprocs.get(boot.pod.Name).health()).To(Equal(string(corev1.PodRunning)))
+
+		Expect(cleanupEntities(boot.errCtx, boot.pod.Name, boot.pod.Name)).NotTo(HaveOccurred())
+	})
+
+	It("should require feature flag", func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Features = 0
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+
+		boot := newBoot("local-disabled", []string{"true"}, nil)
+		Expect(boot.startProc(newPodWatcher(boot.pod.Name, boot))).To(HaveOccurred())
+	})
+})
@@ -58,16 +58,7 @@ func (pw *podWatcher) processEvents() {
 				continue
 			}
 			if exitCode := pw._process(pod); exitCode != 0 {
-				// pw.boot.xctn is not yet assigned in init error
-				if pw.boot == nil || pw.boot.xctn == nil {
-					return
-				}
-				pw.boot.errCtx.PodStatus = pw.GetPodStatus()
-				if pw.boot.xctn.Abort(cmn.NewErrETL(pw.boot.errCtx, ctrTerminated)) {
-					// After Finish() call succeed, proxy will be notified and broadcast to call etl.Stop()
-					// on all targets (including the current one) with the `abortErr`. No need to call Stop() again here.
-					pw.boot.xctn.Finish()
-				}
+				pw.abort()
 				return
 			}
 		case <-pw.stopCh.Listen():
@@ -78,6 +69,25 @@ func (pw *podWatcher) processEvents() {
 	}
 }
 
+func (pw *podWatcher) abort() {
+	// pw.boot.xctn is not yet assigned in init error
+	if pw.boot == nil || pw.boot.xctn == nil {
+		return
+	}
+	pw.boot.errCtx.PodStatus = pw.GetPodStatus()
+	if pw.boot.xctn.Abort(cmn.NewErrETL(pw.boot.errCtx, ctrTerminated)) {
+		// After Finish() call succeed, proxy will be notified and broadcast to call etl.Stop()
+		// on all targets (including the current one) with the `abortErr`. No need to call Stop() again here.
+		pw.boot.xctn.Finish()
+	}
+}
+
+// local runtime: the process terminated unexpectedly (see localProc.wait)
+func (pw *podWatcher) terminated(cname, reason, message string, exitCode int32) {
+	pw.setPodStatus(ctrTerminated, cname, reason, message, exitCode)
+	pw.abort()
+}
+
 // _process analyzes the pod's container states and updates the pod watcher.
 // Returns the ExitCode if any container terminated unexpectedly; otherwise, returns 0.
 func (pw *podWatcher) _process(pod *corev1.Pod) int32 {
@@ -120,6 +130,12 @@ func (pw *podWatcher) _process(pod *corev1.Pod) int32 {
 }
 
 func (pw *podWatcher) start() error {
+	if isLocal() {
+		// nothing to watch (see localProc.wait)
+		pw.stopCh = cos.NewStopCh()
+		pw.podCtx, pw.podCtxCancel = context.WithCancel(context.Background())
+		return nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return err
@@ -143,6 +159,10 @@ func (pw *podWatcher) start() error {
 func (pw *podWatcher) stop(wait bool) {
 	// Notify the `pw.processEvents()` goroutine to exit through stopCh
 	pw.stopCh.Close()
+	if pw.watcher == nil { // local
+		pw.podCtxCancel()
+		return
+	}
 	pw.watcher.Stop()
 
 	// Wait for `pw.processEvents()` to terminate, which will trigger `pw.podCtx` cancellation
@@ -168,6 +168,10 @@ func InitCode(msg *InitCodeMsg, xid, secret string) (core.Xact, error) {
 		ftp      = fromToPairs(msg)
 		replacer = strings.NewReplacer(ftp...)
 	)
+	if isLocal() {
+		return nil, cmn.NewErrETLf(&cmn.ETLErrCtx{TID: core.T.SID(), ETLName: msg.Name()},
+			"%s: init-code requires Kubernetes (local runtime supports init-spec only)", msg)
+	}
 	r, exists := runtime.Get(msg.Runtime)
 	debug.Assert(exists, msg.Runtime) // must've been checked by proxy
 
@@ -220,7 +224,12 @@ func fromToPairs(msg *InitCodeMsg) (ftp []string) {
 
 // cleanupEntities removes provided entities. It tries its best to remove all
 // entities so it doesn't stop when encountering an error.
+// (local runtime: terminates the process, if any)
 func cleanupEntities(errCtx *cmn.ETLErrCtx, podName, svcName string) (err error) {
+	if isLocal() {
+		lprocs.del(podName)
+		return nil
+	}
 	if svcName != "" {
 		if deleteErr := deleteEntity(errCtx, k8s.Svc, svcName); deleteErr != nil {
 			err = deleteErr
@@ -290,25 +299,31 @@ func start(msg *InitSpecMsg, xid, secret string, opts StartOpts, config *cmn.Con
 	err = cleanupEntities(errCtx, boot.pod.Name, boot.svc.Name)
 	debug.AssertNoErr(err)
 
-	// 4. Creating Kubernetes resources.
-	if err = boot.createEntity(k8s.Svc); err != nil {
-		goto cleanup
-	}
-
-	if err = boot.createEntity(k8s.Pod); err != nil {
-		goto cleanup
-	}
-
-	// 5. Waiting for pod's readiness
-	if err = boot.waitPodReady(pw.podCtx); err != nil {
-		goto cleanup
+	// 4. Creating Kubernetes resources (or, local process) and waiting for readiness
+	if isLocal() {
+		if err = boot.startProc(pw); err != nil {
+			goto cleanup
+		}
+		if err = boot.waitProcReady(pw.podCtx); err != nil {
+			goto cleanup
+		}
+	} else {
+		if err = boot.createEntity(k8s.Svc); err != nil {
+			goto cleanup
+		}
+		if err = boot.createEntity(k8s.Pod); err != nil {
+			goto cleanup
+		}
+		if err = boot.waitPodReady(pw.podCtx); err != nil {
+			goto cleanup
+		}
 	}
 
 	if err = comm.SetupConnection(); err != nil {
 		goto cleanup
 	}
 
-	// 6. Transition to the Running stage if everything succeeds
+	// 5. Transition to the Running stage if everything succeeds
 	if !mgr.transition(msg.Name(), Running) {
 		err = fmt.Errorf("etl[%s] fail to transition to Running stage", msg.Name())
 		goto cleanup
@@ -390,9 +405,6 @@ func Delete(etlName string) error {
 
 // StopAll terminates all running ETLs.
 func StopAll() {
-	if !k8s.IsK8s() {
-		return
-	}
 	for _, e := range List() {
 		if err := Stop(e.Name, nil); err != nil {
 			nlog.Errorln(err)
@@ -449,6 +461,13 @@ func PodLogs(transformID string) (logs Logs, err error) {
 	if err != nil {
 		return logs, err
 	}
+	if isLocal() {
+		lp := lprocs.get(c.PodName())
+		if lp == nil || lp.logs == nil {
+			return logs, cos.NewErrNotFound(core.T, "local process for "+transformID)
+		}
+		return Logs{TargetID: core.T.SID(), Logs: lp.logs.bytes()}, nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return logs, err
@@ -468,6 +487,13 @@ func PodHealth(etlName string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if isLocal() {
+		lp := lprocs.get(c.PodName())
+		if lp == nil {
+			return "", cos.NewErrNotFound(core.T, "local process for "+etlName)
+		}
+		return lp.health(), nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return "", err
@@ -480,6 +506,17 @@ func PodMetrics(etlName string) (*CPUMemUsed, error) {
 	if err != nil {
 		return nil, err
 	}
+	if isLocal() {
+		lp := lprocs.get(c.PodName())
+		if lp == nil {
+			return nil, cos.NewErrNotFound(core.T, "local process for "+etlName)
+		}
+		cpuUsed, memUsed, err := lp.metrics()
+		if err != nil {
+			return nil, err
+		}
+		return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}, nil
+	}
 	client, err := k8s.GetClient()
 	if err != nil {
 		return nil, err
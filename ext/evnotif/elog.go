@@ -0,0 +1,103 @@
+// Package evnotif delivers bucket (object) event notifications to HTTP webhooks
+// and target-local event log
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package evnotif
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// target-local event log: <log_dir>/events.jsonl, rotated as events.jsonl.1 (most recent)
+// through events.jsonl.<logMaxFiles>
+
+const (
+	logName     = "events.jsonl"
+	logMaxSize  = 64 * cos.MiB
+	logMaxFiles = 4 // rotated, in addition to the current one
+)
+
+type elog struct {
+	fh      *os.File
+	fqn     string
+	size    int64
+	maxSize int64
+	mu      sync.Mutex
+}
+
+func newElog(dir string) *elog {
+	return &elog{fqn: filepath.Join(dir, logName), maxSize: logMaxSize}
+}
+
+func (l *elog) append(line []byte) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.fh == nil {
+		if err := l.open(); err != nil {
+			return err
+		}
+	}
+	if l.size+int64(len(line))+1 > l.maxSize && l.size > 0 {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.fh.Write(append(line, '\n'))
+	l.size += int64(n)
+	return err
+}
+
+func (l *elog) open() error {
+	if err := cos.CreateDir(filepath.Dir(l.fqn)); err != nil {
+		return err
+	}
+	fh, err := os.OpenFile(l.fqn, os.O_WRONLY|os.O_CREATE|os.O_APPEND, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		fh.Close()
+		return err
+	}
+	l.fh, l.size = fh, finfo.Size()
+	return nil
+}
+
+// under lock
+func (l *elog) rotate() error {
+	l._close()
+	for i := logMaxFiles - 1; i > 0; i-- {
+		src := fmt.Sprintf("%s.%d", l.fqn, i)
+		if err := os.Rename(src, fmt.Sprintf("%s.%d", l.fqn, i+1)); err != nil && !os.IsNotExist(err) {
+			nlog.Errorln("failed to rotate event log", src, "err:", err)
+		}
+	}
+	if err := os.Rename(l.fqn, l.fqn+".1"); err != nil {
+		return err
+	}
+	return l.open()
+}
+
+func (l *elog) close() {
+	l.mu.Lock()
+	l._close()
+	l.mu.Unlock()
+}
+
+func (l *elog) _close() {
+	if l.fh == nil {
+		return
+	}
+	if err := l.fh.Close(); err != nil {
+		nlog.Errorln("failed to close event log", l.fqn, "err:", err)
+	}
+	l.fh = nil
+}
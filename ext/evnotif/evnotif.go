@@ -0,0 +1,115 @@
+// Package evnotif delivers bucket (object) event notifications to HTTP webhooks
+// and target-local event log
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package evnotif
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Events (see cmn.EventsConf) get generated by the target that stores (or stored) the
+// object in question, and are delivered on a best-effort basis:
+// - webhooks: one POST per event, asynchronously, in order per endpoint; failed
+//   deliveries are retried with exponential backoff; when the endpoint's queue is
+//   full, new events are dropped (and counted as errors)
+// - event log: appended synchronously, one JSON-encoded event per line; the log
+//   gets rotated upon reaching `logMaxSize`
+
+type (
+	Event struct {
+		Type    string `json:"event"`             // cmn.EvObj* enum
+		Bucket  string `json:"bucket"`            // e.g. "ais://abc"
+		Name    string `json:"name"`              // object name
+		Src     string `json:"src,omitempty"`     // renamed: original name
+		Version string `json:"version,omitempty"` // object version, if available
+		RuleID  string `json:"rule_id"`           // the rule that selected this event
+		Node    string `json:"node"`              // target ID
+		Size    int64  `json:"size,omitempty"`
+		Time    int64  `json:"time"` // unix nano
+	}
+)
+
+var g struct {
+	hooks  map[string]*hook // by webhook URL
+	log    *elog
+	su     cos.StatsUpdater
+	client *http.Client
+	tid    string
+	mu     sync.Mutex
+}
+
+// called once upon target startup
+func Init(tid, logDir string, su cos.StatsUpdater) {
+	g.tid, g.su = tid, su
+	g.hooks = make(map[string]*hook, 4)
+	g.log = newElog(logDir)
+	g.client = cmn.NewClient(cmn.TransportArgs{Timeout: hookTimeout, UseHTTPProxyEnv: true})
+}
+
+// called upon graceful shutdown
+func Term() {
+	g.mu.Lock()
+	for _, h := range g.hooks {
+		close(h.ch)
+	}
+	clear(g.hooks)
+	g.mu.Unlock()
+	if g.log != nil {
+		g.log.close()
+	}
+}
+
+// Emit generates events for all rules that select (typ, objName); no-op
+// when the bucket doesn't have (or has disabled) event notifications
+func Emit(bck *meta.Bck, typ, objName, src, version string, size int64) {
+	conf := &bck.Props.Events
+	if !conf.IsActive() || g.hooks == nil {
+		return
+	}
+	var (
+		vlabs map[string]string
+		now   = time.Now().UnixNano()
+	)
+	for i := range conf.Rules {
+		rule := &conf.Rules[i]
+		if !rule.Match(typ, objName) {
+			continue
+		}
+		if vlabs == nil {
+			vlabs = map[string]string{stats.VlabBucket: bck.Cname("")}
+		}
+		ev := &Event{
+			Type:    typ,
+			Bucket:  bck.Cname(""),
+			Name:    objName,
+			Src:     src,
+			Version: version,
+			RuleID:  rule.ID,
+			Node:    g.tid,
+			Size:    size,
+			Time:    now,
+		}
+		body := cos.MustMarshal(ev)
+		if rule.Log {
+			if err := g.log.append(body); err != nil {
+				nlog.ErrorDepth(1, "failed to log", typ, "event for", bck.Cname(objName), "err:", err)
+				g.su.IncWith(stats.ErrEventCount, vlabs)
+			} else {
+				g.su.IncWith(stats.EventCount, vlabs)
+			}
+		}
+		if rule.Webhook != "" {
+			post(rule.Webhook, body, vlabs)
+		}
+	}
+}
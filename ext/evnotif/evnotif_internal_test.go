@@ -0,0 +1,129 @@
+// Package evnotif delivers bucket (object) event notifications to HTTP webhooks
+// and target-local event log
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package evnotif
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newTestBck(rules ...cmn.EventRule) *meta.Bck {
+	props := &cmn.Bprops{Events: cmn.EventsConf{Rules: rules, Enabled: true}}
+	return meta.NewBck("evnotif", apc.AIS, cmn.NsGlobal, props)
+}
+
+func readLog(t *testing.T, fqn string) (evs []Event) {
+	fh, err := os.Open(fqn)
+	tassert.CheckFatal(t, err)
+	defer fh.Close()
+	scanner := bufio.NewScanner(fh)
+	for scanner.Scan() {
+		var ev Event
+		tassert.CheckFatal(t, json.Unmarshal(scanner.Bytes(), &ev))
+		evs = append(evs, ev)
+	}
+	return evs
+}
+
+func TestEventLog(t *testing.T) {
+	dir := t.TempDir()
+	Init("t1", dir, mock.NewStatsTracker())
+	defer Term()
+
+	bck := newTestBck(
+		cmn.EventRule{ID: "jpg", Events: []string{cmn.EvObjCreated, cmn.EvObjRenamed}, Prefix: "img/", Suffix: ".jpg", Log: true},
+		cmn.EventRule{ID: "del", Events: []string{cmn.EvObjDeleted}, Log: true},
+	)
+	tassert.CheckFatal(t, bck.Props.Events.ValidateAsProps())
+
+	Emit(bck, cmn.EvObjCreated, "img/a.jpg", "", "1", 100)
+	Emit(bck, cmn.EvObjCreated, "img/a.png", "", "", 100) // no match: suffix
+	Emit(bck, cmn.EvObjCreated, "doc/a.jpg", "", "", 100) // no match: prefix
+	Emit(bck, cmn.EvObjEvicted, "img/a.jpg", "", "", 100) // no match: event type
+	Emit(bck, cmn.EvObjRenamed, "img/b.jpg", "img/a.jpg", "", 100)
+	Emit(bck, cmn.EvObjDeleted, "doc/a.jpg", "", "", 0)
+
+	evs := readLog(t, filepath.Join(dir, logName))
+	tassert.Fatalf(t, len(evs) == 3, "expecting 3 events, got %d", len(evs))
+	tassert.Errorf(t, evs[0].Type == cmn.EvObjCreated && evs[0].Name == "img/a.jpg" && evs[0].RuleID == "jpg" &&
+		evs[0].Node == "t1" && evs[0].Version == "1" && evs[0].Size == 100, "unexpected %+v", evs[0])
+	tassert.Errorf(t, evs[1].Type == cmn.EvObjRenamed && evs[1].Src == "img/a.jpg", "unexpected %+v", evs[1])
+	tassert.Errorf(t, evs[2].Type == cmn.EvObjDeleted && evs[2].RuleID == "del", "unexpected %+v", evs[2])
+
+	// disabled
+	bck.Props.Events.Enabled = false
+	Emit(bck, cmn.EvObjDeleted, "doc/b.jpg", "", "", 0)
+	evs = readLog(t, filepath.Join(dir, logName))
+	tassert.Errorf(t, len(evs) == 3, "expecting no events when disabled, got %d", len(evs))
+}
+
+func TestEventLogRotate(t *testing.T) {
+	dir := t.TempDir()
+	Init("t1", dir, mock.NewStatsTracker())
+	defer Term()
+	g.log.maxSize = 512
+
+	bck := newTestBck(cmn.EventRule{ID: "all", Events: cmn.EvObjAll, Log: true})
+	for range 100 {
+		Emit(bck, cmn.EvObjCreated, "obj", "", "", 1)
+	}
+	for i := 1; i <= logMaxFiles; i++ {
+		finfo, err := os.Stat(filepath.Join(dir, logName) + "." + strconv.Itoa(i))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, finfo.Size() <= 512, "rotated log exceeds max size: %d", finfo.Size())
+	}
+	_, err := os.Stat(filepath.Join(dir, logName) + "." + strconv.Itoa(logMaxFiles+1))
+	tassert.Errorf(t, os.IsNotExist(err), "expecting at most %d rotated logs", logMaxFiles)
+}
+
+func TestWebhook(t *testing.T) {
+	var (
+		calls    atomic.Int32
+		received = make(chan Event, 10)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable) // retry
+			return
+		}
+		var ev Event
+		if err := json.NewDecoder(r.Body).Decode(&ev); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- ev
+	}))
+	defer srv.Close()
+
+	Init("t1", t.TempDir(), mock.NewStatsTracker())
+	defer Term()
+
+	bck := newTestBck(cmn.EventRule{ID: "hook", Events: []string{cmn.EvObjColdGet}, Webhook: srv.URL})
+	tassert.CheckFatal(t, bck.Props.Events.ValidateAsProps())
+	Emit(bck, cmn.EvObjColdGet, "obj", "", "", 1)
+
+	select {
+	case ev := <-received:
+		tassert.Errorf(t, ev.Type == cmn.EvObjColdGet && ev.Name == "obj" && ev.Bucket == bck.Cname(""), "unexpected %+v", ev)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for webhook delivery")
+	}
+	tassert.Errorf(t, calls.Load() == 2, "expecting 2 calls (one retry), got %d", calls.Load())
+}
@@ -0,0 +1,123 @@
+// Package evnotif delivers bucket (object) event notifications to HTTP webhooks
+// and target-local event log
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package evnotif
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+const (
+	hookQueue    = 1024
+	hookRetries  = 5
+	hookTimeout  = 10 * time.Second
+	hookIdle     = 5 * time.Minute // stop idle sender
+	hookMinPause = time.Second
+	hookMaxPause = 30 * time.Second
+)
+
+type (
+	// per-endpoint sender
+	hook struct {
+		ch  chan *delivery
+		url string
+	}
+	delivery struct {
+		vlabs map[string]string
+		body  []byte
+	}
+)
+
+// enqueue (non-blocking), start sender if need be
+func post(url string, body []byte, vlabs map[string]string) {
+	d := &delivery{body: body, vlabs: vlabs}
+	g.mu.Lock()
+	h, ok := g.hooks[url]
+	if !ok {
+		h = &hook{url: url, ch: make(chan *delivery, hookQueue)}
+		g.hooks[url] = h
+		go h.run()
+	}
+	select {
+	case h.ch <- d:
+		g.mu.Unlock()
+	default:
+		g.mu.Unlock()
+		nlog.Warningln("webhook", url, "queue is full - dropping event")
+		g.su.IncWith(stats.ErrEventCount, vlabs)
+	}
+}
+
+func (h *hook) run() {
+	timer := time.NewTimer(hookIdle)
+	defer timer.Stop()
+	for {
+		select {
+		case d, ok := <-h.ch:
+			if !ok {
+				return // terminated
+			}
+			if err := h.deliver(d.body); err != nil {
+				nlog.Errorln("webhook", h.url, "failed to deliver event:", err)
+				g.su.IncWith(stats.ErrEventCount, d.vlabs)
+			} else {
+				g.su.IncWith(stats.EventCount, d.vlabs)
+			}
+			timer.Reset(hookIdle)
+		case <-timer.C:
+			g.mu.Lock()
+			if len(h.ch) == 0 {
+				delete(g.hooks, h.url)
+				g.mu.Unlock()
+				return
+			}
+			g.mu.Unlock()
+			timer.Reset(hookIdle)
+		}
+	}
+}
+
+// POST with retries; 4xx responses (other than 408 and 429) are not retried
+func (h *hook) deliver(body []byte) (err error) {
+	pause := hookMinPause
+	for i := 0; ; i++ {
+		var retry bool
+		if retry, err = h.do(body); err == nil || !retry || i >= hookRetries {
+			return err
+		}
+		time.Sleep(pause)
+		pause = min(pause*2, hookMaxPause)
+	}
+}
+
+func (h *hook) do(body []byte) (retry bool, _ error) {
+	req, err := http.NewRequest(http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+
+	switch code := resp.StatusCode; {
+	case code >= http.StatusOK && code < http.StatusMultipleChoices:
+		return false, nil
+	case code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError:
+		return true, fmt.Errorf("status %d", code)
+	default:
+		return false, fmt.Errorf("status %d", code)
+	}
+}
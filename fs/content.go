@@ -38,6 +38,8 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+
+	ObjVersionType = "ov" // noncurrent object versions (see versioning.max_versions)
 )
 
 type (
@@ -73,6 +75,8 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+
+	ObjVersionContentResolver struct{}
 )
 
 var CSM *contentSpecMgr
@@ -83,6 +87,7 @@ var (
 	_ ContentResolver = (*WorkfileContentResolver)(nil)
 	_ ContentResolver = (*ECSliceContentResolver)(nil)
 	_ ContentResolver = (*ECMetaContentResolver)(nil)
+	_ ContentResolver = (*ObjVersionContentResolver)(nil)
 )
 
 func (f *contentSpecMgr) Resolver(contentType string) ContentResolver {
@@ -217,3 +222,30 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// noncurrent version: <object-name>.v<version>, where version is a decimal number
+const objVersionSepa = ".v"
+
+func (*ObjVersionContentResolver) GenUniqueFQN(base, version string) string {
+	return base + objVersionSepa + version
+}
+
+func (r *ObjVersionContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	orig, _, ok = r.ParseVersion(base)
+	return orig, false, ok
+}
+
+// splits (object name, version)
+func (*ObjVersionContentResolver) ParseVersion(name string) (objName, version string, ok bool) {
+	i := strings.LastIndex(name, objVersionSepa)
+	if i <= 0 || i+len(objVersionSepa) == len(name) {
+		return "", "", false
+	}
+	version = name[i+len(objVersionSepa):]
+	for _, c := range version {
+		if c < '0' || c > '9' {
+			return "", "", false
+		}
+	}
+	return name[:i], version, true
+}
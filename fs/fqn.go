@@ -200,6 +200,8 @@ func CleanPathErr(err error) {
 			what = "ec slice"
 		case ECMetaType:
 			what = "ec metadata"
+		case ObjVersionType:
+			what = "object version"
 		default:
 			what = fmt.Sprintf("content type '%s'(?)", parsed.ContentType)
 		}
@@ -392,6 +392,29 @@ func TestMakeAndParseFQN(t *testing.T) {
 	}
 }
 
+func TestObjVersionResolver(t *testing.T) {
+	r := &fs.ObjVersionContentResolver{}
+	tests := []struct {
+		objName, version string
+	}{
+		{"obj", "1"},
+		{"a/b/c.tar", "123"},
+		{"obj.v7", "2"},
+		{"dir.v1/obj", "10"},
+	}
+	for _, tt := range tests {
+		name := r.GenUniqueFQN(tt.objName, tt.version)
+		objName, version, ok := r.ParseVersion(name)
+		tassert.Fatalf(t, ok, "failed to parse %q", name)
+		tassert.Errorf(t, objName == tt.objName && version == tt.version,
+			"%q: expected (%q, %q), got (%q, %q)", name, tt.objName, tt.version, objName, version)
+	}
+	for _, name := range []string{"obj", "obj.v", ".v1", "obj.vx", "obj.v1a"} {
+		_, _, ok := r.ParseVersion(name)
+		tassert.Errorf(t, !ok, "%q: expected to fail", name)
+	}
+}
+
 func BenchmarkParseFQN(b *testing.B) {
 	var (
 		mpath = "/tmp/mpath"
@@ -0,0 +1,289 @@
+// Package lsidx: persistent per-bucket index of locally stored object names
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package lsidx
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+
+	"github.com/tidwall/btree"
+)
+
+// Each target maintains (an optional) list-objects index for the buckets that have
+// feat.ListIndex enabled. The index contains sorted names of the objects for which
+// the target is the HRW location; it gets updated incrementally upon PUT, DELETE,
+// rename (copy+delete), and rebalance (receive), and is periodically persisted in
+// the bucket's directory on the bucket's HRW mountpath.
+//
+// An index becomes usable ("ready") only after it's been fully built - see
+// `ActLsoIndex` xaction - or loaded from disk upon (clean) restart. Readers must
+// tolerate stale entries that can remain, e.g., when an object is removed behind
+// the index's back; rebuilding the index takes care of it.
+
+const (
+	hkName    = "lsidx" + hk.NameSuffix
+	flushIval = 10 * time.Minute
+)
+
+type (
+	Index struct {
+		names *btree.Set[string]  // non-nil when ready
+		next  *btree.Set[string]  // non-nil when building
+		dels  map[string]struct{} // names deleted while building
+		bck   cmn.Bck
+		mu    sync.RWMutex
+		bid   uint64
+		dirty bool
+		gone  bool // dropped
+	}
+)
+
+var (
+	g struct {
+		all map[string]*Index // by bucket cname
+		mu  sync.RWMutex
+		pmu sync.Mutex // serializes persistence
+	}
+)
+
+func init() {
+	g.all = make(map[string]*Index, 4)
+}
+
+// called once upon target startup;
+// after unclean shutdown persisted indexes cannot be trusted and get removed
+func Init(unclean bool) {
+	if unclean {
+		removeAll()
+	}
+	hk.Reg(hkName, flush, flushIval)
+}
+
+func get(bck *cmn.Bck, bid uint64) *Index {
+	key := bck.Cname("")
+	g.mu.RLock()
+	idx := g.all[key]
+	g.mu.RUnlock()
+	if idx != nil && idx.bid == bid {
+		return idx
+	}
+
+	g.mu.Lock()
+	if idx = g.all[key]; idx != nil && idx.bid == bid {
+		g.mu.Unlock()
+		return idx
+	}
+	if idx != nil {
+		idx.mu.Lock()
+		idx.gone = true // (bucket re-created)
+		idx.mu.Unlock()
+	}
+	idx = &Index{bck: *bck, bid: bid}
+	idx.mu.Lock()
+	g.all[key] = idx
+	g.mu.Unlock()
+
+	idx.load()
+	idx.mu.Unlock()
+	return idx
+}
+
+// Get returns ready-to-use index or nil
+func Get(bck *cmn.Bck, bid uint64) *Index {
+	idx := get(bck, bid)
+	idx.mu.RLock()
+	ready := idx.names != nil
+	idx.mu.RUnlock()
+	if ready {
+		return idx
+	}
+	return nil
+}
+
+func Add(bck *cmn.Bck, bid uint64, name string) {
+	idx := get(bck, bid)
+	idx.mu.Lock()
+	if idx.names != nil {
+		idx.names.Insert(name)
+		idx.dirty = true
+	}
+	if idx.next != nil {
+		idx.next.Insert(name)
+		delete(idx.dels, name)
+	}
+	idx.mu.Unlock()
+}
+
+func Del(bck *cmn.Bck, bid uint64, name string) {
+	idx := get(bck, bid)
+	idx.mu.Lock()
+	if idx.names != nil {
+		idx.names.Delete(name)
+		idx.dirty = true
+	}
+	if idx.next != nil {
+		idx.next.Delete(name)
+		idx.dels[name] = struct{}{}
+	}
+	idx.mu.Unlock()
+}
+
+// Drop removes both in-memory and persisted index (e.g., when the bucket is destroyed,
+// or when feat.ListIndex gets disabled)
+func Drop(bck *cmn.Bck) {
+	key := bck.Cname("")
+	g.mu.Lock()
+	idx := g.all[key]
+	delete(g.all, key)
+	g.mu.Unlock()
+	if idx != nil {
+		idx.mu.Lock()
+		idx.gone = true
+		idx.names, idx.next, idx.dels = nil, nil, nil
+		idx.mu.Unlock()
+	}
+	g.pmu.Lock()
+	removeFile(bck)
+	g.pmu.Unlock()
+}
+
+//
+// build
+//
+
+// StartBuild returns false if the index is already being built
+// NOTE: until the build completes, previously built (ready) index, if any, remains in service
+func StartBuild(bck *cmn.Bck, bid uint64) (*Index, bool) {
+	idx := get(bck, bid)
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if idx.next != nil {
+		return idx, false
+	}
+	idx.next = &btree.Set[string]{}
+	idx.dels = make(map[string]struct{}, 16)
+	return idx, true
+}
+
+func (idx *Index) BuildAdd(name string) {
+	idx.mu.Lock()
+	if idx.next != nil {
+		if _, ok := idx.dels[name]; !ok {
+			idx.next.Insert(name)
+		}
+	}
+	idx.mu.Unlock()
+}
+
+// EndBuild completes the build and persists the result; when `ok` is false
+// (the build has been aborted) the (partially) built index gets discarded
+func (idx *Index) EndBuild(ok bool) {
+	idx.mu.Lock()
+	if idx.next == nil {
+		idx.mu.Unlock()
+		return
+	}
+	if ok && !idx.gone {
+		idx.names = idx.next
+		idx.dirty = true
+	}
+	idx.next, idx.dels = nil, nil
+	idx.mu.Unlock()
+
+	if ok {
+		idx.persist()
+	}
+}
+
+//
+// read
+//
+
+func (idx *Index) Len() (n int) {
+	idx.mu.RLock()
+	if idx.names != nil {
+		n = idx.names.Len()
+	}
+	idx.mu.RUnlock()
+	return n
+}
+
+// Page appends to `out` up to `limit` sorted names that start with `prefix` and are
+// greater than or equal `from`; returns false if the index is no longer usable
+func (idx *Index) Page(prefix, from string, limit int, out []string) ([]string, bool) {
+	pivot := max(prefix, from)
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	if idx.names == nil {
+		return out, false
+	}
+	idx.names.Ascend(pivot, func(name string) bool {
+		if !strings.HasPrefix(name, prefix) {
+			return false
+		}
+		out = append(out, name)
+		return len(out) < limit
+	})
+	return out, true
+}
+
+//
+// persistence
+//
+
+func (idx *Index) persist() {
+	g.pmu.Lock()
+	defer g.pmu.Unlock()
+
+	idx.mu.Lock()
+	if idx.names == nil || !idx.dirty || idx.gone {
+		idx.mu.Unlock()
+		return
+	}
+	names := idx.names.Copy() // copy-on-write
+	idx.dirty = false
+	idx.mu.Unlock()
+
+	if err := store(&idx.bck, idx.bid, names); err != nil {
+		nlog.Errorln("failed to persist list-objects index", idx.bck.Cname(""), "err:", err)
+		idx.mu.Lock()
+		idx.dirty = true
+		idx.mu.Unlock()
+	}
+}
+
+// under (idx) lock
+func (idx *Index) load() {
+	names, err := restore(&idx.bck, idx.bid)
+	if err != nil {
+		nlog.Warningln("discarding list-objects index", idx.bck.Cname(""), "err:", err)
+		removeFile(&idx.bck)
+		return
+	}
+	idx.names = names // nil when not found
+}
+
+// PersistAll is called upon graceful shutdown
+func PersistAll() {
+	g.mu.RLock()
+	all := make([]*Index, 0, len(g.all))
+	for _, idx := range g.all {
+		all = append(all, idx)
+	}
+	g.mu.RUnlock()
+	for _, idx := range all {
+		idx.persist()
+	}
+}
+
+func flush(int64) time.Duration {
+	PersistAll()
+	return flushIval
+}
@@ -0,0 +1,125 @@
+// Package lsidx: persistent per-bucket index of locally stored object names
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package lsidx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const testBID = 0x1234
+
+func initTestMpath(t *testing.T) {
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+}
+
+// forget in-memory state, to subsequently load from disk
+func forget(bck *cmn.Bck) {
+	g.mu.Lock()
+	delete(g.all, bck.Cname(""))
+	g.mu.Unlock()
+}
+
+func TestIndexBuildUpdatePage(t *testing.T) {
+	initTestMpath(t)
+	bck := &cmn.Bck{Name: "lsidx-page", Provider: apc.AIS}
+	defer Drop(bck)
+
+	tassert.Fatalf(t, Get(bck, testBID) == nil, "expecting no index prior to build")
+
+	idx, ok := StartBuild(bck, testBID)
+	tassert.Fatalf(t, ok, "failed to start build")
+	_, ok = StartBuild(bck, testBID)
+	tassert.Fatalf(t, !ok, "expecting build in progress")
+
+	for i := range 100 {
+		idx.BuildAdd(fmt.Sprintf("a/%03d", i))
+	}
+	// concurrent updates while building
+	Del(bck, testBID, "a/010")
+	idx.BuildAdd("a/010") // (walked after having been deleted)
+	Add(bck, testBID, "b/000")
+	tassert.Fatalf(t, Get(bck, testBID) == nil, "index must not be served while building")
+
+	idx.EndBuild(true)
+	tassert.Fatalf(t, Get(bck, testBID) != nil, "expecting ready index")
+	tassert.Errorf(t, idx.Len() == 100, "expecting 100 names, got %d", idx.Len())
+
+	// paginate with prefix
+	var (
+		from  string
+		names []string
+		all   []string
+	)
+	for {
+		names, ok = idx.Page("a/", from, 7, names[:0])
+		tassert.Fatalf(t, ok, "index unusable")
+		if len(names) == 0 {
+			break
+		}
+		all = append(all, names...)
+		from = names[len(names)-1] + "\x00"
+	}
+	tassert.Fatalf(t, len(all) == 99, "expecting 99 names, got %d", len(all))
+	for i := 1; i < len(all); i++ {
+		tassert.Fatalf(t, all[i-1] < all[i], "not sorted: %q, %q", all[i-1], all[i])
+	}
+
+	// incremental updates
+	Add(bck, testBID, "a/100")
+	Del(bck, testBID, "a/000")
+	names, _ = idx.Page("a/", "", 1, names[:0])
+	tassert.Errorf(t, len(names) == 1 && names[0] == "a/001", "unexpected first name %v", names)
+	names, _ = idx.Page("a/1", "", 10, names[:0])
+	tassert.Errorf(t, len(names) == 1 && names[0] == "a/100", "unexpected %v", names)
+}
+
+func TestIndexPersist(t *testing.T) {
+	initTestMpath(t)
+	bck := &cmn.Bck{Name: "lsidx-persist", Provider: apc.AIS, Ns: cmn.Ns{Name: "ns"}}
+	defer Drop(bck)
+
+	idx, _ := StartBuild(bck, testBID)
+	for i := range 1000 {
+		idx.BuildAdd(fmt.Sprintf("dir-%d/obj-%04d", i%10, i))
+	}
+	idx.EndBuild(true)
+	Add(bck, testBID, "zzz")
+	PersistAll()
+
+	forget(bck)
+	idx = Get(bck, testBID)
+	tassert.Fatalf(t, idx != nil, "failed to load persisted index")
+	tassert.Errorf(t, idx.Len() == 1001, "expecting 1001 names, got %d", idx.Len())
+	names, _ := idx.Page("zz", "", 10, nil)
+	tassert.Errorf(t, len(names) == 1, "expecting 'zzz', got %v", names)
+
+	// different BID (bucket re-created): discard
+	forget(bck)
+	tassert.Fatalf(t, Get(bck, testBID+1) == nil, "expecting persisted index to be discarded")
+}
+
+func TestIndexAbortDrop(t *testing.T) {
+	initTestMpath(t)
+	bck := &cmn.Bck{Name: "lsidx-drop", Provider: apc.AIS}
+
+	idx, _ := StartBuild(bck, testBID)
+	idx.BuildAdd("a")
+	idx.EndBuild(false)
+	tassert.Fatalf(t, Get(bck, testBID) == nil, "aborted build must not produce index")
+
+	idx, _ = StartBuild(bck, testBID)
+	idx.BuildAdd("a")
+	idx.EndBuild(true)
+	Drop(bck)
+	tassert.Fatalf(t, Get(bck, testBID) == nil, "expecting no index after drop")
+}
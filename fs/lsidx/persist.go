@@ -0,0 +1,190 @@
+// Package lsidx: persistent per-bucket index of locally stored object names
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package lsidx
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+
+	"github.com/pierrec/lz4/v4"
+	"github.com/tidwall/btree"
+)
+
+// on-disk format (lz4 stream):
+// magic | version | BID | count | count x (uvarint length, name) | magic
+
+const (
+	magic   = "aislsidx"
+	version = 1
+
+	maxNameLen = 64 * cos.KiB
+	bufSize    = 64 * cos.KiB
+)
+
+func fpath(bck *cmn.Bck) (string, error) {
+	mi, _, err := fs.Hrw(bck.MakeUname(""))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(mi.MakePathBck(bck), fname.LsIndex), nil
+}
+
+func store(bck *cmn.Bck, bid uint64, names *btree.Set[string]) error {
+	fqn, err := fpath(bck)
+	if err != nil {
+		return err
+	}
+	tmp := fqn + ".tmp"
+	fh, err := cos.CreateFile(tmp)
+	if err != nil {
+		return err
+	}
+	var (
+		zw  = lz4.NewWriter(fh)
+		bw  = bufio.NewWriterSize(zw, bufSize)
+		hdr = make([]byte, 0, 32)
+	)
+	hdr = append(hdr, magic...)
+	hdr = append(hdr, version)
+	hdr = binary.AppendUvarint(hdr, bid)
+	hdr = binary.AppendUvarint(hdr, uint64(names.Len()))
+	_, err = bw.Write(hdr)
+	names.Scan(func(name string) bool {
+		if err != nil {
+			return false
+		}
+		var l [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(l[:], uint64(len(name)))
+		if _, err = bw.Write(l[:n]); err == nil {
+			_, err = bw.WriteString(name)
+		}
+		return err == nil
+	})
+	if err == nil {
+		_, err = bw.WriteString(magic)
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = zw.Close()
+	}
+	if errC := cos.FlushClose(fh); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = cos.Rename(tmp, fqn)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(tmp); errRm != nil {
+			nlog.Errorln("nested err:", errRm)
+		}
+	}
+	return err
+}
+
+// returns (nil, nil) when not found
+func restore(bck *cmn.Bck, bid uint64) (*btree.Set[string], error) {
+	fqn, err := fpath(bck)
+	if err != nil {
+		return nil, err
+	}
+	fh, err := os.Open(fqn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer fh.Close()
+
+	br := bufio.NewReaderSize(lz4.NewReader(fh), bufSize)
+	m := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, m); err != nil {
+		return nil, err
+	}
+	if string(m) != magic {
+		return nil, errors.New("invalid format")
+	}
+	ver, err := br.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if ver != version {
+		return nil, fmt.Errorf("unsupported version %d", ver)
+	}
+	fbid, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	if fbid != bid {
+		return nil, fmt.Errorf("BID mismatch: %d vs %d", fbid, bid)
+	}
+	cnt, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		names = &btree.Set[string]{}
+		buf   = make([]byte, 256)
+	)
+	for range cnt {
+		l, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		if l == 0 || l > maxNameLen {
+			return nil, fmt.Errorf("invalid name length %d", l)
+		}
+		if int(l) > cap(buf) {
+			buf = make([]byte, l)
+		}
+		if _, err := io.ReadFull(br, buf[:l]); err != nil {
+			return nil, err
+		}
+		names.Load(string(buf[:l])) // sorted
+	}
+	if _, err := io.ReadFull(br, m); err != nil || string(m) != magic {
+		return nil, errors.New("truncated or corrupted")
+	}
+	return names, nil
+}
+
+func removeFile(bck *cmn.Bck) {
+	fqn, err := fpath(bck)
+	if err != nil {
+		return
+	}
+	if err := cos.RemoveFile(fqn); err != nil {
+		nlog.Errorln("failed to remove list-objects index", fqn, "err:", err)
+	}
+}
+
+// remove all persisted indexes on all mountpaths, namespaced buckets included
+func removeAll() {
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		for _, pattern := range []string{"*/*", "*/*/*"} {
+			fqns, _ := filepath.Glob(filepath.Join(mi.Path, pattern, fname.LsIndex))
+			for _, fqn := range fqns {
+				if err := cos.RemoveFile(fqn); err != nil {
+					nlog.Errorln("failed to remove list-objects index", fqn, "err:", err)
+				}
+			}
+		}
+	}
+}
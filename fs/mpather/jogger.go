@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -43,12 +44,14 @@ type (
 		Slab        *memsys.Slab
 		Bck         cmn.Bck
 		Buckets     cmn.Bcks
+		StartAfter  map[string]string // resume: [mountpath => last visited object name] (see Jgroup.Progress)
 		Prefix      string
 		CTs         []string
 		DoLoad      LoadType // if specified, lom.Load(lock type)
 		IncludeCopy bool     // visit copies (aka replicas)
 		PerBucket   bool     // num joggers = (num mountpaths) x (num buckets)
 		Throttle    bool     // true: pace itself depending on disk utilization
+		Sorted      bool     // walk in deterministic (sorted) order; required to track progress and resume
 	}
 
 	// Jgroup runs jogger per mountpath which walk the entire bucket and
@@ -69,10 +72,16 @@ type (
 		mi        *fs.Mountpath
 		bdir      string // mi.MakePath(bck)
 		objPrefix string // fully-qualified prefix, as in: join(bdir, opts.Prefix)
+		odir      string // same as bdir, when tracking progress (opts.Sorted)
+		after     string // skip objects that precede (in the walk order) or equal this one
 		config    *cmn.Config
 		stopCh    cos.StopCh
 		buf       []byte
-		numvis    atomic.Int64 // counter: num visited objects
+		last      struct {
+			name string // last visited object
+			mu   sync.Mutex
+		}
+		numvis atomic.Int64 // counter: num visited objects
 	}
 )
 
@@ -139,6 +148,22 @@ func (jg *Jgroup) NumVisits() (n int64) {
 	return n
 }
 
+// Progress returns the last visited object name per mountpath (see also: JgroupOpts.StartAfter);
+// requires JgroupOpts.Sorted
+func (jg *Jgroup) Progress(out map[string]string) map[string]string {
+	if out == nil {
+		out = make(map[string]string, len(jg.joggers))
+	}
+	for mpath, jogger := range jg.joggers {
+		jogger.last.mu.Lock()
+		if name := jogger.last.name; name != "" {
+			out[mpath] = name
+		}
+		jogger.last.mu.Unlock()
+	}
+	return out
+}
+
 func (jg *Jgroup) Run() {
 	for _, jogger := range jg.joggers {
 		jg.wg.Go(jogger.run)
@@ -173,6 +198,16 @@ func newJogger(ctx context.Context, opts *JgroupOpts, mi *fs.Mountpath, config *
 		j.bdir = mi.MakePathCT(&j.opts.Bck, fs.ObjectType) // this mountpath's bucket dir that contains objects
 		j.objPrefix = filepath.Join(j.bdir, opts.Prefix)
 	}
+	if opts.Sorted {
+		debug.Assert(len(opts.Buckets) == 0 && !opts.Bck.IsQuery())
+		j.odir = mi.MakePathCT(&j.opts.Bck, fs.ObjectType)
+		if after, ok := opts.StartAfter[mi.Path]; ok {
+			j.after = after
+			j.last.name = after
+		}
+	} else {
+		debug.Assert(len(opts.StartAfter) == 0)
+	}
 	j.stopCh.Init()
 	return
 }
@@ -260,7 +295,7 @@ func (j *jogger) runBck(bck *cmn.Bck) (aborted bool, err error) {
 		Mi:       j.mi,
 		CTs:      j.opts.CTs,
 		Callback: j.jog,
-		Sorted:   false,
+		Sorted:   j.opts.Sorted,
 	}
 	opts.Bck.Copy(bck)
 
@@ -286,6 +321,11 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 			return nil
 		}
 	}
+	if j.after != "" {
+		if skip, err := j.skip(fqn, de); skip {
+			return err
+		}
+	}
 	if de.IsDir() {
 		return nil
 	}
@@ -297,6 +337,11 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 	if err := j.visitFQN(fqn, j.buf); err != nil {
 		return err
 	}
+	if j.odir != "" && len(fqn) > len(j.odir) {
+		j.last.mu.Lock()
+		j.last.name = fqn[len(j.odir)+1:]
+		j.last.mu.Unlock()
+	}
 
 	n := j.numvis.Inc()
 
@@ -311,6 +356,53 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// resuming: skip objects (and entire directories) that precede `j.after` in the walk order
+func (j *jogger) skip(fqn string, de fs.DirEntry) (bool, error) {
+	if !strings.HasPrefix(fqn, j.odir) || len(fqn) <= len(j.odir)+1 {
+		return false, nil
+	}
+	cmp, prefix := cmpWalk(fqn[len(j.odir)+1:], j.after)
+	if de.IsDir() {
+		if cmp < 0 && !prefix {
+			return true, filepath.SkipDir
+		}
+		return false, nil
+	}
+	if cmp <= 0 {
+		return true, nil
+	}
+	j.after = "" // past the starting point
+	return false, nil
+}
+
+// sorted walk visits directory entries in the lexicographical order of their (base)names,
+// which is why object names must be compared component by component
+// (e.g., "a/b" precedes "a-b" even though '/' > '-')
+func cmpWalk(a, b string) (cmp int, prefix bool) {
+	for {
+		ia, ib := strings.IndexByte(a, '/'), strings.IndexByte(b, '/')
+		ca, cb := a, b
+		if ia >= 0 {
+			ca = a[:ia]
+		}
+		if ib >= 0 {
+			cb = b[:ib]
+		}
+		if cmp = strings.Compare(ca, cb); cmp != 0 {
+			return cmp, false
+		}
+		switch {
+		case ia < 0 && ib < 0:
+			return 0, false
+		case ia < 0:
+			return -1, true // `a` is a (directory) prefix of `b`
+		case ib < 0:
+			return 1, false
+		}
+		a, b = a[ia+1:], b[ib+1:]
+	}
+}
+
 func (j *jogger) visitFQN(fqn string, buf []byte) error {
 	ct, err := core.NewCTFromFQN(fqn, core.T.Bowner())
 	if err != nil {
@@ -0,0 +1,69 @@
+// Package mpather provides per-mountpath concepts.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package mpather
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCmpWalk(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		cmp    int
+		prefix bool
+	}{
+		{"a/b", "a/b", 0, false},
+		{"a/b", "a-b", -1, false}, // even though '/' > '-'
+		{"a-b", "a/b", 1, false},
+		{"a", "a/b", -1, true}, // directory prefix
+		{"a/b", "a", 1, false},
+		{"a/b/c", "a/c", -1, false},
+		{"a/c", "a/b/c", 1, false},
+		{"ab", "a/b", 1, false},
+		{"a/b", "a/bc", -1, false},
+		{"x/y/z", "x/y/z/w", -1, true},
+	}
+	for i, test := range tests {
+		cmp, prefix := cmpWalk(test.a, test.b)
+		tassert.Errorf(t, cmp == test.cmp && prefix == test.prefix, "%d: cmpWalk(%q, %q): expected (%d, %t), got (%d, %t)",
+			i, test.a, test.b, test.cmp, test.prefix, cmp, prefix)
+	}
+}
+
+// must agree with the order in which (sorted) walk visits nested directories
+func TestCmpWalkOrder(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		names = []string{"a-b", "a.b", "a/b/c", "a/b-c", "a/b.c", "a/bb", "a0/x", "b/a/a", "b/a-a", "b/aa", "b/a.a/z", "c"}
+	)
+	for _, name := range names {
+		fqn := filepath.Join(dir, name)
+		tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(fqn), 0o755))
+		tassert.CheckFatal(t, os.WriteFile(fqn, nil, 0o644))
+	}
+
+	var visited []string
+	err := filepath.WalkDir(dir, func(fqn string, de fs.DirEntry, err error) error {
+		if err != nil || de.IsDir() {
+			return err
+		}
+		visited = append(visited, fqn[len(dir)+1:])
+		return nil
+	})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(visited) == len(names), "expecting %d files, got %v", len(names), visited)
+
+	for i := 1; i < len(visited); i++ {
+		cmp, _ := cmpWalk(visited[i-1], visited[i])
+		tassert.Errorf(t, cmp < 0, "walk order %q => %q disagrees with cmpWalk", visited[i-1], visited[i])
+		cmp, _ = cmpWalk(visited[i], visited[i-1])
+		tassert.Errorf(t, cmp > 0, "walk order %q => %q disagrees with cmpWalk (reversed)", visited[i-1], visited[i])
+	}
+}
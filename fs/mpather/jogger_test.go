@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -57,6 +58,77 @@ func TestJoggerGroup(t *testing.T) {
 	tassert.CheckFatal(t, err)
 }
 
+func TestJoggerGroupStartAfter(t *testing.T) {
+	var (
+		desc = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjectType, ContentCnt: 500},
+			},
+			MountpathsCnt: 4,
+			ObjectSize:    cos.KiB,
+		}
+		out     = tools.PrepareObjects(t, desc)
+		visited = make(map[string][]string, desc.MountpathsCnt)
+		mu      sync.Mutex
+	)
+	defer os.RemoveAll(out.Dir)
+
+	// 1. sorted walk: record per-mountpath visiting order
+	opts := &mpather.JgroupOpts{
+		Bck:    out.Bck,
+		CTs:    []string{fs.ObjectType},
+		Sorted: true,
+		VisitObj: func(lom *core.LOM, _ []byte) error {
+			mu.Lock()
+			mpath := lom.Mountpath().Path
+			visited[mpath] = append(visited[mpath], lom.ObjName)
+			mu.Unlock()
+			return nil
+		},
+	}
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), nil)
+	jg.Run()
+	<-jg.ListenFinished()
+	tassert.CheckFatal(t, jg.Stop())
+
+	progress := jg.Progress(nil)
+	for mpath, names := range visited {
+		last := names[len(names)-1]
+		tassert.Errorf(t, progress[mpath] == last, "%s: expecting progress %q, got %q", mpath, last, progress[mpath])
+	}
+
+	// 2. resume from the middle
+	var (
+		startAfter = make(map[string]string, len(visited))
+		expected   int
+		counter    = atomic.NewInt32(0)
+	)
+	for mpath, names := range visited {
+		k := len(names) / 2
+		startAfter[mpath] = names[k]
+		expected += len(names) - k - 1
+	}
+	opts = &mpather.JgroupOpts{
+		Bck:        out.Bck,
+		CTs:        []string{fs.ObjectType},
+		Sorted:     true,
+		StartAfter: startAfter,
+		VisitObj: func(lom *core.LOM, _ []byte) error {
+			if lom.ObjName <= startAfter[lom.Mountpath().Path] {
+				t.Errorf("%s: visited %q that precedes %q", lom.Mountpath(), lom.ObjName, startAfter[lom.Mountpath().Path])
+			}
+			counter.Inc()
+			return nil
+		},
+	}
+	jg = mpather.NewJoggerGroup(opts, cmn.GCO.Get(), nil)
+	jg.Run()
+	<-jg.ListenFinished()
+	tassert.CheckFatal(t, jg.Stop())
+
+	tassert.Errorf(t, int(counter.Load()) == expected, "expecting %d resumed visits, got %d", expected, counter.Load())
+}
+
 func TestJoggerGroupLoad(t *testing.T) {
 	var (
 		desc = tools.ObjectsDesc{
@@ -0,0 +1,250 @@
+// Package replq: durable per-bucket change log for cross-cluster replication
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package replq
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// on-disk format
+// - segment: sequence of records: op (1 byte) | time (8 bytes) | name length (4 bytes) | name
+// - cursor:  magic | BID | segment | offset
+
+const (
+	magic      = "aisreplq"
+	cursorName = "cursor"
+	segSuffix  = ".seg"
+
+	hdrLen     = 1 + 8 + 4
+	cursorLen  = len(magic) + 8 + 8 + 8
+	segSize    = 64 * cos.MiB
+	maxNameLen = 64 * cos.KiB
+	bufSize    = 64 * cos.KiB
+)
+
+func encode(op byte, ts int64, name string) []byte {
+	rec := make([]byte, hdrLen, hdrLen+len(name))
+	rec[0] = op
+	binary.LittleEndian.PutUint64(rec[1:], uint64(ts))
+	binary.LittleEndian.PutUint32(rec[9:], uint32(len(name)))
+	return append(rec, name...)
+}
+
+func segPath(dir string, seg uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%016x", seg)+segSuffix)
+}
+
+func createSeg(dir string, seg uint64) (*os.File, error) {
+	if err := cos.CreateDir(dir); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(segPath(dir, seg), os.O_WRONLY|os.O_CREATE|os.O_APPEND, cos.PermRWR)
+}
+
+// reads records from a given position until: `limit` is reached, or `end` offset
+// (tail segment), or EOF (sealed segment); returns end-of-segment indication
+// NOTE: a truncated (torn) record, if any, is treated as end of a sealed segment
+func readSeg(dir string, pos Pos, end int64, limit int, out []Event) ([]Event, Pos, bool, error) {
+	fh, err := os.Open(segPath(dir, pos.Seg))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return out, pos, true, nil
+		}
+		return out, pos, false, err
+	}
+	defer fh.Close()
+	if pos.Off > 0 {
+		if _, err := fh.Seek(pos.Off, io.SeekStart); err != nil {
+			return out, pos, false, err
+		}
+	}
+	var (
+		br  = bufio.NewReaderSize(fh, bufSize)
+		hdr [hdrLen]byte
+	)
+	for len(out) < limit {
+		if end >= 0 && pos.Off >= end {
+			return out, pos, false, nil
+		}
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			return out, pos, true, _eos(err, pos)
+		}
+		var (
+			op = hdr[0]
+			ts = int64(binary.LittleEndian.Uint64(hdr[1:]))
+			l  = binary.LittleEndian.Uint32(hdr[9:])
+		)
+		if (op != OpPut && op != OpDel) || l == 0 || l > maxNameLen {
+			if end >= 0 {
+				return out, pos, false, fmt.Errorf("replication log %s: corrupted record at offset %d", segPath(dir, pos.Seg), pos.Off)
+			}
+			nlog.Warningln("replication log", segPath(dir, pos.Seg), "corrupted at offset", pos.Off, "- skipping the rest")
+			return out, pos, true, nil
+		}
+		name := make([]byte, l)
+		if _, err := io.ReadFull(br, name); err != nil {
+			return out, pos, true, _eos(err, pos)
+		}
+		out = append(out, Event{Name: string(name), Time: ts, Op: op})
+		pos.Off += hdrLen + int64(l)
+	}
+	return out, pos, false, nil
+}
+
+func _eos(err error, pos Pos) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil
+	}
+	return fmt.Errorf("failed to read replication log segment %d at offset %d: %w", pos.Seg, pos.Off, err)
+}
+
+func storeCursor(dir string, bid uint64, pos Pos) error {
+	var (
+		b   = make([]byte, 0, cursorLen)
+		fqn = filepath.Join(dir, cursorName)
+		tmp = fqn + ".tmp"
+	)
+	b = append(b, magic...)
+	b = binary.LittleEndian.AppendUint64(b, bid)
+	b = binary.LittleEndian.AppendUint64(b, pos.Seg)
+	b = binary.LittleEndian.AppendUint64(b, uint64(pos.Off))
+
+	fh, err := cos.CreateFile(tmp)
+	if err != nil {
+		return err
+	}
+	_, err = fh.Write(b)
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = cos.Rename(tmp, fqn)
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(tmp); errRm != nil {
+			nlog.Errorln("nested err:", errRm)
+		}
+	}
+	return err
+}
+
+// returns (Pos{}, false, nil) when not found
+func loadCursor(dir string, bid uint64) (pos Pos, ok bool, _ error) {
+	b, err := os.ReadFile(filepath.Join(dir, cursorName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return pos, false, nil
+		}
+		return pos, false, err
+	}
+	if len(b) != cursorLen || string(b[:len(magic)]) != magic {
+		return pos, false, errors.New("invalid cursor format")
+	}
+	b = b[len(magic):]
+	if fbid := binary.LittleEndian.Uint64(b); fbid != bid {
+		return pos, false, fmt.Errorf("BID mismatch: %d vs %d", fbid, bid)
+	}
+	pos.Seg = binary.LittleEndian.Uint64(b[8:])
+	pos.Off = int64(binary.LittleEndian.Uint64(b[16:]))
+	return pos, true, nil
+}
+
+// under (global) lock
+func (q *Queue) load() error {
+	q.segs = make(map[uint64]int64, 4)
+	if q.dir = findDir(&q.bck); q.dir == "" {
+		mi, _, err := fs.Hrw(q.bck.MakeUname(""))
+		if err != nil {
+			return err
+		}
+		q.dir = filepath.Join(mi.MakePathBck(&q.bck), fname.ReplQueue)
+		q.head, q.tail = Pos{Seg: 1}, Pos{Seg: 1}
+		return nil
+	}
+
+	head, ok, err := loadCursor(q.dir, q.bid)
+	if err != nil {
+		nlog.Warningln("discarding replication log", q.dir, "err:", err)
+		if err := removeDir(q.dir); err != nil {
+			return err
+		}
+		q.head, q.tail = Pos{Seg: 1}, Pos{Seg: 1}
+		return nil
+	}
+	if !ok {
+		head = Pos{Seg: 1}
+	}
+
+	// existing segments
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+	last := head.Seg
+	for _, ent := range entries {
+		name := ent.Name()
+		if ent.IsDir() || !strings.HasSuffix(name, segSuffix) {
+			continue
+		}
+		seg, err := strconv.ParseUint(strings.TrimSuffix(name, segSuffix), 16, 64)
+		if err != nil {
+			continue
+		}
+		fqn := filepath.Join(q.dir, name)
+		if seg < head.Seg {
+			// (consumed prior to restart)
+			if err := cos.RemoveFile(fqn); err != nil {
+				nlog.Errorln("failed to remove replication log segment", fqn, "err:", err)
+			}
+			continue
+		}
+		finfo, err := ent.Info()
+		if err != nil {
+			return err
+		}
+		q.segs[seg] = finfo.Size()
+		q.total += finfo.Size()
+		last = max(last, seg)
+	}
+
+	// resume appending in a new segment
+	q.head = head
+	q.tail = Pos{Seg: last + 1}
+	return nil
+}
+
+// the log stays where it was created even if the bucket's HRW mountpath changes
+// (e.g., when mountpaths get added)
+func findDir(bck *cmn.Bck) string {
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		dir := filepath.Join(mi.MakePathBck(bck), fname.ReplQueue)
+		if finfo, err := os.Stat(dir); err == nil && finfo.IsDir() {
+			return dir
+		}
+	}
+	return ""
+}
+
+func removeDir(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	return os.RemoveAll(dir)
+}
@@ -0,0 +1,398 @@
+// Package replq: durable per-bucket change log for cross-cluster replication
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package replq
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/hk"
+)
+
+// Each target maintains a change log for every bucket that has replication enabled
+// (see cmn.ReplConf). The log is a sequence of append-only segments plus the persisted
+// cursor that points at the first not-yet-replicated record:
+//
+//	<mountpath>/<bucket>/.ais.replq/<segment-number>.seg
+//	<mountpath>/<bucket>/.ais.replq/cursor
+//
+// Records get appended (written) as they come, and periodically fsync-ed. The consumer
+// (x-replicate) reads the log in order and moves the cursor forward once it has shipped
+// the corresponding changes, thus removing fully consumed segments. Delivery semantics
+// is at-least-once: upon restart, some of the already shipped changes may get shipped
+// again.
+//
+// After restart, appending always resumes in a new segment - a torn record (if any)
+// can only be the last one in a sealed segment, where the reader simply skips it.
+
+// record types
+const (
+	OpPut = 'P' // object created or updated (includes the destination of a rename)
+	OpDel = 'D' // object deleted (includes the source of a rename)
+)
+
+const (
+	hkName   = "replq" + hk.NameSuffix
+	syncIval = 10 * time.Second
+)
+
+type (
+	Event struct {
+		Name string
+		Time int64 // unix nano
+		Op   byte
+	}
+	// position in the log
+	Pos struct {
+		Seg uint64
+		Off int64
+	}
+
+	Queue struct {
+		wfh   *os.File         // current (tail) segment
+		segs  map[uint64]int64 // segment => size
+		dir   string
+		bck   cmn.Bck
+		stats struct {
+			lastErr  string
+			lastSync int64
+			xid      string
+		}
+		head    Pos // cursor
+		tail    Pos // append position
+		bid     uint64
+		total   int64 // total size of all segments
+		failed  atomic.Int64
+		shipped atomic.Int64
+		errs    atomic.Int64
+		mu      sync.Mutex
+		dirty   bool // appended since last fsync
+		gone    bool // dropped
+	}
+)
+
+var (
+	g struct {
+		all map[string]*Queue // by bucket cname
+		mu  sync.RWMutex
+	}
+)
+
+func init() {
+	g.all = make(map[string]*Queue, 4)
+}
+
+// called once upon target startup
+func Init() {
+	hk.Reg(hkName, flush, syncIval)
+}
+
+// Get returns the bucket's change log, opening (and loading) or creating it as needed
+func Get(bck *cmn.Bck, bid uint64) (*Queue, error) {
+	key := bck.Cname("")
+	g.mu.RLock()
+	q := g.all[key]
+	g.mu.RUnlock()
+	if q != nil && q.bid == bid {
+		return q, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if q = g.all[key]; q != nil {
+		if q.bid == bid {
+			return q, nil
+		}
+		q.drop() // (bucket re-created)
+		delete(g.all, key)
+	}
+	q = &Queue{bck: *bck, bid: bid}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	g.all[key] = q
+	return q, nil
+}
+
+// Lookup returns the bucket's change log if it's been opened, nil otherwise
+func Lookup(bck *cmn.Bck) *Queue {
+	g.mu.RLock()
+	q := g.all[bck.Cname("")]
+	g.mu.RUnlock()
+	return q
+}
+
+// Drop removes the change log, both in-memory and persisted (e.g., when the bucket
+// is destroyed, or when replication gets disabled)
+func Drop(bck *cmn.Bck) {
+	key := bck.Cname("")
+	g.mu.Lock()
+	q := g.all[key]
+	delete(g.all, key)
+	g.mu.Unlock()
+	if q != nil {
+		q.drop()
+		return
+	}
+	if dir := findDir(bck); dir != "" {
+		if err := removeDir(dir); err != nil {
+			nlog.Errorln("failed to remove replication log", dir, "err:", err)
+		}
+	}
+}
+
+// Range visits all open change logs
+func Range(cb func(q *Queue)) {
+	g.mu.RLock()
+	all := make([]*Queue, 0, len(g.all))
+	for _, q := range g.all {
+		all = append(all, q)
+	}
+	g.mu.RUnlock()
+	for _, q := range all {
+		cb(q)
+	}
+}
+
+// CloseAll is called upon graceful shutdown
+func CloseAll() {
+	Range(func(q *Queue) {
+		q.mu.Lock()
+		q.closeTail()
+		q.mu.Unlock()
+	})
+}
+
+func flush(int64) time.Duration {
+	Range(func(q *Queue) { q.sync() })
+	return syncIval
+}
+
+///////////
+// Queue //
+///////////
+
+func (q *Queue) Bck() *cmn.Bck { return &q.bck }
+
+// Append records a change; appending to a dropped log is a no-op
+func (q *Queue) Append(op byte, name string) error {
+	rec := encode(op, time.Now().UnixNano(), name)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.gone {
+		return nil
+	}
+	if q.wfh == nil || q.tail.Off >= segSize {
+		if err := q.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := q.wfh.Write(rec)
+	q.tail.Off += int64(n)
+	q.segs[q.tail.Seg] = q.tail.Off
+	q.total += int64(n)
+	q.dirty = true
+	if err != nil {
+		// seal the (possibly) torn segment; next append starts a new one
+		q.closeTail()
+		return err
+	}
+	return nil
+}
+
+// Read returns up to `limit` recorded changes starting from the cursor, and the
+// position that follows the last returned change (to subsequently Commit)
+func (q *Queue) Read(limit int, out []Event) ([]Event, Pos, error) {
+	q.mu.Lock()
+	pos, tail, dir := q.head, q.tail, q.dir
+	q.mu.Unlock()
+	for len(out) < limit && pos.Seg <= tail.Seg {
+		end := int64(-1) // sealed segment: read until EOF
+		if pos.Seg == tail.Seg {
+			if end = tail.Off; pos.Off >= end {
+				break
+			}
+		}
+		var (
+			eos bool
+			err error
+		)
+		out, pos, eos, err = readSeg(dir, pos, end, limit, out)
+		if err != nil {
+			return out, pos, err
+		}
+		if eos {
+			if pos.Seg >= tail.Seg {
+				break
+			}
+			pos = Pos{Seg: pos.Seg + 1}
+		}
+	}
+	return out, pos, nil
+}
+
+// Commit moves the cursor forward and removes fully consumed segments
+func (q *Queue) Commit(pos Pos) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.gone {
+		return nil
+	}
+	if err := storeCursor(q.dir, q.bid, pos); err != nil {
+		return err
+	}
+	prev := q.head
+	q.head = pos
+	for seg := prev.Seg; seg < pos.Seg; seg++ {
+		size, ok := q.segs[seg]
+		if !ok {
+			continue
+		}
+		if err := cos.RemoveFile(segPath(q.dir, seg)); err != nil {
+			nlog.Errorln("failed to remove replication log segment", segPath(q.dir, seg), "err:", err)
+		}
+		delete(q.segs, seg)
+		q.total -= size
+	}
+	if q._pending() == 0 {
+		q.stats.lastSync = time.Now().UnixNano()
+	}
+	return nil
+}
+
+// size (bytes) of the not-yet-replicated part of the log
+func (q *Queue) Pending() int64 {
+	q.mu.Lock()
+	n := q._pending()
+	q.mu.Unlock()
+	return n
+}
+
+func (q *Queue) _pending() int64 {
+	if q.head.Seg == q.tail.Seg {
+		return q.tail.Off - q.head.Off
+	}
+	return q.total - q.head.Off
+}
+
+//
+// consumer status
+//
+
+func (q *Queue) SetXid(xid string) {
+	q.mu.Lock()
+	q.stats.xid = xid
+	q.mu.Unlock()
+}
+
+// whether there's a running consumer
+func (q *Queue) Busy() bool {
+	q.mu.Lock()
+	busy := q.stats.xid != ""
+	q.mu.Unlock()
+	return busy
+}
+
+func (q *Queue) Shipped(n int) { q.shipped.Add(int64(n)) }
+
+func (q *Queue) Failed(err error) {
+	q.errs.Add(1)
+	q.failed.Store(mono.NanoTime())
+	q.mu.Lock()
+	q.stats.lastErr = err.Error()
+	q.mu.Unlock()
+}
+
+// whether the most recent failure happened within a given interval
+func (q *Queue) FailedWithin(d time.Duration) bool {
+	failed := q.failed.Load()
+	return failed != 0 && mono.Since(failed) < d
+}
+
+func (q *Queue) Stats(dst string) *cmn.ReplStats {
+	q.mu.Lock()
+	st := &cmn.ReplStats{
+		Bck:      q.bck,
+		Dst:      dst,
+		Xid:      q.stats.xid,
+		LastErr:  q.stats.lastErr,
+		Pending:  q._pending(),
+		LastSync: q.stats.lastSync,
+	}
+	q.mu.Unlock()
+	st.Shipped = q.shipped.Load()
+	st.Errors = q.errs.Load()
+	if st.Pending > 0 {
+		// age of the oldest pending change
+		if evs, _, err := q.Read(1, nil); err == nil && len(evs) > 0 {
+			st.Lag = max(time.Now().UnixNano()-evs[0].Time, 0)
+		}
+	}
+	return st
+}
+
+//
+// internal
+//
+
+// under lock
+func (q *Queue) rotate() error {
+	if q.wfh != nil {
+		q.closeTail()
+	}
+	if q.tail.Off > 0 {
+		q.tail = Pos{Seg: q.tail.Seg + 1}
+	}
+	fh, err := createSeg(q.dir, q.tail.Seg)
+	if err != nil {
+		return err
+	}
+	q.wfh = fh
+	q.segs[q.tail.Seg] = 0
+	return nil
+}
+
+// under lock
+func (q *Queue) closeTail() {
+	if q.wfh == nil {
+		return
+	}
+	if err := cos.FlushClose(q.wfh); err != nil {
+		nlog.Errorln("failed to close replication log segment", q.wfh.Name(), "err:", err)
+	}
+	q.wfh = nil
+	q.dirty = false
+}
+
+func (q *Queue) sync() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.dirty || q.wfh == nil {
+		return
+	}
+	if err := q.wfh.Sync(); err != nil {
+		nlog.Errorln("failed to sync replication log", q.dir, "err:", err)
+		return
+	}
+	q.dirty = false
+}
+
+func (q *Queue) drop() {
+	q.mu.Lock()
+	q.gone = true
+	q.closeTail()
+	if err := removeDir(q.dir); err != nil {
+		nlog.Errorln("failed to remove replication log", q.dir, "err:", err)
+	}
+	q.segs, q.total = map[uint64]int64{}, 0
+	q.head, q.tail = Pos{}, Pos{}
+	q.mu.Unlock()
+}
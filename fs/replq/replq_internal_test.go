@@ -0,0 +1,130 @@
+// Package replq: durable per-bucket change log for cross-cluster replication
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package replq
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const testBID = 0x1234
+
+func initTestMpath(t *testing.T) {
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+}
+
+// simulate restart: close and forget in-memory state, to subsequently load from disk
+func restart(bck *cmn.Bck) {
+	CloseAll()
+	g.mu.Lock()
+	delete(g.all, bck.Cname(""))
+	g.mu.Unlock()
+}
+
+func TestAppendReadCommit(t *testing.T) {
+	initTestMpath(t)
+	bck := &cmn.Bck{Name: "replq-basic", Provider: apc.AIS}
+	defer Drop(bck)
+
+	q, err := Get(bck, testBID)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, q.Pending() == 0, "expecting empty log")
+
+	for i := range 10 {
+		tassert.CheckFatal(t, q.Append(OpPut, fmt.Sprintf("obj-%02d", i)))
+	}
+	tassert.CheckFatal(t, q.Append(OpDel, "obj-03"))
+	tassert.Errorf(t, q.Pending() > 0, "expecting pending changes")
+
+	evs, pos, err := q.Read(4, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(evs) == 4, "expecting 4 events, got %d", len(evs))
+	tassert.Errorf(t, evs[0].Name == "obj-00" && evs[0].Op == OpPut && evs[0].Time > 0, "unexpected %+v", evs[0])
+
+	// not committed: same batch again
+	evs2, pos2, err := q.Read(4, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, pos2 == pos && evs2[3].Name == evs[3].Name, "expecting the same batch")
+
+	tassert.CheckFatal(t, q.Commit(pos))
+	evs, pos, err = q.Read(100, evs[:0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(evs) == 7, "expecting 7 events, got %d", len(evs))
+	last := evs[len(evs)-1]
+	tassert.Errorf(t, last.Name == "obj-03" && last.Op == OpDel, "unexpected %+v", last)
+
+	tassert.CheckFatal(t, q.Commit(pos))
+	tassert.Errorf(t, q.Pending() == 0, "expecting nothing pending, got %d", q.Pending())
+	st := q.Stats("ais://@remais/dst")
+	tassert.Errorf(t, st.LastSync > 0 && st.Lag == 0, "unexpected stats %+v", st)
+}
+
+func TestRestart(t *testing.T) {
+	initTestMpath(t)
+	bck := &cmn.Bck{Name: "replq-restart", Provider: apc.AIS}
+	defer Drop(bck)
+
+	q, err := Get(bck, testBID)
+	tassert.CheckFatal(t, err)
+	for i := range 6 {
+		tassert.CheckFatal(t, q.Append(OpPut, fmt.Sprintf("obj-%d", i)))
+	}
+	evs, pos, err := q.Read(2, nil)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, q.Commit(pos))
+
+	// torn record at the end of the (soon to be sealed) segment
+	fh, err := os.OpenFile(segPath(q.dir, q.tail.Seg), os.O_WRONLY|os.O_APPEND, 0)
+	tassert.CheckFatal(t, err)
+	_, err = fh.Write(encode(OpPut, 1, "torn")[:hdrLen+2])
+	tassert.CheckFatal(t, err)
+	fh.Close()
+
+	restart(bck)
+	q, err = Get(bck, testBID)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, q.Append(OpDel, "obj-0"))
+
+	evs, pos, err = q.Read(100, evs[:0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(evs) == 5, "expecting 5 events (4 prior to restart + 1), got %d", len(evs))
+	tassert.Errorf(t, evs[0].Name == "obj-2" && evs[4].Name == "obj-0" && evs[4].Op == OpDel, "unexpected %+v", evs)
+
+	tassert.CheckFatal(t, q.Commit(pos))
+	tassert.Errorf(t, q.Pending() == 0, "expecting nothing pending, got %d", q.Pending())
+	tassert.Errorf(t, len(q.segs) == 1, "expecting consumed segments to be removed, got %d", len(q.segs))
+
+	// re-created bucket (different BID)
+	restart(bck)
+	q, err = Get(bck, testBID+1)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, q.Pending() == 0, "expecting new empty log")
+}
+
+func TestDrop(t *testing.T) {
+	initTestMpath(t)
+	bck := &cmn.Bck{Name: "replq-drop", Provider: apc.AIS}
+
+	q, err := Get(bck, testBID)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, q.Append(OpPut, "obj"))
+	dir := q.dir
+
+	Drop(bck)
+	tassert.Errorf(t, Lookup(bck) == nil, "expecting no log after drop")
+	_, err = os.Stat(dir)
+	tassert.Errorf(t, os.IsNotExist(err), "expecting %s to be removed", dir)
+
+	// no-op
+	tassert.CheckFatal(t, q.Append(OpPut, "obj"))
+}
@@ -14,6 +14,9 @@ const (
 	DelOldIval        = 24 * time.Minute // cleanup old xactions; old transactions
 	PruneActiveIval   = 2 * time.Minute  // prune active xactions; cleanup notifs
 	PruneRateLimiters = 6 * time.Hour    // prune stale rate limiters on the front
+	LifecycleIval     = time.Hour        // evaluate bucket lifecycle rules (x-lifecycle)
+	ReplIval          = time.Minute      // check bucket change logs (x-replicate)
+	ResumeIval        = 20 * time.Second // resume suspended xactions, if any (see xact/xckpt)
 
 	//
 	// when things are considered _old_
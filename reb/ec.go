@@ -206,11 +206,11 @@ func (*Reb) renameAsWorkFile(ct *core.CT) (string, error) {
 // goes to any other _free_ target.
 func (reb *Reb) findEmptyTarget(md *ec.Metadata, ct *core.CT, sender string) (*meta.Snode, error) {
 	var (
-		sliceCnt     = md.Data + md.Parity + 2
-		smap         = reb.smap.Load()
-		uname        = ct.UnamePtr()
-		hrwList, err = smap.HrwTargetList(uname, sliceCnt)
+		sliceCnt = md.Data + md.Parity + 2
+		smap     = reb.smap.Load()
+		uname    = ct.UnamePtr()
 	)
+	hrwList, err := smap.HrwTargetList(uname, smap.MaxDomainTargets(sliceCnt))
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/evnotif"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/stats"
@@ -445,6 +446,12 @@ func (j *lruJ) evictObj(lom *core.LOM) bool {
 	if cmn.Rom.FastV(5, cos.SmoduleSpace) {
 		nlog.Infof("%s: evicted %s, size=%d", j, lom, lom.Lsize(true /*not loaded*/))
 	}
+	// (evicting from ais:// buckets with LRU enabled is deletion)
+	ev := cmn.EvObjEvicted
+	if !lom.Bck().IsRemote() {
+		ev = cmn.EvObjDeleted
+	}
+	evnotif.Emit(lom.Bck(), ev, lom.ObjName, "" /*src*/, lom.Version(), lom.Lsize(true))
 	return true
 }
 
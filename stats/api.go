@@ -141,6 +141,8 @@ func LatencyToCounter(latName string) string {
 		return PutCount
 	case ETLOfflineLatencyTotal:
 		return ETLOfflineCount
+	case ReplLagTotal:
+		return ReplCount
 	case RatelimGetRetryLatencyTotal:
 		return RatelimGetRetryCount
 	case RatelimPutRetryLatencyTotal:
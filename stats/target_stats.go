@@ -91,6 +91,16 @@ const (
 	// Downloader
 	DloadSize = "dl.size"
 
+	// cross-cluster bucket replication (x-replicate)
+	ReplCount    = "repl.n"
+	ReplSize     = "repl.size"
+	ReplLagTotal = "repl.lag.ns.total" // sum of (shipped - recorded) times, to compute average lag
+	ErrReplCount = errPrefix + "repl.n"
+
+	// object event notifications (webhooks and local event log)
+	EventCount    = "event.n"
+	ErrEventCount = errPrefix + "event.n" // including events dropped due to backpressure
+
 	// KindThroughput
 	GetThroughput = "get.bps" // bytes per second
 	PutThroughput = "put.bps" // ditto
@@ -516,6 +526,44 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	// replication
+	r.reg(snode, ReplCount, KindCounter,
+		&Extra{
+			Help:    "cross-cluster replication: number of replicated changes (PUTs and DELETEs)",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ReplSize, KindSize,
+		&Extra{
+			Help:    "cross-cluster replication: total cumulative size (bytes) of replicated objects",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ReplLagTotal, KindTotal,
+		&Extra{
+			Help:    "cross-cluster replication: total cumulative time (nanoseconds) between recording and replicating each change",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ErrReplCount, KindCounter,
+		&Extra{
+			Help:    "cross-cluster replication: number of failures to replicate changes",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, EventCount, KindCounter,
+		&Extra{
+			Help:    "event notifications: number of delivered object events (webhooks and local event log)",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ErrEventCount, KindCounter,
+		&Extra{
+			Help:    "event notifications: number of object events that failed to get delivered (including dropped)",
+			VarLabs: BckVlabs,
+		},
+	)
+
 	// core
 	r.reg(snode, LcacheCollisionCount, KindCounter,
 		&Extra{
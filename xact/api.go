@@ -105,6 +105,10 @@ type (
 		// xaction returns extended xaction-specific stats
 		// (see related: `Snap.Ext` in core/xaction.go)
 		ExtendedStats bool
+
+		// periodically checkpoints its progress (locally, on each target) to continue running
+		// under the same ID after the target restarts (see xact/xckpt)
+		Resumable bool
 	}
 )
 
@@ -156,6 +160,7 @@ var Table = map[string]Descriptor{
 		Startable:   false,
 		RefreshCap:  true,
 		Idles:       true,
+		Resumable:   true,
 	},
 	apc.ActETLObjects: {
 		DisplayName: "etl-objects",
@@ -165,9 +170,10 @@ var Table = map[string]Descriptor{
 		RefreshCap:  true,
 		Idles:       true,
 		AbortRebRes: true,
+		Resumable:   true,
 	},
 
-	apc.ActBlobDl: {Access: apc.AccessRW, Scope: ScopeB, Startable: true, AbortRebRes: true, RefreshCap: true},
+	apc.ActBlobDl: {Access: apc.AccessRW, Scope: ScopeB, Startable: true, AbortRebRes: true, RefreshCap: true, Resumable: true},
 
 	apc.ActDownload: {Access: apc.AccessRW, Scope: ScopeG, Startable: false, Idles: true, AbortRebRes: true},
 
@@ -212,12 +218,26 @@ var Table = map[string]Descriptor{
 		Startable:   false,
 		RefreshCap:  true,
 	},
+	apc.ActLifecycle: {
+		DisplayName: "lifecycle",
+		Scope:       ScopeB,
+		Access:      apc.AceObjDELETE,
+		Startable:   true,
+		RefreshCap:  true,
+	},
+	apc.ActReplicate: {
+		DisplayName: "replicate",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   true,
+	},
 	apc.ActPrefetchObjects: {
 		DisplayName: "prefetch-objects",
 		Scope:       ScopeB,
 		Access:      apc.AccessRW,
 		Startable:   true,
 		RefreshCap:  true,
+		Resumable:   true,
 	},
 
 	// entire bucket (storage svcs)
@@ -230,6 +250,14 @@ var Table = map[string]Descriptor{
 		RefreshCap:     true,
 		ConflictRebRes: true,
 	},
+	apc.ActECReencode: {
+		DisplayName:    "ec-reencode",
+		Scope:          ScopeB,
+		Access:         apc.AccessRW,
+		Startable:      true,
+		RefreshCap:     true,
+		ConflictRebRes: true,
+	},
 	apc.ActMakeNCopies: {
 		DisplayName: "mirror",
 		Scope:       ScopeB,
@@ -255,6 +283,7 @@ var Table = map[string]Descriptor{
 		Metasync:       true,
 		RefreshCap:     true,
 		ConflictRebRes: true,
+		Resumable:      true,
 	},
 	apc.ActETLBck: {
 		DisplayName: "etl-bucket",
@@ -264,12 +293,16 @@ var Table = map[string]Descriptor{
 		Metasync:    true,
 		RefreshCap:  true,
 		AbortRebRes: true,
+		Resumable:   true,
 	},
 
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Idles: true},
 
 	// cache management, internal usage
 	apc.ActLoadLomCache: {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true},
+
+	// list-objects index (feat.ListIndex)
+	apc.ActLsoIndex: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: true},
 }
 
 func GetDescriptor(kindOrName string) (string, Descriptor, error) {
@@ -367,6 +400,11 @@ func IsSameScope(kindOrName string, scs ...int) bool {
 	return dtor.Scope == scope || dtor.Scope == scope2
 }
 
+func IsResumable(kind string) bool {
+	dtor, ok := Table[kind]
+	return ok && dtor.Resumable
+}
+
 func getDtor(kindOrName string) (string, *Descriptor) {
 	if dtor, ok := Table[kindOrName]; ok {
 		return kindOrName, &dtor
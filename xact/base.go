@@ -5,6 +5,7 @@
 package xact
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -135,6 +136,15 @@ func (xctn *Base) AbortErr() error {
 	return cmn.NewErrAborted(xctn.Name(), "base.abort-err.timeout", nil)
 }
 
+// aborted with cmn.ErrXactSuspended, to resume later (see xact/xckpt)
+func (xctn *Base) IsSuspended() bool {
+	if !xctn.IsAborted() {
+		return false
+	}
+	perr := xctn.abort.err.Load()
+	return perr != nil && errors.Is(*perr, cmn.ErrXactSuspended)
+}
+
 func (xctn *Base) AbortedAfter(d time.Duration) (err error) {
 	sleep := cos.ProbingFrequency(d)
 	for elapsed := time.Duration(0); elapsed < d; elapsed += sleep {
@@ -209,6 +219,8 @@ func (xctn *Base) Finish() {
 	case xctn.Kind() == apc.ActList:
 	case err == nil:
 		nlog.Infoln(xctn.String(), "finished")
+	case aborted && errors.Is(err, cmn.ErrXactSuspended):
+		nlog.Infoln(xctn.String(), "suspended")
 	case aborted:
 		nlog.Warningln(xctn.String(), "aborted:", err, info)
 	default:
@@ -339,8 +351,8 @@ func (xctn *Base) EndTime() time.Time {
 
 // upon completion, all xactions optionally notify listener(s) and refresh local capacity stats
 func (xctn *Base) onFinished(err error, aborted bool) {
-	// notifications
-	if xctn.notif != nil {
+	// notifications (not when suspended - the job is to be resumed under the same ID)
+	if xctn.notif != nil && !(aborted && errors.Is(err, cmn.ErrXactSuspended)) {
 		nl.OnFinished(xctn.notif, err, aborted)
 	}
 	xactRecord := Table[xctn.kind]
@@ -26,6 +26,9 @@ func (r *BckJog) Run()             { r.joggers.Run() }
 func (r *BckJog) NumJoggers() int  { return r.joggers.NumJ() }
 func (r *BckJog) NumVisits() int64 { return r.joggers.NumVisits() }
 
+// (resumable) see mpather.JgroupOpts.Sorted
+func (r *BckJog) Progress(out map[string]string) map[string]string { return r.joggers.Progress(out) }
+
 func (r *BckJog) Wait() error {
 	select {
 	case errCause := <-r.ChanAbort():
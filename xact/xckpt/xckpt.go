@@ -0,0 +1,203 @@
+// Package xckpt persists progress checkpoints of resumable xactions, to continue
+// running them (under the same xaction ID) after target restart
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xckpt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Resumable xactions (see xact.Descriptor.Resumable) periodically save their progress
+// on one of the mountpaths:
+//
+//	<mountpath>/.ais.xckpt/<xaction ID>
+//
+// The checkpoint gets removed when the xaction finishes or is aborted. It is, however, retained
+// when the xaction is suspended (cmn.ErrXactSuspended) - upon target shutdown or when global
+// rebalance is starting. Upon restart, persisted checkpoints are loaded and become pending.
+// Pending xactions are then resumed by the target (one at a time, via housekeeping), whereby
+// each resumed xaction takes its own checkpoint (see Take) to continue from where it left off.
+
+const (
+	SaveIval = 10 * time.Second // how often to checkpoint
+
+	// suspended xaction that fails to resume within this interval is considered aborted
+	// (both by the target that has it pending, and by the proxy that tracks its notifications)
+	ResumeTimeout = 30 * time.Minute
+
+	// max number of times a given xaction can be suspended and resumed
+	// (upon reaching the limit, transient errors cause abort - see xs.isTransient)
+	MaxResumes = 8
+)
+
+const tmpSepa = ".tmp." // (see jsp.Save)
+
+type (
+	// resumable xaction: kind-specific control message, position, and stats
+	Ckpt struct {
+		Msg     json.RawMessage `json:"msg,omitempty"` // original control message (e.g., apc.TCBMsg)
+		BckTo   *cmn.Bck        `json:"bck_to,omitempty"`
+		ID      string          `json:"id"`
+		Kind    string          `json:"kind"`
+		ObjName string          `json:"name,omitempty"` // blob-download
+		Bck     cmn.Bck         `json:"bck"`
+		Pos     Pos             `json:"pos"`
+		Objs    int64           `json:"objs"`
+		Bytes   int64           `json:"bytes"`
+		Started int64           `json:"started"` // unix nano (the very first start)
+		Saved   int64           `json:"saved"`   // ditto (last checkpoint)
+		Resumes int             `json:"resumes"` // num times resumed so far
+	}
+	// position: the last processed name or offset, depending on the kind
+	Pos struct {
+		Joggers map[string]string `json:"joggers,omitempty"` // [mountpath => last visited object name]
+		Token   string            `json:"token,omitempty"`   // list-objects continuation token of the current page
+		Wfqn    string            `json:"wfqn,omitempty"`    // workfile (blob-download)
+		Version string            `json:"version,omitempty"` // remote object version (ditto)
+		Done    []string          `json:"done,omitempty"`    // IDs of the targets that have already finished
+		N       int64             `json:"n,omitempty"`       // num names processed: list, range, or current page (prefix)
+		Seq     int64             `json:"seq,omitempty"`     // control message in progress (copy/transform multiple objects)
+		Off     int64             `json:"off,omitempty"`     // write offset (blob-download)
+		Size    int64             `json:"size,omitempty"`    // remote object size (ditto)
+	}
+)
+
+// interface guard
+var _ jsp.Opts = (*Ckpt)(nil)
+
+var g struct {
+	pending map[string]*Ckpt // loaded upon restart or suspended at runtime, waiting to resume
+	mu      sync.Mutex
+}
+
+func (*Ckpt) JspOpts() jsp.Options { return jsp.CCSign(cmn.MetaverXckpt) }
+
+func (ck *Ckpt) String() string { return "xckpt[" + ck.Kind + "[" + ck.ID + "]]" }
+
+// load persisted checkpoints upon startup
+func Init() {
+	g.pending = make(map[string]*Ckpt, 4)
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		dir := filepath.Join(mi.Path, fname.XactCkpt)
+		dents, err := os.ReadDir(dir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				nlog.Errorln("failed to read", dir, "err:", err)
+			}
+			continue
+		}
+		for _, dent := range dents {
+			fqn := filepath.Join(dir, dent.Name())
+			if dent.IsDir() || strings.Contains(dent.Name(), tmpSepa) {
+				cos.RemoveFile(fqn)
+				continue
+			}
+			ck := &Ckpt{}
+			if _, err := jsp.LoadMeta(fqn, ck); err != nil || ck.ID != dent.Name() {
+				nlog.Errorln("failed to load", fqn, "err:", err, "- removing")
+				cos.RemoveFile(fqn)
+				continue
+			}
+			if prev, ok := g.pending[ck.ID]; ok && prev.Saved >= ck.Saved {
+				continue
+			}
+			g.pending[ck.ID] = ck
+		}
+	}
+	for _, ck := range g.pending {
+		nlog.Infoln("loaded", ck.String(), "to resume")
+	}
+}
+
+// persist on a single mountpath (while removing older copies, if any)
+func Save(ck *Ckpt) error {
+	ck.Saved = time.Now().UnixNano()
+	fn := filepath.Join(fname.XactCkpt, ck.ID)
+	cnt, availCnt := fs.PersistOnMpaths(fn, "" /*backup*/, ck, 1 /*at most*/, nil, nil)
+	if cnt > 0 {
+		return nil
+	}
+	if availCnt == 0 {
+		return cmn.ErrNoMountpaths
+	}
+	return fmt.Errorf("failed to store %s on any of the mountpaths (%d)", ck, availCnt)
+}
+
+// persist and add to pending
+func Suspend(ck *Ckpt) error {
+	if err := Save(ck); err != nil {
+		return err
+	}
+	g.mu.Lock()
+	g.pending[ck.ID] = ck
+	g.mu.Unlock()
+	return nil
+}
+
+// remove both pending (if exists) and persisted
+func Remove(id string) {
+	g.mu.Lock()
+	delete(g.pending, id)
+	g.mu.Unlock()
+
+	fn := filepath.Join(fname.XactCkpt, id)
+	avail := fs.GetAvail()
+	for _, mi := range avail {
+		if err := cos.RemoveFile(filepath.Join(mi.Path, fn)); err != nil {
+			nlog.Errorln("failed to remove", fn, "at", mi.String(), "err:", err)
+		}
+	}
+}
+
+// returns pending checkpoint (if exists) that the caller is about to resume
+func Take(id string) *Ckpt {
+	g.mu.Lock()
+	ck, ok := g.pending[id]
+	if ok {
+		delete(g.pending, id)
+	}
+	g.mu.Unlock()
+	return ck
+}
+
+// put back into pending (e.g., upon failure to resume)
+func Restore(ck *Ckpt) {
+	g.mu.Lock()
+	g.pending[ck.ID] = ck
+	g.mu.Unlock()
+}
+
+func IsPending(id string) bool {
+	g.mu.Lock()
+	_, ok := g.pending[id]
+	g.mu.Unlock()
+	return ok
+}
+
+func Pending() (cks []*Ckpt) {
+	g.mu.Lock()
+	if l := len(g.pending); l > 0 {
+		cks = make([]*Ckpt, 0, l)
+		for _, ck := range g.pending {
+			cks = append(cks, ck)
+		}
+	}
+	g.mu.Unlock()
+	return cks
+}
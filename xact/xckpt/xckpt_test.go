@@ -0,0 +1,134 @@
+// Package xckpt persists progress checkpoints of resumable xactions, to continue
+// running them (under the same xaction ID) after target restart
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xckpt_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xckpt"
+)
+
+func initMpaths(t *testing.T, num int) (mpaths []string) {
+	fs.TestNew(nil)
+	for range num {
+		mpath := t.TempDir()
+		_, err := fs.Add(mpath, "daeID")
+		tassert.CheckFatal(t, err)
+		mpaths = append(mpaths, mpath)
+	}
+	return mpaths
+}
+
+func newCkpt(id string) *xckpt.Ckpt {
+	return &xckpt.Ckpt{
+		ID:    id,
+		Kind:  apc.ActCopyBck,
+		Bck:   cmn.Bck{Name: "src", Provider: apc.AIS},
+		BckTo: &cmn.Bck{Name: "dst", Provider: apc.AIS},
+		Msg:   []byte(`{"prefix":"abc"}`),
+		Pos:   xckpt.Pos{Joggers: map[string]string{"/mp1": "a/b/c"}, Done: []string{"t1"}},
+		Objs:  10,
+		Bytes: 1000,
+	}
+}
+
+func numPersisted(mpaths []string, id string) (n int) {
+	for _, mpath := range mpaths {
+		if _, err := os.Stat(filepath.Join(mpath, fname.XactCkpt, id)); err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+func TestSaveInit(t *testing.T) {
+	mpaths := initMpaths(t, 3)
+	xckpt.Init()
+
+	ck := newCkpt("x-save")
+	tassert.CheckFatal(t, xckpt.Save(ck))
+	tassert.Errorf(t, ck.Saved > 0, "expecting saved time")
+	tassert.Errorf(t, !xckpt.IsPending(ck.ID), "saved (running) checkpoint must not be pending")
+
+	// saving again does not add copies
+	ck.Pos.Joggers["/mp1"] = "a/b/d"
+	tassert.CheckFatal(t, xckpt.Save(ck))
+	tassert.Errorf(t, numPersisted(mpaths, ck.ID) == 1, "expecting a single persisted copy, got %d", numPersisted(mpaths, ck.ID))
+
+	// stray temp file gets removed upon restart
+	tmp := filepath.Join(mpaths[0], fname.XactCkpt, "x-other.tmp.123")
+	tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(tmp), 0o755))
+	tassert.CheckFatal(t, os.WriteFile(tmp, []byte("garbage"), 0o644))
+
+	// restart
+	xckpt.Init()
+	tassert.Fatalf(t, xckpt.IsPending(ck.ID), "expecting %s to be pending upon restart", ck)
+	_, err := os.Stat(tmp)
+	tassert.Errorf(t, os.IsNotExist(err), "expecting %s to be removed", tmp)
+
+	cks := xckpt.Pending()
+	tassert.Fatalf(t, len(cks) == 1, "expecting 1 pending, got %d", len(cks))
+	loaded := cks[0]
+	tassert.Errorf(t, loaded.Kind == ck.Kind && loaded.Bck.Equal(&ck.Bck) && loaded.BckTo.Equal(ck.BckTo),
+		"loaded %+v vs saved %+v", loaded, ck)
+	tassert.Errorf(t, loaded.Pos.Joggers["/mp1"] == "a/b/d", "expecting the last saved position, got %v", loaded.Pos.Joggers)
+	tassert.Errorf(t, loaded.Objs == 10 && loaded.Bytes == 1000, "stats: %d, %d", loaded.Objs, loaded.Bytes)
+	tassert.Errorf(t, string(loaded.Msg) == string(ck.Msg), "msg: %s vs %s", loaded.Msg, ck.Msg)
+
+	xckpt.Remove(ck.ID)
+	tassert.Errorf(t, !xckpt.IsPending(ck.ID), "expecting %s to be removed", ck)
+	tassert.Errorf(t, numPersisted(mpaths, ck.ID) == 0, "expecting no persisted copies")
+}
+
+func TestInitCorrupted(t *testing.T) {
+	mpaths := initMpaths(t, 1)
+
+	fqn := filepath.Join(mpaths[0], fname.XactCkpt, "x-corrupted")
+	tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(fqn), 0o755))
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte("not a checkpoint"), 0o644))
+
+	// persisted under a different name
+	ck := newCkpt("x-renamed")
+	tassert.CheckFatal(t, xckpt.Save(ck))
+	tassert.CheckFatal(t, os.Rename(filepath.Join(mpaths[0], fname.XactCkpt, ck.ID), filepath.Join(mpaths[0], fname.XactCkpt, "x-other")))
+
+	xckpt.Init()
+	tassert.Errorf(t, len(xckpt.Pending()) == 0, "expecting nothing pending, got %d", len(xckpt.Pending()))
+	tassert.Errorf(t, numPersisted(mpaths, "x-corrupted") == 0 && numPersisted(mpaths, "x-other") == 0,
+		"expecting invalid checkpoints to be removed")
+}
+
+func TestSuspendTake(t *testing.T) {
+	mpaths := initMpaths(t, 2)
+	xckpt.Init()
+
+	ck := newCkpt("x-suspend")
+	tassert.CheckFatal(t, xckpt.Suspend(ck))
+	tassert.Fatalf(t, xckpt.IsPending(ck.ID), "expecting %s to be pending", ck)
+	tassert.Errorf(t, numPersisted(mpaths, ck.ID) == 1, "expecting suspended checkpoint to be persisted")
+
+	// take (resume) exactly once
+	taken := xckpt.Take(ck.ID)
+	tassert.Fatalf(t, taken == ck, "expecting to take %s", ck)
+	tassert.Errorf(t, xckpt.Take(ck.ID) == nil, "expecting %s to be taken only once", ck)
+	tassert.Errorf(t, !xckpt.IsPending(ck.ID), "taken checkpoint must not be pending")
+	tassert.Errorf(t, numPersisted(mpaths, ck.ID) == 1, "taken checkpoint remains persisted (until removed)")
+
+	// failed to resume
+	xckpt.Restore(taken)
+	tassert.Errorf(t, xckpt.IsPending(ck.ID), "expecting %s to be pending again", ck)
+
+	xckpt.Remove(ck.ID)
+	tassert.Errorf(t, xckpt.Take(ck.ID) == nil, "expecting nothing to take")
+	tassert.Errorf(t, numPersisted(mpaths, ck.ID) == 0, "expecting no persisted copies")
+}
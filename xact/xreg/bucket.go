@@ -38,6 +38,10 @@ func RenewECEncode(bck *meta.Bck, uuid, phase string, checkAndRecover bool) Rene
 	return RenewBucketXact(apc.ActECEncode, bck, args)
 }
 
+func RenewECReencode(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActECReencode, bck, Args{UUID: uuid})
+}
+
 func RenewMakeNCopies(uuid, tag string) {
 	var (
 		cfg      = cmn.GCO.Get()
@@ -81,6 +85,18 @@ func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
 
+func RenewBckLsoIndex(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActLsoIndex, bck, Args{UUID: uuid})
+}
+
+func RenewLifecycle(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActLifecycle, bck, Args{UUID: uuid})
+}
+
+func RenewReplicate(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActReplicate, bck, Args{UUID: uuid})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
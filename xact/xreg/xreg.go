@@ -81,10 +81,11 @@ type (
 	abortArgs struct {
 		err error // original cause (or reason), e.g. cmn.ErrUserAbort
 		// criteria
-		bcks   []*meta.Bck // run on a slice of buckets
-		scope  []int       // one of { ScopeG, ScopeB, ... } enum
-		kind   string      // all of a kind
-		newreb bool        // (rebalance is starting) vs (dtor.AbortRebRes)
+		bcks    []*meta.Bck // run on a slice of buckets
+		scope   []int       // one of { ScopeG, ScopeB, ... } enum
+		kind    string      // all of a kind
+		newreb  bool        // (rebalance is starting) vs (dtor.AbortRebRes)
+		suspend bool        // all resumable (dtor.Resumable)
 	}
 
 	entries struct {
@@ -242,6 +243,29 @@ func AbortKind(err error, kind string) {
 
 func AbortByNewReb(err error) { dreg.abort(&abortArgs{err: err, newreb: true}) }
 
+// suspend all resumable xactions (e.g., upon shutdown) - see xact/xckpt
+// returns IDs of the suspended ones
+func SuspendResumable() (ids []string) {
+	args := &abortArgs{err: cmn.ErrXactSuspended, suspend: true}
+	dreg.entries.forEach(func(entry Renewable) bool {
+		xctn := entry.Get()
+		if args.do(entry) && xctn.IsSuspended() {
+			ids = append(ids, xctn.ID())
+		}
+		return true
+	})
+	return ids
+}
+
+// remove finished xaction from the registry, so that it can be resumed under the same ID
+// (and, in the meantime, is not reported as aborted)
+func Forget(id string) {
+	e := &dreg.entries
+	e.mtx.Lock()
+	e.del(id)
+	e.mtx.Unlock()
+}
+
 func DoAbort(flt Flt, err error) {
 	switch {
 	case flt.ID != "":
@@ -339,15 +363,23 @@ func (args *abortArgs) do(entry Renewable) bool {
 		return true
 	}
 
-	var abort bool
+	var (
+		abort bool
+		err   = args.err
+	)
 	switch {
 	case args.newreb:
 		debug.Assertf(args.scope == nil && args.kind == "", "scope %v, kind %q", args.scope, args.kind)
-		_, dtor, err := xact.GetDescriptor(xctn.Kind())
-		debug.AssertNoErr(err)
+		_, dtor, e := xact.GetDescriptor(xctn.Kind())
+		debug.AssertNoErr(e)
 		if dtor.AbortRebRes {
 			abort = true
+			if dtor.Resumable {
+				err = cmn.ErrXactSuspended // to resume when rebalance is done
+			}
 		}
+	case args.suspend:
+		abort = xact.IsResumable(xctn.Kind())
 	case len(args.bcks) > 0:
 		debug.Assertf(args.scope == nil, "scope %v", args.scope)
 		for _, bck := range args.bcks {
@@ -367,7 +399,7 @@ func (args *abortArgs) do(entry Renewable) bool {
 	}
 
 	if abort {
-		xctn.Abort(args.err)
+		xctn.Abort(err)
 	}
 	return true
 }
@@ -580,7 +580,7 @@ func (wi *archwi) openTarForAppend() (err error) {
 }
 
 // multi-object iterator i/f: "handle work item"
-func (wi *archwi) do(lom *core.LOM, lrit *lrit, _ []byte) {
+func (wi *archwi) do(lom *core.LOM, lrit *lrit, _ []byte, _ int64) {
 	var coldGet bool
 	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
 		if !cos.IsNotExist(err, 0) {
@@ -30,6 +30,7 @@ import (
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
@@ -62,6 +63,7 @@ type (
 		cksum   cos.CksumHash
 		sgls    []*memsys.SGL
 		readers []*blobReader
+		ckpt    *ckpter // (resumable)
 		xact.Base
 		wg       sync.WaitGroup
 		nextRoff int64
@@ -230,6 +232,16 @@ func (p *blobFactory) Start() error {
 		}
 	}
 
+	if r.args.Resumable && r.args.RspW == nil && r.args.WriteSGL == nil {
+		if err := r.initCkpt(); err != nil {
+			return err
+		}
+		// resuming: fewer chunks to go
+		if rem := r.fullSize - r.woff; int64(r.numWorkers)*r.chunkSize > rem {
+			r.numWorkers = max(int((rem+r.chunkSize-1)/r.chunkSize), 1)
+		}
+	}
+
 	// open channels
 	r.workCh = make(chan chunkWi, r.numWorkers)
 	r.doneCh = make(chan chunkDone, r.numWorkers)
@@ -257,7 +269,9 @@ func (p *blobFactory) Start() error {
 
 	ws := make([]io.Writer, 0, 3)
 	if ty := r.args.Lom.CksumConf().Type; ty != cos.ChecksumNone {
-		r.cksum.Init(ty)
+		if r.cksum.H == nil {
+			r.cksum.Init(ty)
+		}
 		ws = append(ws, r.cksum.H)
 	}
 	ws = append(ws, r.args.Lmfh)
@@ -277,6 +291,53 @@ func (p *blobFactory) Start() error {
 	return nil
 }
 
+// resumable: checkpoint the (sequential) write offset; when resuming, continue
+// writing the same workfile (the caller makes sure it's valid and truncates it to the offset)
+func (r *XactBlobDl) initCkpt() error {
+	lom := r.args.Lom
+	ck := newCkpt(r.ID(), r.Kind(), lom.Bck(), r.args.Msg)
+	ck.ObjName = lom.ObjName
+	r.ckpt = &ckpter{}
+	r.ckpt.init(&r.Base, ck, r.pos, false /*lag*/)
+
+	off := ck.Pos.Off
+	if off == 0 {
+		return nil
+	}
+	debug.Assert(ck.Pos.Wfqn == r.args.Wfqn && off < r.fullSize, ck.Pos.Wfqn, " vs ", r.args.Wfqn)
+	if ty := lom.CksumConf().Type; ty != cos.ChecksumNone {
+		// checksum the already downloaded part
+		r.cksum.Init(ty)
+		fh, err := os.Open(r.args.Wfqn)
+		if err != nil {
+			return err
+		}
+		_, err = io.CopyN(r.cksum.H, fh, off)
+		cos.Close(fh)
+		if err != nil {
+			return err
+		}
+	}
+	r.woff, r.nextRoff = off, off
+	nlog.Infoln(r.Name(), "resuming at offset", off)
+	return nil
+}
+
+func (r *XactBlobDl) pos(pos *xckpt.Pos) {
+	pos.Off = r.woff
+	pos.Wfqn = r.args.Wfqn
+	pos.Size = r.fullSize
+	pos.Version = r.args.Lom.Version()
+}
+
+// only standalone (checkpointed) blob downloads get suspended
+func (r *XactBlobDl) Abort(err error) bool {
+	if r.ckpt == nil && errors.Is(err, cmn.ErrXactSuspended) {
+		err = errNotResumable
+	}
+	return r.Base.Abort(err)
+}
+
 func (*blobFactory) Kind() string     { return apc.ActBlobDl }
 func (p *blobFactory) Get() core.Xact { return p.xctn }
 
@@ -358,6 +419,9 @@ outer:
 			if err = r.write(sgl); err != nil {
 				goto fin
 			}
+			if r.ckpt != nil {
+				r.ckpt.saveIf(mono.NanoTime())
+			}
 
 			if r.nextRoff < r.fullSize {
 				debug.Assert(sgl.Size() == 0)
@@ -382,6 +446,9 @@ outer:
 			}
 		case <-r.ChanAbort():
 			err = cmn.ErrXactUserAbort
+			if r.IsSuspended() {
+				err = cmn.ErrXactSuspended
+			}
 			goto fin
 		}
 	}
@@ -422,10 +489,12 @@ fin:
 
 			r.ObjsAdd(1, 0)
 		} else {
-			if errRemove := cos.RemoveFile(r.args.Wfqn); errRemove != nil && !os.IsNotExist(errRemove) {
-				nlog.Errorln("nested err:", errRemove)
+			if err != cmn.ErrXactSuspended || r.ckpt == nil { // when suspended, keep the workfile to resume
+				if errRemove := cos.RemoveFile(r.args.Wfqn); errRemove != nil && !os.IsNotExist(errRemove) {
+					nlog.Errorln("nested err:", errRemove)
+				}
 			}
-			if err != cmn.ErrXactUserAbort {
+			if err != cmn.ErrXactUserAbort && err != cmn.ErrXactSuspended {
 				r.Abort(err)
 			}
 		}
@@ -435,6 +504,9 @@ fin:
 	close(r.doneCh)
 	r.cleanup()
 	r.Finish()
+	if r.ckpt != nil {
+		r.ckpt.fin()
+	}
 }
 
 func (r *XactBlobDl) start() {
@@ -0,0 +1,274 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Resumable xactions (copy-bck, etl-bck, prefetch, blob-download) periodically checkpoint
+// their respective progress - see xact/xckpt for the big picture.
+//
+// The position that gets checkpointed may (optionally) lag: items that were visited (e.g., by
+// a jogger) but are still being processed - queued to workers, waiting on destination rate-limiting,
+// or being sent to other targets - must not be skipped upon resumption. To that end, each visited
+// item gets marked with the current epoch (see mark, unmark); every tick ends the current epoch
+// with a snapshot of the position, and the checkpoint advances to the most recent snapshot
+// that has no items in flight behind it. The resulting semantics is at-least-once.
+
+const ckptEpochs = 8 // max number of epochs with items in flight (ring)
+
+type (
+	ckpter struct {
+		xctn    *xact.Base
+		ck      *xckpt.Ckpt
+		fill    func(*xckpt.Pos)     // kind-specific: current position
+		msgf    func(*xckpt.Pos) any // (optional) control message(s) to persist with the checkpointed position
+		stopCh  *cos.StopCh
+		snaps   [ckptEpochs]xckpt.Pos // position at the end of the respective epoch
+		inflt   [ckptEpochs]atomic.Int64
+		epoch   atomic.Int64 // current
+		oldest  int64        // the oldest epoch that may still have items in flight
+		last    int64        // mono time of the last (inline) checkpoint - see saveIf
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		lag     bool
+		done    bool
+		rewound bool
+	}
+)
+
+var (
+	errQuiTimeout   = errors.New("timed out waiting for")
+	errNotResumable = errors.New("aborted upon suspension (not resumable)")
+)
+
+// new or resumed (under the same xaction ID)
+func newCkpt(id, kind string, bck *meta.Bck, msg any) (ck *xckpt.Ckpt) {
+	if ck = xckpt.Take(id); ck != nil {
+		ck.Resumes++
+		nlog.Infoln("resuming", ck.String(), "[ resumes:", ck.Resumes, "]")
+		return ck
+	}
+	return &xckpt.Ckpt{
+		ID:      id,
+		Kind:    kind,
+		Bck:     *bck.Bucket(),
+		Msg:     cos.MustMarshal(msg),
+		Started: time.Now().UnixNano(),
+	}
+}
+
+func (c *ckpter) init(xctn *xact.Base, ck *xckpt.Ckpt, fill func(*xckpt.Pos), lag bool) {
+	c.xctn = xctn
+	c.ck = ck
+	c.fill = fill
+	c.lag = lag
+	c.stopCh = cos.NewStopCh()
+	c.last = mono.NanoTime()
+	if ck.Resumes > 0 {
+		xctn.ObjsAdd(int(ck.Objs), ck.Bytes) // restore stats
+	}
+}
+
+func (c *ckpter) resumed() bool { return c.ck.Resumes > 0 }
+
+func (c *ckpter) start() {
+	c.wg.Add(1)
+	go c.run()
+}
+
+func (c *ckpter) run() {
+	ticker := time.NewTicker(xckpt.SaveIval)
+	defer func() {
+		ticker.Stop()
+		c.wg.Done()
+	}()
+	for {
+		select {
+		case <-ticker.C:
+			c.save()
+		case <-c.stopCh.Listen():
+			return
+		}
+	}
+}
+
+// (alternatively) checkpoint inline, from the xaction's own goroutine
+func (c *ckpter) saveIf(now int64) {
+	if time.Duration(now-c.last) < xckpt.SaveIval {
+		return
+	}
+	c.last = now
+	c.save()
+}
+
+func (c *ckpter) save() {
+	c.mu.Lock()
+	if !c.done {
+		c.update()
+		if err := xckpt.Save(c.ck); err != nil {
+			nlog.Warningln(c.xctn.Name(), "failed to checkpoint:", err)
+		}
+	}
+	c.mu.Unlock()
+}
+
+// (under lock)
+func (c *ckpter) update() {
+	var cur xckpt.Pos
+	c.fill(&cur)
+	switch {
+	case c.rewound:
+		c.ck.Pos = xckpt.Pos{Done: cur.Done}
+	case c.lag:
+		c.advance(&cur)
+		c.ck.Pos.Done = cur.Done // (not lagging)
+	default:
+		c.ck.Pos = cur
+	}
+	if c.msgf != nil {
+		c.ck.Msg = cos.MustMarshal(c.msgf(&c.ck.Pos))
+	}
+	c.ck.Objs, c.ck.Bytes = c.xctn.Objs(), c.xctn.Bytes()
+}
+
+// (under lock) end the current epoch, unless all ring slots are taken
+func (c *ckpter) advance(cur *xckpt.Pos) {
+	ended := c.endEpoch(cur)
+	c.commit()
+	if !ended {
+		c.endEpoch(cur) // (slot freed)
+		c.commit()
+	}
+}
+
+func (c *ckpter) endEpoch(cur *xckpt.Pos) bool {
+	e := c.epoch.Load()
+	if e-c.oldest >= ckptEpochs-1 {
+		return false
+	}
+	c.snaps[e%ckptEpochs] = *cur
+	c.epoch.Store(e + 1)
+	return true
+}
+
+// advance the checkpoint through the ended epochs that have no items in flight
+func (c *ckpter) commit() {
+	for c.oldest < c.epoch.Load() && c.inflt[c.oldest%ckptEpochs].Load() == 0 {
+		c.ck.Pos = c.snaps[c.oldest%ckptEpochs]
+		c.snaps[c.oldest%ckptEpochs] = xckpt.Pos{}
+		c.oldest++
+	}
+}
+
+// visiting: must be called prior to advancing the position (that includes the item)
+func (c *ckpter) mark() int64 {
+	if c == nil || !c.lag {
+		return 0
+	}
+	for {
+		e := c.epoch.Load()
+		c.inflt[e%ckptEpochs].Inc()
+		if c.epoch.Load() == e {
+			return e
+		}
+		c.inflt[e%ckptEpochs].Dec() // (retry: the epoch has ended in the meantime)
+	}
+}
+
+// the item (marked with the given epoch and not yet unmarked) remains in flight
+// until the respective (additional) unmark - e.g., when sent asynchronously
+func (c *ckpter) retain(epoch int64) {
+	if c == nil || !c.lag {
+		return
+	}
+	c.inflt[epoch%ckptEpochs].Inc()
+}
+
+// the item (marked with the given epoch) is done, successfully or not
+func (c *ckpter) unmark(epoch int64) {
+	if c == nil || !c.lag {
+		return
+	}
+	c.inflt[epoch%ckptEpochs].Dec()
+}
+
+// resume from the very beginning
+func (c *ckpter) rewind() {
+	c.mu.Lock()
+	c.rewound = true
+	c.mu.Unlock()
+}
+
+// stop checkpointing and take the final position (when suspended)
+func (c *ckpter) stop() {
+	c.stopCh.Close()
+	c.wg.Wait()
+
+	c.mu.Lock()
+	if !c.done {
+		c.done = true
+		if c.xctn.IsSuspended() {
+			c.update() // (items abandoned in flight remain marked)
+		}
+	}
+	c.mu.Unlock()
+}
+
+// must be called after xctn.Finish(): retain the checkpoint iff suspended
+func (c *ckpter) fin() {
+	c.stop()
+	if !c.xctn.IsSuspended() {
+		xckpt.Remove(c.ck.ID)
+		return
+	}
+	// remove from the registry prior to adding to pending (see xckpt.Take)
+	xreg.Forget(c.ck.ID)
+	if err := xckpt.Suspend(c.ck); err != nil {
+		nlog.Errorln(c.xctn.Name(), "failed to suspend:", err)
+	}
+}
+
+// suspend rather than abort upon transient errors (but not forever)
+func (c *ckpter) suspend(err error) error {
+	if c == nil || err == nil || c.ck.Resumes >= xckpt.MaxResumes {
+		return err
+	}
+	if errors.Is(err, cmn.ErrXactSuspended) || errors.Is(err, cmn.ErrXactUserAbort) {
+		return err
+	}
+	if isTransient(err) {
+		nlog.Warningln(c.xctn.Name(), "suspending upon transient error [", err, "]")
+		return cmn.ErrXactSuspended
+	}
+	return err
+}
+
+// cluster membership changes and peer connectivity
+func isTransient(err error) bool {
+	var errMc *cmn.ErrMembershipChanges
+	if errors.As(err, &errMc) {
+		return true
+	}
+	var errSt *cmn.ErrStreamTerminated
+	if errors.As(err, &errSt) {
+		return true
+	}
+	return errors.Is(err, errQuiTimeout) || cos.IsRetriableConnErr(err)
+}
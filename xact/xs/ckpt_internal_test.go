@@ -0,0 +1,351 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/transport"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// position: number of visited items (see ckpter.mark)
+type testPos struct {
+	done []string
+	n    int64
+	mu   sync.Mutex
+}
+
+func (p *testPos) visit(c *ckpter) int64 {
+	epoch := c.mark()
+	p.mu.Lock()
+	p.n++
+	p.mu.Unlock()
+	return epoch
+}
+
+func (p *testPos) fill(pos *xckpt.Pos) {
+	p.mu.Lock()
+	pos.N, pos.Done = p.n, p.done
+	p.mu.Unlock()
+}
+
+func newTestCkpter(t *testing.T, lag bool, start xckpt.Pos) (*ckpter, *testPos, *xact.Base) {
+	xctn := &xact.Base{}
+	xctn.InitBase(cos.GenUUID(), apc.ActCopyBck, "", nil)
+	var (
+		p = &testPos{n: start.N}
+		c = &ckpter{}
+		b = meta.NewBck("ckpt-src", apc.AIS, cmn.NsGlobal)
+	)
+	c.init(xctn, newCkpt(xctn.ID(), xctn.Kind(), b, &apc.TCBMsg{}), p.fill, lag)
+	c.ck.Pos = start
+	t.Cleanup(c.stopCh.Close)
+	return c, p, xctn
+}
+
+func TestCkptNoLag(t *testing.T) {
+	c, p, _ := newTestCkpter(t, false, xckpt.Pos{})
+	epoch := p.visit(c)
+	c.update()
+	tassert.Errorf(t, c.ck.Pos.N == 1, "expecting current position (1), got %d", c.ck.Pos.N)
+	c.unmark(epoch) // (no-op)
+
+	// nil ckpter
+	var nc *ckpter
+	nc.unmark(nc.mark())
+	nc.retain(0)
+}
+
+func TestCkptInFlight(t *testing.T) {
+	c, p, _ := newTestCkpter(t, true, xckpt.Pos{N: 5})
+
+	// nothing in flight: checkpoint the current position right away
+	c.unmark(p.visit(c))
+	c.update()
+	tassert.Fatalf(t, c.ck.Pos.N == 6, "expecting 6, got %d", c.ck.Pos.N)
+
+	// visited but still in flight
+	e1 := p.visit(c)
+	c.update()
+	tassert.Fatalf(t, c.ck.Pos.N == 6, "must not get ahead of in-flight item: expecting 6, got %d", c.ck.Pos.N)
+
+	// more visited and done, while e1 is still in flight (e.g., waiting on rate-limiting)
+	for range 3 {
+		c.unmark(p.visit(c))
+		c.update()
+		tassert.Fatalf(t, c.ck.Pos.N == 6, "expecting 6, got %d", c.ck.Pos.N)
+	}
+
+	// done
+	c.unmark(e1)
+	c.update()
+	tassert.Fatalf(t, c.ck.Pos.N == 10, "expecting 10, got %d", c.ck.Pos.N)
+}
+
+func TestCkptRetain(t *testing.T) {
+	c, p, _ := newTestCkpter(t, true, xckpt.Pos{})
+
+	// e.g., visited by lrit and then sent (asynchronously) by the copier
+	epoch := p.visit(c)
+	c.retain(epoch)
+	c.unmark(epoch)
+	c.update()
+	c.update()
+	tassert.Fatalf(t, c.ck.Pos.N == 0, "expecting 0 while being sent, got %d", c.ck.Pos.N)
+
+	c.unmark(epoch) // sent
+	c.update()
+	tassert.Fatalf(t, c.ck.Pos.N == 1, "expecting 1, got %d", c.ck.Pos.N)
+}
+
+func TestCkptRingFull(t *testing.T) {
+	c, p, _ := newTestCkpter(t, true, xckpt.Pos{})
+
+	held := p.visit(c)
+	for i := range 3 * ckptEpochs {
+		c.unmark(p.visit(c))
+		c.update()
+		tassert.Fatalf(t, c.ck.Pos.N == 0, "%d: expecting 0, got %d", i, c.ck.Pos.N)
+		tassert.Fatalf(t, c.epoch.Load()-c.oldest < ckptEpochs, "%d: epochs %d..%d exceed the ring",
+			i, c.oldest, c.epoch.Load())
+	}
+	c.unmark(held)
+	c.update()
+	tassert.Fatalf(t, c.ck.Pos.N == 1+3*ckptEpochs, "expecting %d, got %d", 1+3*ckptEpochs, c.ck.Pos.N)
+	for i := range ckptEpochs {
+		tassert.Errorf(t, c.inflt[i].Load() == 0, "epoch slot %d: %d in flight", i, c.inflt[i].Load())
+	}
+}
+
+func TestCkptConcurrent(t *testing.T) {
+	const (
+		numWorkers = 4
+		numItems   = 10000
+	)
+	type item struct {
+		epoch int64
+		pos   int
+	}
+	var (
+		c, p, _ = newTestCkpter(t, true, xckpt.Pos{})
+		workCh  = make(chan item, 64)
+		done    = make([]atomic.Bool, numItems+1) // by position
+		wg      sync.WaitGroup
+	)
+	for range numWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for it := range workCh {
+				done[it.pos].Store(true)
+				c.unmark(it.epoch)
+			}
+		}()
+	}
+	// checkpointed position must never get ahead of the items in flight
+	// (here, "in flight" means queued; workers complete them in arbitrary order)
+	check := func() {
+		c.mu.Lock()
+		c.update()
+		pos := int(c.ck.Pos.N)
+		c.mu.Unlock()
+		for k := 1; k <= pos; k++ {
+			tassert.Fatalf(t, done[k].Load(), "checkpointed %d while %d is still in flight", pos, k)
+		}
+	}
+	for i := 1; i <= numItems; i++ {
+		workCh <- item{p.visit(c), i}
+		if i%100 == 0 {
+			check()
+		}
+	}
+	close(workCh)
+	wg.Wait()
+
+	check()
+	tassert.Fatalf(t, c.ck.Pos.N == numItems, "expecting %d, got %d", numItems, c.ck.Pos.N)
+}
+
+func TestCkptDoneAndRewind(t *testing.T) {
+	c, p, _ := newTestCkpter(t, true, xckpt.Pos{})
+
+	epoch := p.visit(c)
+	p.done = []string{"t1"}
+	c.update()
+	tassert.Errorf(t, c.ck.Pos.N == 0, "expecting 0, got %d", c.ck.Pos.N)
+	tassert.Errorf(t, len(c.ck.Pos.Done) == 1, "finished targets are not lagging: %v", c.ck.Pos.Done)
+
+	c.rewind()
+	c.unmark(epoch)
+	c.update()
+	tassert.Errorf(t, c.ck.Pos.N == 0 && len(c.ck.Pos.Done) == 1, "expecting rewound position, got %+v", c.ck.Pos)
+}
+
+func TestCkptSuspend(t *testing.T) {
+	c, _, _ := newTestCkpter(t, true, xckpt.Pos{})
+	tests := []struct {
+		err     error
+		suspend bool
+	}{
+		{cmn.NewErrMembershipChanges("test"), true},
+		{&cmn.ErrStreamTerminated{}, true},
+		{errQuiTimeout, true},
+		{errors.New("fatal"), false},
+		{cmn.ErrXactUserAbort, false},
+	}
+	for i, test := range tests {
+		err := c.suspend(test.err)
+		tassert.Errorf(t, errors.Is(err, cmn.ErrXactSuspended) == test.suspend, "%d: %v => %v", i, test.err, err)
+	}
+	tassert.Errorf(t, c.suspend(nil) == nil, "expecting nil")
+
+	c.ck.Resumes = xckpt.MaxResumes
+	err := c.suspend(cmn.NewErrMembershipChanges("test"))
+	tassert.Errorf(t, !errors.Is(err, cmn.ErrXactSuspended), "expecting abort upon max resumes, got %v", err)
+
+	var nc *ckpter
+	err = errors.New("fatal")
+	tassert.Errorf(t, nc.suspend(err) == err, "expecting pass-through")
+}
+
+func TestCkptFin(t *testing.T) {
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	xckpt.Init()
+	xreg.TestReset()
+	xact.IncFinished = func() {} // (not registered)
+
+	// finished: checkpoint removed
+	c, p, xctn := newTestCkpter(t, true, xckpt.Pos{})
+	c.unmark(p.visit(c))
+	xctn.Finish()
+	c.fin()
+	tassert.Errorf(t, !xckpt.IsPending(c.ck.ID), "expecting finished %s to be removed", c.ck)
+
+	// suspended: retained with the final (in-flight aware) position
+	c, p, xctn = newTestCkpter(t, true, xckpt.Pos{})
+	c.unmark(p.visit(c))
+	c.update() // (tick)
+	p.visit(c) // abandoned in flight
+	xctn.Abort(cmn.ErrXactSuspended)
+	xctn.Finish()
+	c.fin()
+	ck := xckpt.Take(c.ck.ID)
+	tassert.Fatalf(t, ck != nil, "expecting suspended %s to be pending", c.ck)
+	tassert.Errorf(t, ck.Pos.N == 1, "expecting 1, got %d", ck.Pos.N)
+	xckpt.Remove(ck.ID)
+}
+
+//
+// sentinel
+//
+
+func TestSentinelDone(t *testing.T) {
+	var (
+		smap = &meta.Smap{Tmap: make(meta.NodeMap, 4), Version: 1}
+		xctn = &XactRepl{} // (any)
+	)
+	mock.NewTarget(mock.NewBaseBownerMock())
+	xctn.InitBase(cos.GenUUID(), apc.ActCopyBck, "", nil)
+	for _, tid := range []string{core.T.SID(), "t1", "t2", "t3"} {
+		smap.Tmap[tid] = &meta.Snode{DaeID: tid, DaeType: apc.Target}
+	}
+
+	// resuming: t2 (and t9 that's no longer a member) have already finished
+	var s sentinel
+	s.init(xctn, smap, 4, []string{"t2", "t9"})
+	tassert.Errorf(t, s.pend.n.Load() == 2, "expecting 2 pending, got %d", s.pend.n.Load())
+	done := s.done()
+	tassert.Fatalf(t, len(done) == 1 && done[0] == "t2", "expecting [t2], got %v", done)
+
+	s.rxDone(&transport.ObjHdr{SID: "t1"})
+	s.rxDone(&transport.ObjHdr{SID: "t1"}) // (idempotent)
+	tassert.Errorf(t, s.pend.n.Load() == 1, "expecting 1 pending, got %d", s.pend.n.Load())
+	done = s.done()
+	tassert.Errorf(t, len(done) == 2, "expecting 2 done, got %v", done)
+	for _, tid := range done {
+		tassert.Errorf(t, tid == "t1" || tid == "t2", "unexpected %s", tid)
+	}
+}
+
+//
+// lrit
+//
+
+type testLrwi struct {
+	c     *ckpter
+	names []string
+	nofly []string // not in flight (must be empty)
+}
+
+func (wi *testLrwi) do(lom *core.LOM, _ *lrit, _ []byte, epoch int64) {
+	wi.names = append(wi.names, lom.ObjName)
+	if wi.c.inflt[epoch%ckptEpochs].Load() <= 0 {
+		wi.nofly = append(wi.nofly, lom.ObjName)
+	}
+}
+
+func TestLritSkip(t *testing.T) {
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	bck := meta.NewBck("lrit-bck", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x21})
+	mock.NewTarget(mock.NewBaseBownerMock(bck))
+
+	names := make([]string, 10)
+	for i := range names {
+		names[i] = "obj-" + strconv.Itoa(i)
+	}
+	c, _, xctn := newTestCkpter(t, true, xckpt.Pos{})
+
+	// resuming: 4 names processed prior to suspension
+	r := &lrit{}
+	tassert.CheckFatal(t, r.init(xctn, &apc.ListRange{ObjNames: names}, bck, nwpNone))
+	r.start = xckpt.Pos{N: 4}
+	r.ckpt = c
+	c.fill = r.progress
+
+	wi := &testLrwi{c: c}
+	tassert.CheckFatal(t, r.run(wi, nil /*smap*/, false))
+	r.wait()
+
+	tassert.Fatalf(t, len(wi.names) == 6, "expecting 6 names, got %v", wi.names)
+	tassert.Errorf(t, wi.names[0] == "obj-4", "expecting to resume at obj-4, got %s", wi.names[0])
+	tassert.Errorf(t, len(wi.nofly) == 0, "expecting all to be in flight, got %v", wi.nofly)
+	var pos xckpt.Pos
+	r.progress(&pos)
+	tassert.Errorf(t, pos.N == 10, "expecting position 10, got %d", pos.N)
+	tassert.Errorf(t, !r.skip(), "nothing left to skip")
+
+	c.update()
+	tassert.Errorf(t, c.ck.Pos.N == 10, "expecting checkpointed 10, got %d", c.ck.Pos.N)
+
+	// range
+	r = &lrit{}
+	tassert.CheckFatal(t, r.init(xctn, &apc.ListRange{Template: "obj-{0..9}"}, bck, nwpNone))
+	r.start = xckpt.Pos{N: 7}
+	wi = &testLrwi{c: c}
+	r.ckpt = c
+	tassert.CheckFatal(t, r.run(wi, nil, false))
+	r.wait()
+	tassert.Fatalf(t, len(wi.names) == 3 && wi.names[0] == "obj-7", "expecting obj-7..9, got %v", wi.names)
+}
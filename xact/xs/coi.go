@@ -26,6 +26,7 @@ type (
 		Config *cmn.Config
 		BckTo  *meta.Bck
 		core.GetROC
+		SentCB          func() // (optional) sending via data mover: called upon completion, successful or not
 		ObjnameTo       string
 		Buf             []byte
 		OWT             cmn.OWT
@@ -40,6 +41,7 @@ type (
 		Lsize int64
 		Ecode int
 		RGET  bool // when reading source via backend.GetObjReader
+		Async bool // SentCB will be called (asynchronously)
 	}
 
 	COI interface {
@@ -82,6 +84,7 @@ func FreeCOI(a *CoiParams) {
 type (
 	copier struct {
 		r      core.Xact
+		ckpt   *ckpter      // resumable (see mark, unmark)
 		bp     core.Backend // backend(source bucket)
 		getROC core.GetROC
 		rate   tcrate
@@ -117,14 +120,21 @@ func (tc *copier) prepare(lom *core.LOM, bckTo *meta.Bck, msg *apc.TCBMsg, confi
 	return a
 }
 
-func (tc *copier) do(a *CoiParams, lom *core.LOM, dm *bundle.DM) (err error) {
+// the object (visited in the given epoch) remains in flight until sent - see ckpter
+func (tc *copier) do(a *CoiParams, lom *core.LOM, dm *bundle.DM, epoch int64) (err error) {
 	var started int64
 	if tc.bp != nil {
 		started = mono.NanoTime()
 	}
+	if dm != nil && tc.ckpt != nil {
+		a.SentCB = func() { tc.ckpt.unmark(epoch) }
+	}
 
 	res := gcoi.CopyObject(lom, dm, a)
 	FreeCOI(a)
+	if !res.Async {
+		tc.ckpt.unmark(epoch)
+	}
 
 	switch {
 	case res.Err == nil:
@@ -98,7 +98,7 @@ func (r *evictDelete) Run(wg *sync.WaitGroup) {
 	r.Finish()
 }
 
-func (r *evictDelete) do(lom *core.LOM, lrit *lrit, _ []byte) {
+func (r *evictDelete) do(lom *core.LOM, lrit *lrit, _ []byte, _ int64) {
 	ecode, err := core.T.DeleteObject(lom, r.Kind() == apc.ActEvictObjects)
 	if err == nil { // done
 		r.ObjsAdd(1, lom.Lsize(true))
@@ -34,6 +34,9 @@ func Treg(coi COI) {
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&lsiFactory{})
+	xreg.RegBckXact(&lcyFactory{})
+	xreg.RegBckXact(&replFactory{})
 
 	gcoi = coi
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
@@ -0,0 +1,155 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-lifecycle: target-local walk of a given bucket that applies the bucket's
+// lifecycle rules (cmn.LifecycleConf) to all locally stored objects.
+// Is periodically started by the target's housekeeper for each bucket with
+// active lifecycle configuration; can be also started via `api.StartXaction`.
+
+type (
+	lcyFactory struct {
+		xreg.RenewBase
+		xctn *xactLifecycle
+	}
+	xactLifecycle struct {
+		rules []cmn.LifecycleRule
+		xact.BckJog
+		now    int64
+		remote bool
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*xactLifecycle)(nil)
+	_ xreg.Renewable = (*lcyFactory)(nil)
+)
+
+////////////////
+// lcyFactory //
+////////////////
+
+func (*lcyFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &lcyFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *lcyFactory) Start() error {
+	xctn := newXactLifecycle(p.UUID(), p.Bck)
+	p.xctn = xctn
+	go xctn.Run(nil)
+	return nil
+}
+
+func (*lcyFactory) Kind() string     { return apc.ActLifecycle }
+func (p *lcyFactory) Get() core.Xact { return p.xctn }
+
+func (*lcyFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+///////////////////
+// xactLifecycle //
+///////////////////
+
+func newXactLifecycle(uuid string, bck *meta.Bck) (r *xactLifecycle) {
+	r = &xactLifecycle{
+		rules:  bck.Props.Lifecycle.Rules,
+		now:    time.Now().UnixNano(),
+		remote: bck.IsRemote(),
+	}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.do,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActLifecycle, "" /*ctlmsg*/, bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *xactLifecycle) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "rules:", len(r.rules))
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+// first matching rule wins
+func (r *xactLifecycle) do(lom *core.LOM, _ []byte) error {
+	_, _, mtime, err := lom.Fstat(false /*get-atime*/)
+	if err != nil {
+		if !cos.IsNotExist(err, 0) {
+			r.AddErr(err, 5, cos.SmoduleXs)
+		}
+		return nil
+	}
+	var (
+		tags   cos.StrKVs
+		age    = r.now - mtime.UnixNano()
+		getTag = func(k string) (string, bool) {
+			if tags == nil {
+				s, _ := lom.GetCustomKey(cmn.TagsObjMD)
+				if tags, _ = cmn.ParseObjTags(s); tags == nil {
+					tags = cos.StrKVs{}
+				}
+			}
+			v, ok := tags[k]
+			return v, ok
+		}
+	)
+	for i := range r.rules {
+		rule := &r.rules[i]
+		if !rule.Match(lom.ObjName, age, getTag) {
+			continue
+		}
+		evict := rule.Action == cmn.LcActEvict
+		if evict && !r.remote {
+			continue // (nothing to evict)
+		}
+		size := lom.Lsize()
+		ecode, err := core.T.DeleteObject(lom, evict)
+		switch {
+		case err == nil:
+			r.ObjsAdd(1, size)
+			if cmn.Rom.FastV(5, cos.SmoduleXs) {
+				nlog.Infoln(r.Name(), "rule", rule.ID, rule.Action, lom.Cname())
+			}
+		case cos.IsNotExist(err, ecode) || cmn.IsErrObjNought(err):
+		default:
+			r.AddErr(err, 5, cos.SmoduleXs)
+		}
+		break
+	}
+	return nil
+}
+
+func (r *xactLifecycle) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact/xckpt"
 )
 
 // Assorted multi-object (list/range templated) xactions: evict, delete, prefetch multiple objects
@@ -41,7 +42,7 @@ const (
 type (
 	// one multi-object operation work item
 	lrwi interface {
-		do(*core.LOM, *lrit, []byte)
+		do(*core.LOM, *lrit, []byte, int64 /*epoch: see ckpter.mark*/)
 	}
 	// a strict subset of core.Xact, includes only the methods
 	// lrit needs for itself
@@ -55,8 +56,9 @@ type (
 
 	// running concurrency
 	lrpair struct {
-		lom *core.LOM
-		wi  lrwi
+		lom   *core.LOM
+		wi    lrwi
+		epoch int64
 	}
 	lrworker struct {
 		lrit *lrit
@@ -79,6 +81,14 @@ type (
 		buf    []byte       // when (prealloc && no-workers)
 		numvis atomic.Int64 // counter: num visited objects
 		lrp    int          // enum { lrpList, ... }
+		// (resumable) current position, and the position to resume from (see xact/xckpt)
+		pos struct {
+			token string       // continuation token of the current page (lrpPrefix)
+			n     atomic.Int64 // num names iterated: total (lrpList, lrpRange) or in the current page (lrpPrefix)
+			mu    sync.Mutex
+		}
+		start xckpt.Pos
+		ckpt  *ckpter // nil when not resumable
 	}
 )
 
@@ -225,12 +235,33 @@ func (r *lrit) wait() {
 
 func (r *lrit) done() bool { return r.parent.IsAborted() || r.parent.Finished() }
 
+// (resumable) see ckpter
+func (r *lrit) progress(pos *xckpt.Pos) {
+	r.pos.mu.Lock()
+	pos.Token = r.pos.token
+	pos.N = r.pos.n.Load()
+	r.pos.mu.Unlock()
+}
+
+// (resumable) skip names iterated prior to suspension
+func (r *lrit) skip() bool {
+	if r.start.N <= 0 {
+		return false
+	}
+	r.start.N--
+	r.pos.n.Inc()
+	return true
+}
+
 func (r *lrit) _list(wi lrwi, smap *meta.Smap) error {
 	r.lrp = lrpList
 	for _, objName := range r.msg.ObjNames {
 		if r.done() {
 			break
 		}
+		if r.skip() {
+			continue
+		}
 		lom := core.AllocLOM(objName)
 		done, err := r.do(lom, wi, smap)
 		if err != nil {
@@ -250,6 +281,9 @@ func (r *lrit) _range(wi lrwi, smap *meta.Smap) error {
 		if r.done() {
 			return nil
 		}
+		if r.skip() {
+			continue
+		}
 		lom := core.AllocLOM(objName)
 		done, err := r.do(lom, wi, smap)
 		if err != nil {
@@ -276,6 +310,10 @@ func (r *lrit) _prefix(wi lrwi, smap *meta.Smap) error {
 		bremote = r.bck.IsRemote()
 	)
 	lsmsg.SetFlag(apc.LsNoDirs)
+	if r.start.Token != "" {
+		lsmsg.ContinuationToken = r.start.Token // resuming
+		r.pos.token = r.start.Token
+	}
 
 	if err := r.bck.Init(core.T.Bowner()); err != nil {
 		return err
@@ -301,10 +339,15 @@ func (r *lrit) _prefix(wi lrwi, smap *meta.Smap) error {
 			return err
 		}
 		for _, be := range lst.Entries {
+			if r.skip() {
+				continue
+			}
 			if !be.IsStatusOK() {
+				r.pos.n.Inc()
 				continue
 			}
 			if be.IsAnyFlagSet(apc.EntryIsDir) { // always skip virtual dirs
+				r.pos.n.Inc()
 				continue
 			}
 			if r.done() {
@@ -329,6 +372,10 @@ func (r *lrit) _prefix(wi lrwi, smap *meta.Smap) error {
 		}
 		// token for the next page
 		lsmsg.ContinuationToken = lst.ContinuationToken
+		r.pos.mu.Lock()
+		r.pos.token = lst.ContinuationToken
+		r.pos.n.Store(0)
+		r.pos.mu.Unlock()
 	}
 	return nil
 }
@@ -337,24 +384,30 @@ func (r *lrit) do(lom *core.LOM, wi lrwi, smap *meta.Smap) (bool /*this lom done
 	if err := lom.InitBck(r.bck.Bucket()); err != nil {
 		return false, err
 	}
+	epoch := r.ckpt.mark()
+	r.pos.n.Inc()
+
 	// (smap != nil) to filter non-locals
 	if smap != nil {
 		_, local, err := lom.HrwTarget(smap)
 		if err != nil {
+			r.ckpt.unmark(epoch)
 			return false, err
 		}
 		if !local {
+			r.ckpt.unmark(epoch)
 			return true, nil
 		}
 	}
 
 	if r.nwp.workers == nil {
-		wi.do(lom, r, r.buf)
+		wi.do(lom, r, r.buf, epoch)
+		r.ckpt.unmark(epoch)
 		r.numvis.Inc()
 		return true, nil
 	}
 
-	r.nwp.workCh <- lrpair{lom, wi} // lom eventually freed below // TODO -- FIXME: consider core.LIF
+	r.nwp.workCh <- lrpair{lom, wi, epoch} // lom eventually freed below // TODO -- FIXME: consider core.LIF
 	return false, nil
 }
 
@@ -372,7 +425,8 @@ outer:
 			if !ok {
 				break outer
 			}
-			lrpair.wi.do(lrpair.lom, worker.lrit, buf)
+			lrpair.wi.do(lrpair.lom, worker.lrit, buf, lrpair.epoch)
+			worker.lrit.ckpt.unmark(lrpair.epoch)
 			core.FreeLOM(lrpair.lom)
 			worker.lrit.numvis.Inc()
 		case <-stopCh:
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,11 +23,13 @@ import (
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/lpi"
+	"github.com/NVIDIA/aistore/fs/lsidx"
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/transport"
@@ -473,6 +476,7 @@ func (r *LsoXact) thisPageR(npg *npgCtx) (page *cmn.LsoRes, err error) {
 	r.walk.last = page.ContinuationToken == ""
 
 	if r.walk.wor {
+		npg.filterEnts(page)
 		return page, nil
 	}
 	if aborted = r.IsAborted(); aborted {
@@ -616,6 +620,30 @@ func (r *LsoXact) shiftLastPage(token string) {
 
 func (r *LsoXact) doWalk(msg *apc.LsoMsg) {
 	r.walk.wi = newWalkInfo(msg, r.LomAdd)
+
+	// prefix that crosses shard boundary: list (and paginate) archived content
+	// (note: without LsArchDir the same prefix simply means virtual directory)
+	if shard, inner := archive.SplitPrefix(msg.Prefix); shard != "" && msg.IsFlagSet(apc.LsArchDir) {
+		if err := r.walkArch(shard, inner); err != nil && err != errStopped {
+			r.AddErr(err, 0)
+		}
+		close(r.walk.pageCh)
+		r.walk.wg.Done()
+		return
+	}
+
+	// index-served listing, if requested and available (otherwise, fall back to walking)
+	if msg.IsFlagSet(apc.LsIndexed) {
+		if idx := r.getIndex(); idx != nil {
+			if err := r.walkIndex(idx); err != nil && err != errStopped {
+				r.AddErr(err, 0)
+			}
+			close(r.walk.pageCh)
+			r.walk.wg.Done()
+			return
+		}
+	}
+
 	opts := &fs.WalkBckOpts{
 		WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Callback: r.cb, Prefix: msg.Prefix, Sorted: true},
 	}
@@ -693,9 +721,10 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	entry.Flags |= apc.EntryIsArchive // the parent archive
 	for _, archEntry := range archList {
 		e := &cmn.LsoEnt{
-			Name:  path.Join(entry.Name, archEntry.Name),
-			Flags: entry.Flags | apc.EntryInArch,
-			Size:  archEntry.Size,
+			Name:   path.Join(entry.Name, archEntry.Name),
+			Flags:  entry.Flags | apc.EntryInArch,
+			Size:   archEntry.Size,
+			Offset: archEntry.Offset,
 		}
 		select {
 		case r.walk.pageCh <- e:
@@ -707,6 +736,176 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// list files inside a given shard, e.g. "A.tar/tutorials/" => ("A.tar", "tutorials/");
+// only the shard's (HRW) target does the listing; names are sorted and
+// resumable via continuation token (see walkInfo.match)
+func (r *LsoXact) walkArch(shard, inner string) error {
+	var (
+		wi  = r.walk.wi
+		lom = core.AllocLOM(shard)
+	)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		return err
+	}
+	if _, local, err := lom.HrwTarget(wi.smap); err != nil || !local {
+		return err
+	}
+
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if cmn.IsErrObjNought(err) {
+			return nil
+		}
+		return err
+	}
+	archList, err := archive.List(lom.FQN)
+	lom.Unlock(false)
+	if err != nil {
+		return err
+	}
+
+	msg := wi.lsmsg()
+	for _, archEntry := range archList {
+		if !strings.HasPrefix(archEntry.Name, inner) {
+			continue
+		}
+		name := shard + "/" + archEntry.Name
+		if !wi.match(name) || name <= msg.StartAfter {
+			continue
+		}
+		e := &cmn.LsoEnt{
+			Name:   name,
+			Flags:  apc.LocOK | apc.EntryIsCached | apc.EntryInArch,
+			Size:   archEntry.Size,
+			Offset: archEntry.Offset,
+		}
+		if wi.flt != nil && !wi.flt.MatchEnt(e) {
+			continue
+		}
+		select {
+		case r.walk.pageCh <- e:
+		case <-r.walk.stopCh.Listen():
+			return errStopped
+		}
+	}
+	return nil
+}
+
+func (r *LsoXact) getIndex() *lsidx.Index {
+	bck := r.Bck()
+	if !bck.Props.Features.IsSet(feat.ListIndex) {
+		return nil
+	}
+	return lsidx.Get(bck.Bucket(), bck.Props.BID)
+}
+
+// list objects using this target's list-objects index (see fs/lsidx), whereby:
+// - index entries are sorted and are consumed in chunks;
+// - names-only listing requires no object metadata (fast path);
+// - otherwise, objects get loaded, and those that are no longer present are skipped;
+// - LsNoRecursion: names that are nested deeper than the prefix yield virtual directories
+func (r *LsoXact) walkIndex(idx *lsidx.Index) error {
+	const chunk = 1024
+	var (
+		wi       = r.walk.wi
+		msg      = wi.lsmsg()
+		bck      = r.Bck()
+		after    = max(msg.ContinuationToken, msg.StartAfter)
+		from     = after
+		dirp     string
+		nameOnly = msg.IsFlagSet(apc.LsNameOnly) && (wi.flt == nil || wi.flt.NameOnly())
+		norecurs = msg.IsFlagSet(apc.LsNoRecursion)
+		names    = make([]string, 0, chunk)
+	)
+	if after != "" {
+		from = after + "\x00" // strictly greater
+	}
+	if norecurs {
+		if i := strings.LastIndexByte(msg.Prefix, '/'); i >= 0 {
+			dirp = msg.Prefix[:i+1]
+		}
+	}
+	for {
+		var ok bool
+		names, ok = idx.Page(msg.Prefix, from, chunk, names[:0])
+		if !ok {
+			return errors.New(bck.Cname("") + ": list-objects index is no longer available")
+		}
+		if len(names) == 0 {
+			return nil
+		}
+		from = names[len(names)-1] + "\x00"
+		for _, name := range names {
+			var en *cmn.LsoEnt
+			if norecurs {
+				if i := strings.IndexByte(name[len(dirp):], '/'); i >= 0 {
+					dir := name[:len(dirp)+i]
+					from = dir + "0" // ('/' + 1): skip the entire subtree
+					if dir <= after || msg.IsFlagSet(apc.LsNoDirs) {
+						break
+					}
+					en = &cmn.LsoEnt{Name: dir, Flags: apc.EntryIsDir}
+					if err := r.sendEnt(en); err != nil {
+						return err
+					}
+					break
+				}
+			}
+			if nameOnly {
+				tsi, err := wi.smap.HrwName2T(bck.MakeUname(name))
+				if err != nil {
+					return err
+				}
+				if tsi.ID() != core.T.SID() || (wi.flt != nil && !wi.flt.MatchName(name)) {
+					continue
+				}
+				en = &cmn.LsoEnt{Name: name, Flags: apc.LocOK | apc.EntryIsCached}
+			} else {
+				var err error
+				if en, err = r.idxEnt(name); err != nil {
+					return err
+				}
+				if en == nil {
+					continue
+				}
+			}
+			if err := r.sendEnt(en); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (r *LsoXact) idxEnt(name string) (*cmn.LsoEnt, error) {
+	lom := core.AllocLOM(name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		return nil, err
+	}
+	wi := r.walk.wi
+	if _, local, err := lom.HrwTarget(wi.smap); err != nil || !local {
+		return nil, err
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if cmn.IsErrObjNought(err) {
+			return nil, nil // stale index entry
+		}
+		return nil, err
+	}
+	return wi.ls(lom, apc.LocOK), nil
+}
+
+func (r *LsoXact) sendEnt(en *cmn.LsoEnt) error {
+	select {
+	case r.walk.pageCh <- en:
+		return nil
+	case <-r.walk.stopCh.Listen():
+		return errStopped
+	}
+}
+
 func (r *LsoXact) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
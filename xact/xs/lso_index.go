@@ -0,0 +1,132 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/lsidx"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-rebuild-list-index: target-local walk that (re)builds the bucket's list-objects index
+// (see fs/lsidx); the previously built index, if any, remains in service until the walk completes.
+// Requires bucket feature flag feat.ListIndex.
+
+type (
+	lsiFactory struct {
+		xreg.RenewBase
+		xctn *xactLsoIndex
+	}
+	xactLsoIndex struct {
+		idx *lsidx.Index
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*xactLsoIndex)(nil)
+	_ xreg.Renewable = (*lsiFactory)(nil)
+)
+
+////////////////
+// lsiFactory //
+////////////////
+
+func (*lsiFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &lsiFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *lsiFactory) Start() error {
+	bck := p.Bck
+	if !bck.Props.Features.IsSet(feat.ListIndex) {
+		return cmn.NewErrUnsupp("build list-objects index for", bck.Cname("")+" (feature flag \"Enable-List-Index\" not set)")
+	}
+	idx, ok := lsidx.StartBuild(bck.Bucket(), bck.Props.BID)
+	if !ok {
+		return errors.New(bck.Cname("") + ": list-objects index is already being built")
+	}
+	xctn := newXactLsoIndex(p.UUID(), bck, idx)
+	p.xctn = xctn
+	go xctn.Run(nil)
+	return nil
+}
+
+func (*lsiFactory) Kind() string     { return apc.ActLsoIndex }
+func (p *lsiFactory) Get() core.Xact { return p.xctn }
+
+func (*lsiFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+//////////////////
+// xactLsoIndex //
+//////////////////
+
+func newXactLsoIndex(uuid string, bck *meta.Bck, idx *lsidx.Index) (r *xactLsoIndex) {
+	r = &xactLsoIndex{idx: idx}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.do,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActLsoIndex, "" /*ctlmsg*/, bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *xactLsoIndex) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	ok := err == nil && !r.IsAborted()
+	r.idx.EndBuild(ok)
+	if ok {
+		nlog.Infoln(r.Name(), "indexed:", r.idx.Len())
+	}
+	r.Finish()
+}
+
+// names only (no need to load except shortened names); skip copies and misplaced
+func (r *xactLsoIndex) do(lom *core.LOM, _ []byte) error {
+	name := lom.ObjName
+	if fs.HasPrefixFntl(name) {
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil || lom.IsCopy() {
+			return nil
+		}
+		if lom.IsFntl() {
+			if orig := lom.OrigFntl(); orig != nil {
+				name = orig[1]
+			}
+		}
+	} else if !lom.IsHRW() {
+		return nil
+	}
+	r.idx.BuildAdd(name)
+	r.ObjsAdd(1, 0)
+	return nil
+}
+
+func (r *xactLsoIndex) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -41,6 +41,7 @@ func newNpgCtx(bck *meta.Bck, msg *apc.LsoMsg, cb lomVisitedCb, ctx *core.LsoInv
 	if msg.IsFlagSet(apc.LsDiff) {
 		npg.wi.custom = make(cos.StrKVs) // TODO: move to parent x-lso; clear and reuse here
 	}
+	npg.wi.initFilter(msg)
 	return npg
 }
 
@@ -146,6 +147,10 @@ func (npg *npgCtx) filterAddLmeta(lst *cmn.LsoRes) error {
 			core.FreeLOM(lom)
 			continue
 		}
+		if npg.wi.flt != nil && !npg.wi.matchLOM(lom) {
+			core.FreeLOM(lom)
+			continue
+		}
 
 		npg.wi.setWanted(en, lom)
 		en.SetFlag(apc.EntryIsCached) // formerly, SetPresent
@@ -156,6 +161,9 @@ func (npg *npgCtx) filterAddLmeta(lst *cmn.LsoRes) error {
 
 	keep:
 		core.FreeLOM(lom)
+		if npg.wi.flt != nil && !en.IsAnyFlagSet(apc.EntryIsCached) && !npg.wi.flt.MatchEnt(en) {
+			continue
+		}
 		lst.Entries[i] = en
 		i++
 	}
@@ -163,3 +171,19 @@ func (npg *npgCtx) filterAddLmeta(lst *cmn.LsoRes) error {
 	lst.Entries = lst.Entries[:i]
 	return nil
 }
+
+// pass-through (wantOnlyRemote) page: evaluate server-side filter against remote entries as is
+func (npg *npgCtx) filterEnts(lst *cmn.LsoRes) {
+	if npg.wi.flt == nil {
+		return
+	}
+	var i int
+	for _, en := range lst.Entries {
+		if !en.IsAnyFlagSet(apc.EntryIsDir) && !npg.wi.flt.MatchEnt(en) {
+			continue
+		}
+		lst.Entries[i] = en
+		i++
+	}
+	lst.Entries = lst.Entries[:i]
+}
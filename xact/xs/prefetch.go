@@ -56,6 +56,7 @@ type (
 		msg    *apc.PrefetchMsg
 		vlabs  map[string]string
 		brl    *cos.BurstRateLim
+		ckpt   *ckpter
 		pebl   pebl
 		lrit
 		xact.Base
@@ -88,8 +89,8 @@ func (p *prfFactory) Start() (err error) {
 	if err := b.Init(core.T.Bowner()); err != nil {
 		return err
 	}
-	if !b.IsCloud() && !b.IsRemoteAIS() {
-		return fmt.Errorf("can only prefetch Cloud and remote AIS buckets (have %s)", b.Cname(""))
+	if !b.IsCloud() && !b.IsRemoteAIS() && !b.IsFile() {
+		return fmt.Errorf("can only prefetch Cloud, file, and remote AIS buckets (have %s)", b.Cname(""))
 	}
 	p.xctn, err = newPrefetch(&p.Args, p.Kind(), b, p.msg)
 	return err
@@ -110,6 +111,13 @@ func newPrefetch(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.Prefetch
 		return nil, err
 	}
 	r.InitBase(xargs.UUID, kind, msg.Str(r.lrp == lrpPrefix), bck)
+
+	ck := newCkpt(xargs.UUID, kind, bck, msg)
+	r.ckpt = &ckpter{}
+	r.ckpt.init(&r.Base, ck, r.lrit.progress, true /*lag*/)
+	r.lrit.start = ck.Pos
+	r.lrit.ckpt = r.ckpt
+
 	r.latestVer = bck.VersionConf().ValidateWarmGet || msg.LatestVer
 
 	smap := core.T.Sowner().Get()
@@ -135,6 +143,8 @@ func (r *prefetch) Run(wg *sync.WaitGroup) {
 
 	wg.Done()
 
+	r.ckpt.start()
+
 	err := r.lrit.run(r, core.T.Sowner().Get(), false /*prealloc buf*/)
 	if err != nil {
 		r.AddErr(err, 5, cos.SmoduleXs) // duplicated?
@@ -145,16 +155,21 @@ func (r *prefetch) Run(wg *sync.WaitGroup) {
 	if r.pebl.num() > 0 {
 		if r.IsAborted() {
 			r.pebl.abort(r.AbortErr())
+			if r.IsSuspended() {
+				// objects being blob-downloaded may precede the checkpointed position
+				r.ckpt.rewind()
+			}
 		} else {
 			r.pebl.wait()
 		}
 	}
 
 	r.Finish()
+	r.ckpt.fin()
 }
 
 // NOTE ref 6735188: _not_ setting negative atime, flushing lom metadata
-func (r *prefetch) do(lom *core.LOM, lrit *lrit, _ []byte) {
+func (r *prefetch) do(lom *core.LOM, lrit *lrit, _ []byte, _ int64) {
 	var (
 		err     error
 		oa      *cmn.ObjAttrs
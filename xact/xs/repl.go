@@ -0,0 +1,314 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs/replq"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-replicate: target-local shipping of the changes recorded in the bucket's change log
+// (fs/replq) to the destination bucket in a remote AIS cluster (see cmn.ReplConf).
+// - changes are shipped in batches; within a batch, multiple changes of the same object
+//   collapse into the most recent one, and different objects get shipped in parallel
+// - PUT ships the current content of the object (if it's been deleted since, there's
+//   a subsequent DELETE in the log; if it's been migrated by rebalance, the new owner
+//   records the PUT in its own log)
+// - failed batch is retried with exponential backoff; after `replRetries` attempts
+//   the xaction finishes with error, leaving the log intact (the target will start
+//   a new one later)
+// - finishes after `replIdle` of inactivity
+
+const (
+	replBatch    = 1024
+	replWorkers  = 8
+	replRetries  = 5
+	replIdle     = time.Minute
+	replPoll     = time.Second
+	replMaxPause = 30 * time.Second
+)
+
+type (
+	replFactory struct {
+		xreg.RenewBase
+		xctn *XactRepl
+	}
+	XactRepl struct {
+		q     *replq.Queue
+		dst   *meta.Bck
+		bp    core.Backend
+		vlabs map[string]string
+		xact.Base
+	}
+	replEvent struct {
+		err error
+		replq.Event
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactRepl)(nil)
+	_ xreg.Renewable = (*replFactory)(nil)
+)
+
+/////////////////
+// replFactory //
+/////////////////
+
+func (*replFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &replFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *replFactory) Start() error {
+	xctn, err := newXactRepl(p.UUID(), p.Bck)
+	if err != nil {
+		return err
+	}
+	p.xctn = xctn
+	go xctn.Run(nil)
+	return nil
+}
+
+func (*replFactory) Kind() string     { return apc.ActReplicate }
+func (p *replFactory) Get() core.Xact { return p.xctn }
+
+func (*replFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+//////////////
+// XactRepl //
+//////////////
+
+func newXactRepl(uuid string, bck *meta.Bck) (*XactRepl, error) {
+	conf := &bck.Props.Replication
+	if !conf.Enabled {
+		return nil, fmt.Errorf("bucket %s: replication is not enabled", bck.Cname(""))
+	}
+	dstBck, err := conf.DstBck()
+	if err != nil {
+		return nil, err
+	}
+	dst := meta.CloneBck(&dstBck)
+	if err := dst.Init(core.T.Bowner()); err != nil {
+		return nil, err
+	}
+	q, err := replq.Get(bck.Bucket(), bck.Props.BID)
+	if err != nil {
+		return nil, err
+	}
+	r := &XactRepl{
+		q:     q,
+		dst:   dst,
+		bp:    core.T.Backend(dst),
+		vlabs: map[string]string{stats.VlabBucket: bck.Cname("")},
+	}
+	r.InitBase(uuid, apc.ActReplicate, "=> "+dst.Cname("") /*ctlmsg*/, bck)
+	return r, nil
+}
+
+func (r *XactRepl) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "pending:", cos.ToSizeIEC(r.q.Pending(), 2))
+	r.q.SetXid(r.ID())
+
+	var (
+		evs    []replq.Event
+		active = mono.NanoTime()
+	)
+outer:
+	for !r.IsAborted() {
+		var (
+			pos replq.Pos
+			err error
+		)
+		evs, pos, err = r.q.Read(replBatch, evs[:0])
+		if err != nil {
+			r.AddErr(err)
+			break
+		}
+		if len(evs) == 0 {
+			if mono.Since(active) > replIdle {
+				break
+			}
+			select {
+			case <-r.ChanAbort():
+				break outer
+			case <-time.After(replPoll):
+				continue
+			}
+		}
+		// commit only when the entire batch has been shipped
+		if err := r.batch(evs); err != nil {
+			if !r.IsAborted() {
+				r.AddErr(err)
+			}
+			break
+		}
+		if err := r.q.Commit(pos); err != nil {
+			r.AddErr(err)
+			break
+		}
+		active = mono.NanoTime()
+	}
+
+	r.q.SetXid("")
+	r.Finish()
+}
+
+// ship a batch, retry failed changes with exponential backoff
+func (r *XactRepl) batch(evs []replq.Event) error {
+	// collapse (the most recent change wins)
+	var (
+		idx  = make(map[string]int, len(evs))
+		todo = make([]*replEvent, 0, len(evs))
+	)
+	for _, ev := range evs {
+		if i, ok := idx[ev.Name]; ok {
+			todo[i].Event = ev
+			continue
+		}
+		idx[ev.Name] = len(todo)
+		todo = append(todo, &replEvent{Event: ev})
+	}
+
+	pause := time.Second
+	for i := 0; ; i++ {
+		failed := r.ship(todo)
+		if len(failed) == 0 {
+			return nil
+		}
+		err := failed[0].err
+		r.q.Failed(err)
+		core.T.StatsUpdater().AddWith(cos.NamedVal64{Name: stats.ErrReplCount, Value: int64(len(failed)), VarLabs: r.vlabs})
+		if i >= replRetries {
+			return fmt.Errorf("%s: failed to replicate %d change%s, err: %v", r, len(failed), cos.Plural(len(failed)), err)
+		}
+		nlog.Warningln(r.Name(), "failed to replicate", len(failed), "change(s), err:", err, "- retrying in", pause)
+		select {
+		case <-r.ChanAbort():
+			return r.AbortErr()
+		case <-time.After(pause):
+		}
+		pause = min(pause*2, replMaxPause)
+		todo = failed
+	}
+}
+
+// returns failed changes, if any
+func (r *XactRepl) ship(todo []*replEvent) (failed []*replEvent) {
+	var (
+		wg   sync.WaitGroup
+		work = make(chan *replEvent, len(todo))
+	)
+	for _, ev := range todo {
+		work <- ev
+	}
+	close(work)
+	nw := min(replWorkers, len(todo))
+	wg.Add(nw)
+	for range nw {
+		go func() {
+			for ev := range work {
+				ev.err = r.do(ev)
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	for _, ev := range todo {
+		if ev.err != nil {
+			failed = append(failed, ev)
+		}
+	}
+	return failed
+}
+
+func (r *XactRepl) do(ev *replEvent) error {
+	var (
+		size  int64
+		dlom  = core.AllocLOM(ev.Name)
+		ecode int
+	)
+	defer core.FreeLOM(dlom)
+	if err := dlom.InitBck(r.dst.Bucket()); err != nil {
+		return err
+	}
+	if ev.Op == replq.OpDel {
+		var err error
+		if ecode, err = r.bp.DeleteObj(context.Background(), dlom); err != nil && !cos.IsNotExist(err, ecode) {
+			return err
+		}
+	} else {
+		lom := core.AllocLOM(ev.Name)
+		err := r._put(lom, dlom)
+		core.FreeLOM(lom)
+		switch {
+		case err == nil:
+			size = dlom.Lsize(true)
+		case cos.IsNotExist(err, 0):
+			// removed or renamed since - the corresponding DELETE follows;
+			// or migrated by rebalance - the new owner records (and ships) the PUT
+			return nil
+		default:
+			return err
+		}
+	}
+
+	// stats
+	r.ObjsAdd(1, size)
+	r.q.Shipped(1)
+	core.T.StatsUpdater().AddWith(
+		cos.NamedVal64{Name: stats.ReplCount, Value: 1, VarLabs: r.vlabs},
+		cos.NamedVal64{Name: stats.ReplSize, Value: size, VarLabs: r.vlabs},
+		cos.NamedVal64{Name: stats.ReplLagTotal, Value: max(time.Now().UnixNano()-ev.Time, 0), VarLabs: r.vlabs},
+	)
+	if cmn.Rom.FastV(5, cos.SmoduleXs) {
+		nlog.Infoln(r.Name(), string(ev.Op), dlom.Cname())
+	}
+	return nil
+}
+
+// PUT the current content of the local object; rlock is held until the remote PUT completes
+func (r *XactRepl) _put(lom, dlom *core.LOM) error {
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	dlom.CopyAttrs(lom.ObjAttrs(), false /*skip cksum*/)
+	roc, err := lom.NewDeferROC() // (unlocks upon close or failure)
+	if err != nil {
+		return err
+	}
+	_, err = r.bp.PutObj(context.Background(), roc, dlom, nil)
+	return err
+}
+
+func (r *XactRepl) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
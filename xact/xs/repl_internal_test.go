@@ -0,0 +1,121 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/replq"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// destination backend that only deletes (and fails while `fail` is set)
+type replBackend struct {
+	core.Backend
+	calls atomic.Int32
+	fail  atomic.Bool
+}
+
+func (bp *replBackend) DeleteObj(context.Context, *core.LOM) (int, error) {
+	bp.calls.Add(1)
+	if bp.fail.Load() {
+		return 0, errors.New("destination unavailable")
+	}
+	return 0, nil
+}
+
+func newTestRepl(t *testing.T, bp core.Backend) (*XactRepl, *replq.Queue) {
+	fs.TestNew(nil)
+	_, err := fs.Add(t.TempDir(), "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+
+	var (
+		src = meta.NewBck("repl-src", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x11})
+		dst = meta.NewBck("repl-dst", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 0x12})
+	)
+	mock.NewTarget(mock.NewBaseBownerMock(src, dst))
+	xact.IncFinished = func() {} // (no registry)
+
+	q, err := replq.Get(src.Bucket(), src.Props.BID)
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { replq.Drop(src.Bucket()) })
+
+	r := &XactRepl{q: q, dst: dst, bp: bp, vlabs: map[string]string{}}
+	r.InitBase(cos.GenUUID(), apc.ActReplicate, "", src)
+	return r, q
+}
+
+func waitFinished(t *testing.T, r *XactRepl) {
+	for range 100 {
+		if r.Finished() {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("%s: failed to finish", r)
+}
+
+func TestReplAbortDuringRetry(t *testing.T) {
+	bp := &replBackend{}
+	bp.fail.Store(true)
+	r, q := newTestRepl(t, bp)
+	for _, name := range []string{"a", "b", "c"} {
+		tassert.CheckFatal(t, q.Append(replq.OpDel, name))
+	}
+	pending := q.Pending()
+
+	go r.Run(nil)
+
+	// first attempt fails, the batch is now waiting to be retried
+	for i := 0; bp.calls.Load() < 3; i++ {
+		tassert.Fatalf(t, i < 100, "expecting the batch to be shipped")
+		time.Sleep(10 * time.Millisecond)
+	}
+	r.Abort(errors.New("test abort"))
+	waitFinished(t, r)
+
+	// the log position must stay put
+	tassert.Errorf(t, q.Pending() == pending, "expecting %d pending, got %d", pending, q.Pending())
+	evs, _, err := q.Read(replBatch, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(evs) == 3, "expecting all 3 changes to remain in the log, got %d", len(evs))
+	tassert.Errorf(t, r.Objs() == 0, "expecting nothing shipped, got %d", r.Objs())
+}
+
+func TestReplCommit(t *testing.T) {
+	bp := &replBackend{}
+	r, q := newTestRepl(t, bp)
+	for _, name := range []string{"a", "b", "a"} {
+		tassert.CheckFatal(t, q.Append(replq.OpDel, name))
+	}
+
+	go r.Run(nil)
+
+	for i := 0; q.Pending() > 0; i++ {
+		tassert.Fatalf(t, i < 100, "expecting the log to be committed")
+		time.Sleep(10 * time.Millisecond)
+	}
+	r.Abort(errors.New("test abort"))
+	waitFinished(t, r)
+
+	evs, _, err := q.Read(replBatch, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(evs) == 0, "expecting empty log, got %d changes", len(evs))
+	tassert.Errorf(t, bp.calls.Load() == 2, "expecting 2 (collapsed) deletions, got %d", bp.calls.Load())
+}
@@ -52,7 +52,8 @@ type (
 	}
 )
 
-func (s *sentinel) init(r core.Xact, smap *meta.Smap, nat int) {
+// (done != nil) when resuming: targets that have already finished (see xact/xckpt)
+func (s *sentinel) init(r core.Xact, smap *meta.Smap, nat int, done []string) {
 	s.r = r
 	s.nat = nat
 	s.pend.n.Store(int64(nat - 1))
@@ -63,9 +64,25 @@ func (s *sentinel) init(r core.Xact, smap *meta.Smap, nat int) {
 		}
 		s.pend.m[tid] = &apair{}
 	}
+	for _, tid := range done {
+		if apair, ok := s.pend.m[tid]; ok {
+			apair.last.Store(apairDeleted)
+			s.pend.n.Dec()
+		}
+	}
 	debug.Assert(nat > 1)
 }
 
+// (resumable) targets that have already finished
+func (s *sentinel) done() (tids []string) {
+	for tid, apair := range s.pend.m {
+		if apair.last.Load() == apairDeleted {
+			tids = append(tids, tid)
+		}
+	}
+	return tids
+}
+
 func (s *sentinel) cleanup() {
 	clear(s.pend.m)
 	s.pend.p = s.pend.p[:0]
@@ -130,7 +147,7 @@ func (s *sentinel) qcb(dm *bundle.DM, tot, ival, progressTimeout time.Duration,
 		if last := apair.last.Load(); last != apairDeleted {
 			debug.Assert(last != 0)
 			if since := time.Duration(now - last); since > progressTimeout {
-				err := fmt.Errorf("%s: timed out waiting for %s [ %v, %v, %v ]", s.r.Name(), meta.Tname(tid), since, tot, s.pend.p)
+				err := fmt.Errorf("%s: %w %s [ %v, %v, %v ]", s.r.Name(), errQuiTimeout, meta.Tname(tid), since, tot, s.pend.p)
 				return s._qabort(err)
 			}
 		}
@@ -218,7 +235,7 @@ func (s *sentinel) rxProgress(hdr *transport.ObjHdr) {
 	}
 	if prev := apair.progress.Swap(numvis); prev != numvis {
 		// target hdr.SID is making progress
-		debug.Assert(prev < numvis)
+		// (the number may also go down when the target resumes after restart - see xact/xckpt)
 		apair.last.Store(mono.NanoTime())
 	}
 
@@ -30,6 +30,7 @@ import (
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
@@ -42,6 +43,10 @@ type (
 	tcbworker struct {
 		r *XactTCB
 	}
+	tcbwi struct {
+		lif   core.LIF
+		epoch int64 // see ckpter.mark
+	}
 	XactTCB struct {
 		// sub-function
 		transform etl.Session
@@ -52,13 +57,14 @@ type (
 		// copying parallelism
 		numwp struct {
 			workers []tcbworker
-			workCh  chan core.LIF
+			workCh  chan tcbwi
 			stopCh  *cos.StopCh
 			wg      sync.WaitGroup
 		}
 		// args
 		args *xreg.TCBArgs
 		dm   *bundle.DM
+		ckpt *ckpter
 		nam  string
 		owt  cmn.OWT
 	}
@@ -86,11 +92,12 @@ func (p *tcbFactory) Start() error {
 		slab, err = core.T.PageMM().GetSlab(memsys.MaxPageSlabSize) // estimate
 		args      = p.Args.Custom.(*xreg.TCBArgs)
 		msg       = args.Msg
+		ck        = newCkpt(p.UUID(), p.kind, args.BckFrom, msg)
 	)
 	debug.AssertNoErr(err)
 
 	r := &XactTCB{args: args}
-	r.init(p, slab, config, smap, nat)
+	r.init(p, slab, config, smap, nat, ck)
 
 	r.owt = cmn.OwtCopy
 	if p.kind == apc.ActETLBck {
@@ -148,7 +155,7 @@ func (p *tcbFactory) Start() error {
 
 	// sentinels, to coordinate finishing, aborting, and progress;
 	// use DM to communicate sentinel opcodes (opDone, opAbort, ...)
-	r.sntl.init(r, smap, nat)
+	r.sntl.init(r, smap, nat, ck.Pos.Done)
 
 	return nil
 }
@@ -158,7 +165,7 @@ func (r *XactTCB) _iniNwp(numWorkers int) {
 	for range numWorkers {
 		r.numwp.workers = append(r.numwp.workers, tcbworker{r})
 	}
-	r.numwp.workCh = make(chan core.LIF, numWorkers*nwpBurst)
+	r.numwp.workCh = make(chan tcbwi, numWorkers*nwpBurst)
 	r.numwp.stopCh = cos.NewStopCh()
 	nlog.Infoln(r.Name(), "workers:", numWorkers)
 }
@@ -212,20 +219,25 @@ func (r *XactTCB) TxnAbort(err error) {
 	r.Base.Finish()
 }
 
-func (r *XactTCB) init(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Smap, nat int) {
+func (r *XactTCB) init(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Smap, nat int, ck *xckpt.Ckpt) {
 	var (
 		args   = r.args
 		msg    = r.args.Msg
 		mpopts = &mpather.JgroupOpts{
-			CTs:      []string{fs.ObjectType},
-			VisitObj: r.do,
-			Prefix:   msg.Prefix,
-			Slab:     slab,
-			DoLoad:   mpather.Load,
-			Throttle: false, // superseded by destination rate-limiting (v3.28)
+			CTs:        []string{fs.ObjectType},
+			VisitObj:   r.do,
+			Prefix:     msg.Prefix,
+			Slab:       slab,
+			DoLoad:     mpather.Load,
+			Throttle:   false, // superseded by destination rate-limiting (v3.28)
+			Sorted:     true,  // resumable
+			StartAfter: ck.Pos.Joggers,
 		}
 	)
 	mpopts.Bck.Copy(args.BckFrom.Bucket())
+	if ck.BckTo == nil {
+		ck.BckTo = args.BckTo.Bucket()
+	}
 
 	// ctlmsg
 	var (
@@ -242,6 +254,9 @@ func (r *XactTCB) init(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, sma
 	// xname
 	r._name(fromCname, toCname, r.BckJog.NumJoggers())
 
+	r.ckpt = &ckpter{}
+	r.ckpt.init(&r.BckJog.Base, ck, r.pos, true /*lag*/)
+
 	r.rate.init(args.BckFrom, args.BckTo, nat)
 
 	if msg.Sync {
@@ -257,6 +272,7 @@ func (r *XactTCB) init(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, sma
 	}
 
 	r.copier.r = r
+	r.copier.ckpt = r.ckpt
 
 	debug.Assert(args.BckFrom.Props != nil)
 	// (rgetstats)
@@ -277,6 +293,10 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 		if err := r.sntl.checkSmap(nil); err != nil {
 			r.Abort(err)
 			wg.Done()
+			r.dm.Close(err)
+			r.dm.UnregRecv()
+			r.Finish()
+			r.ckpt.fin()
 			return
 		}
 
@@ -285,6 +305,8 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 	}
 	wg.Done()
 
+	r.ckpt.start()
+
 	for _, worker := range r.numwp.workers {
 		buf, slab := core.T.PageMM().Alloc()
 		r.numwp.wg.Add(1)
@@ -302,7 +324,7 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 		err = r.AbortErr()
 	}
 
-	if r.dm != nil {
+	if r.dm != nil && !r.IsSuspended() {
 		r.sntl.bcast(r.dm, err) // broadcast: done | abort
 		if !r.IsAborted() {
 			r.sntl.initLast(mono.NanoTime())
@@ -310,10 +332,13 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 			if qui == core.QuiAborted {
 				err := r.AbortErr()
 				debug.Assert(err != nil)
-				r.sntl.bcast(r.dm, err) // broadcast: abort
+				if !r.IsSuspended() {
+					r.sntl.bcast(r.dm, err) // broadcast: abort
+				}
 			}
 		}
-		// close
+	}
+	if r.dm != nil {
 		r.dm.Close(err)
 		r.dm.UnregRecv()
 	}
@@ -327,8 +352,18 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 		r.numwp.wg.Wait()
 	}
 
+	r.ckpt.stop()
 	r.sntl.cleanup()
 	r.Finish()
+	r.ckpt.fin()
+}
+
+// (resumable) the position to resume from (see ckpter)
+func (r *XactTCB) pos(pos *xckpt.Pos) {
+	pos.Joggers = r.BckJog.Progress(nil)
+	if r.dm != nil {
+		pos.Done = r.sntl.done()
+	}
 }
 
 func (r *XactTCB) qival() time.Duration {
@@ -345,18 +380,19 @@ func (r *XactTCB) qcb(tot time.Duration) core.QuiRes {
 }
 
 func (r *XactTCB) do(lom *core.LOM, buf []byte) error {
+	epoch := r.ckpt.mark()
 	if r.numwp.workers == nil {
 		args := r.args // TCBArgs
 		a := r.copier.prepare(lom, args.BckTo, args.Msg, r.Config, buf, r.owt)
 
-		err := r.copier.do(a, lom, r.dm)
+		err := r.copier.do(a, lom, r.dm, epoch)
 		if err == nil && args.Msg.Sync {
 			r.prune.filter.Insert(cos.UnsafeB(lom.Uname()))
 		}
 		return err
 	}
 
-	r.numwp.workCh <- lom.LIF()
+	r.numwp.workCh <- tcbwi{lom.LIF(), epoch}
 	return nil
 }
 
@@ -432,7 +468,7 @@ func (r *XactTCB) _recv(hdr *transport.ObjHdr, objReader io.Reader, lom *core.LO
 }
 
 func (r *XactTCB) Abort(err error) bool {
-	if !r.Base.Abort(err) { // already aborted?
+	if !r.Base.Abort(r.ckpt.suspend(err)) { // already aborted?
 		return false
 	}
 	if r.numwp.stopCh != nil {
@@ -484,11 +520,11 @@ func (worker *tcbworker) run(buf []byte, slab *memsys.Slab) {
 outer:
 	for {
 		select {
-		case lif, ok := <-p.workCh:
+		case wi, ok := <-p.workCh:
 			if !ok {
 				break outer
 			}
-			if aborted := worker.do(lif, buf); aborted {
+			if aborted := worker.do(wi, buf); aborted {
 				break outer
 			}
 		case <-p.stopCh.Listen():
@@ -499,20 +535,21 @@ outer:
 	slab.Free(buf)
 }
 
-func (worker *tcbworker) do(lif core.LIF, buf []byte) bool {
+func (worker *tcbworker) do(wi tcbwi, buf []byte) bool {
 	var (
 		r    = worker.r
 		args = r.args // TCBArgs
 	)
-	lom, err := lif.LOM()
+	lom, err := wi.lif.LOM()
 	if err != nil {
-		nlog.Warningln(r.Name(), lif.Name(), err)
+		nlog.Warningln(r.Name(), wi.lif.Name(), err)
+		r.ckpt.unmark(wi.epoch)
 		r.Abort(err)
 		return true
 	}
 
 	a := r.copier.prepare(lom, args.BckTo, args.Msg, r.Config, buf, r.owt)
-	if err := r.copier.do(a, lom, r.dm); err != nil {
+	if err := r.copier.do(a, lom, r.dm, wi.epoch); err != nil {
 		return r.IsAborted()
 	}
 	if args.Msg.Sync {
@@ -27,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xckpt"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
@@ -49,10 +50,26 @@ type (
 			m   map[string]*tcowi
 			mtx sync.RWMutex
 		}
+		// (resumable) control messages in order of arrival, starting from the checkpointed one
+		msgs struct {
+			lrit  *lrit // iterating the one in progress
+			all   []*cmn.TCOMsg
+			first int64 // sequence number of all[0]
+			cur   int64 // in progress (or the last one done)
+			mu    sync.Mutex
+		}
+		ckpt  *ckpter
+		start xckpt.Pos // resuming: position within the message in progress (start.Seq)
 		streamingX
+		nresumed int // resuming: num messages to (re)run first (see Run)
 		chanFull atomic.Int64
 		owt      cmn.OWT
 	}
+	// (resumable) persisted control messages - see xckpt.Ckpt.Msg
+	TCOCkpt struct {
+		Msgs  []*cmn.TCOMsg `json:"msgs"`
+		First int64         `json:"first"` // sequence number of Msgs[0]
+	}
 	tcowi struct {
 		r   *XactTCO
 		msg *cmn.TCOMsg
@@ -91,11 +108,14 @@ func (p *tcoFactory) Start() error {
 	//
 	// target-local generation of a global UUID
 	//
-	uuid, err := p.genBEID(p.args.BckFrom, p.args.BckTo)
-	if err != nil {
-		return err
+	// (unless resuming)
+	if p.Args.UUID == "" {
+		uuid, err := p.genBEID(p.args.BckFrom, p.args.BckTo)
+		if err != nil {
+			return err
+		}
+		p.Args.UUID = PrefixTcoID + uuid
 	}
-	p.Args.UUID = PrefixTcoID + uuid
 
 	// new x-tco
 	workCh := make(chan *cmn.TCOMsg, maxNumInParallel)
@@ -107,6 +127,7 @@ func (p *tcoFactory) Start() error {
 		r.owt = cmn.OwtTransform
 		// TODO: when the xctn itself encounters unrecoverable error
 		// call r.transform.Finish() to cleanup communicator state
+		var err error
 		r.copier.getROC, r.transform, err = etl.GetOfflineTransform(p.args.Msg.Transform.Name, r)
 		if err != nil {
 			return err
@@ -130,7 +151,11 @@ func (p *tcoFactory) Start() error {
 		}
 	}
 
+	if err := r.initCkpt(p); err != nil {
+		return err
+	}
 	r.copier.r = r
+	r.copier.ckpt = r.ckpt
 
 	// (rgetstats)
 	if bck := r.args.BckFrom; bck.IsRemote() {
@@ -181,6 +206,9 @@ func (r *XactTCO) BeginMsg(msg *cmn.TCOMsg) {
 
 func (r *XactTCO) ContMsg(msg *cmn.TCOMsg) {
 	r.IncPending()
+	r.msgs.mu.Lock()
+	r.msgs.all = append(r.msgs.all, msg)
+	r.msgs.mu.Unlock()
 	r.workCh <- msg
 
 	if l, c := len(r.workCh), cap(r.workCh); l > c/2 {
@@ -194,7 +222,18 @@ func (r *XactTCO) ContMsg(msg *cmn.TCOMsg) {
 	}
 }
 
-func (r *XactTCO) doMsg(msg *cmn.TCOMsg) (stop bool) {
+// (the channel only signals; messages get executed in order of arrival - see ContMsg)
+func (r *XactTCO) next() (*cmn.TCOMsg, int64) {
+	r.msgs.mu.Lock()
+	r.msgs.cur++
+	seq := r.msgs.cur
+	msg := r.msgs.all[seq-r.msgs.first]
+	r.msgs.lrit = nil
+	r.msgs.mu.Unlock()
+	return msg, seq
+}
+
+func (r *XactTCO) doMsg(msg *cmn.TCOMsg, seq int64) (stop bool) {
 	debug.Assert(cos.IsValidUUID(msg.TxnUUID), msg.TxnUUID) // (ref050724: in re: ais/plstcx)
 
 	r.pending.mtx.Lock()
@@ -222,6 +261,13 @@ func (r *XactTCO) doMsg(msg *cmn.TCOMsg) (stop bool) {
 		r.AddErr(err)
 		return !msg.ContinueOnError // stop?
 	}
+	if seq == r.start.Seq {
+		lrit.start = r.start // resuming
+	}
+	lrit.ckpt = r.ckpt
+	r.msgs.mu.Lock()
+	r.msgs.lrit = lrit
+	r.msgs.mu.Unlock()
 
 	// run
 	var wg *sync.WaitGroup
@@ -260,11 +306,25 @@ func (r *XactTCO) doMsg(msg *cmn.TCOMsg) (stop bool) {
 func (r *XactTCO) Run(wg *sync.WaitGroup) {
 	nlog.Infoln(r.Name())
 	wg.Done()
+
+	r.ckpt.start()
+
+	// resuming: messages restored from the checkpoint
+	var stop bool
+	r.IncPending()
+	for ; r.nresumed > 0 && !stop; r.nresumed-- {
+		msg, seq := r.next()
+		if stop = r.doMsg(msg, seq); !stop {
+			r.sendTerm(msg.TxnUUID, nil, nil)
+		}
+	}
+	r.DecPending()
 outer:
-	for {
+	for !stop {
 		select {
-		case msg := <-r.workCh:
-			stop := r.doMsg(msg)
+		case <-r.workCh:
+			msg, seq := r.next()
+			stop := r.doMsg(msg, seq)
 			r.DecPending()
 			if stop {
 				break outer
@@ -283,6 +343,64 @@ outer:
 		clear(r.pending.m)
 		r.pending.mtx.Unlock()
 	}
+	r.ckpt.fin()
+}
+
+func (r *XactTCO) Abort(err error) bool {
+	return r.DemandBase.Abort(r.ckpt.suspend(err))
+}
+
+//
+// resumable (see ckpter)
+//
+
+func (r *XactTCO) initCkpt(p *tcoFactory) error {
+	ck := newCkpt(p.UUID(), p.kind, p.args.BckFrom, &TCOCkpt{First: 1})
+	if ck.BckTo == nil {
+		ck.BckTo = p.args.BckTo.Bucket()
+	}
+	r.msgs.first = 1
+	if ck.Resumes > 0 {
+		ckm := &TCOCkpt{}
+		if err := cos.JSON.Unmarshal(ck.Msg, ckm); err != nil {
+			return err
+		}
+		r.msgs.all, r.msgs.first = ckm.Msgs, ckm.First
+		r.nresumed = len(ckm.Msgs)
+		r.start = ck.Pos
+		for i, msg := range ckm.Msgs {
+			if i == 0 || msg.TxnUUID != ckm.Msgs[i-1].TxnUUID {
+				r.BeginMsg(msg)
+			}
+		}
+	}
+	r.msgs.cur = r.msgs.first - 1
+
+	r.ckpt = &ckpter{msgf: r.ckmsg}
+	r.ckpt.init(&r.Base, ck, r.pos, true /*lag*/)
+	return nil
+}
+
+// the message in progress, and the position within it
+func (r *XactTCO) pos(pos *xckpt.Pos) {
+	r.msgs.mu.Lock()
+	pos.Seq = r.msgs.cur
+	if r.msgs.lrit != nil {
+		r.msgs.lrit.progress(pos)
+	}
+	r.msgs.mu.Unlock()
+}
+
+// persist the checkpointed message and all subsequent ones (the preceding ones are done)
+func (r *XactTCO) ckmsg(pos *xckpt.Pos) any {
+	r.msgs.mu.Lock()
+	if n := pos.Seq - r.msgs.first; n > 0 {
+		r.msgs.all = append([]*cmn.TCOMsg(nil), r.msgs.all[n:]...)
+		r.msgs.first = pos.Seq
+	}
+	ckm := &TCOCkpt{Msgs: r.msgs.all, First: r.msgs.first}
+	r.msgs.mu.Unlock()
+	return ckm
 }
 
 //
@@ -368,14 +486,15 @@ func (r *XactTCO) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *core.LOM
 // until after the transformation; here we are disregarding the size anyway as the stats
 // are done elsewhere
 
-func (wi *tcowi) do(lom *core.LOM, lrit *lrit, buf []byte) {
+func (wi *tcowi) do(lom *core.LOM, lrit *lrit, buf []byte, epoch int64) {
 	r := wi.r
 	a := r.copier.prepare(lom, r.args.BckTo, &r.args.Msg.TCBMsg, r.config, buf, r.owt)
 
 	// multiple messages per x-tco (compare w/ x-tcb)
 	a.LatestVer, a.Sync = wi.msg.LatestVer, wi.msg.Sync
 
-	err := r.copier.do(a, lom, r.p.dm)
+	r.ckpt.retain(epoch) // (released by the copier, possibly asynchronously)
+	err := r.copier.do(a, lom, r.p.dm, epoch)
 	if cos.IsNotExist(err, 0) && lrit.lrp == lrpList {
 		r.AddErr(err, 5, cos.SmoduleXs)
 	}
@@ -411,6 +530,6 @@ func (r *XactTCO) prune(pruneit *lrit, smap *meta.Smap, pt *cos.ParsedTemplate)
 	syncit.wait()
 }
 
-func (syncwi *syncwi) do(lom *core.LOM, _ *lrit, _ []byte) {
+func (syncwi *syncwi) do(lom *core.LOM, _ *lrit, _ []byte, _ int64) {
 	syncwi.rp.do(lom, nil)
 }
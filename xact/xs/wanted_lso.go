@@ -10,6 +10,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 )
 
@@ -39,6 +40,10 @@ func (wi *walkInfo) setWanted(en *cmn.LsoEnt, lom *core.LOM) {
 	var (
 		checkVchanged = wi.msg.IsFlagSet(apc.LsDiff)
 	)
+	// slow path: recompute content checksum (and store it if missing)
+	if wi.msg.IsFlagSet(apc.LsValidateCksum) {
+		validateCksum(lom, en)
+	}
 	for name, fl := range allmap {
 		if !wi.wanted.IsSet(fl) {
 			continue
@@ -71,6 +76,9 @@ func (wi *walkInfo) setWanted(en *cmn.LsoEnt, lom *core.LOM) {
 		case apc.GetPropsEC:
 			// TODO at the risk of significant slow-down
 
+		case apc.GetPropsTags:
+			en.Tags, _ = lom.GetCustomKey(cmn.TagsObjMD)
+
 		case apc.GetPropsCustom:
 			// en.Custom is set via one of the two alternative flows:
 			// - checkRemoteMD => HEAD(obj)
@@ -99,3 +107,15 @@ func (wi *walkInfo) setWanted(en *cmn.LsoEnt, lom *core.LOM) {
 		}
 	}
 }
+
+func validateCksum(lom *core.LOM, en *cmn.LsoEnt) {
+	lom.Lock(false)
+	err := lom.ValidateContentChecksum()
+	lom.Unlock(false)
+	switch {
+	case cos.IsErrBadCksum(err):
+		en.SetFlag(apc.EntryBadCksum)
+	case err != nil:
+		nlog.Warningln("failed to validate", lom.Cname(), "checksum:", err)
+	}
+}
@@ -6,6 +6,7 @@
 package xs
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -29,6 +30,7 @@ type (
 		msg          *apc.LsoMsg
 		lomVisitedCb lomVisitedCb
 		custom       cos.StrKVs
+		flt          *cmn.LsoFilter // server-side filter, if requested
 		markerDir    string
 		wanted       cos.BitFlags
 	}
@@ -55,9 +57,50 @@ func newWalkInfo(msg *apc.LsoMsg, lomVisitedCb lomVisitedCb) (wi *walkInfo) {
 	if msg.IsFlagSet(apc.LsDiff) {
 		wi.custom = make(cos.StrKVs)
 	}
+	wi.initFilter(msg)
 	return
 }
 
+func (wi *walkInfo) initFilter(msg *apc.LsoMsg) {
+	if msg.Filter == nil || msg.Filter.IsEmpty() {
+		return
+	}
+	flt, err := cmn.NewLsoFilter(msg.Filter)
+	debug.AssertNoErr(err) // validated by the target prior to renewing x-lso
+	wi.flt = flt
+}
+
+// evaluate server-side filter against in-cluster object
+func (wi *walkInfo) matchLOM(lom *core.LOM) bool {
+	name := lom.ObjName
+	if lom.IsFntl() {
+		if orig := lom.OrigFntl(); orig != nil {
+			name = orig[1]
+		}
+	}
+	if !wi.flt.MatchName(name) {
+		return false
+	}
+	if wi.flt.NameOnly() {
+		return true
+	}
+	a := cmn.LsoFilterAttrs{
+		Name:     name,
+		Size:     lom.Lsize(),
+		Atime:    lom.AtimeUnix(),
+		HasCksum: !lom.Checksum().IsEmpty(),
+		Custom:   lom.GetCustomKey,
+	}
+	if wi.flt.MtimeAfter != 0 || wi.flt.MtimeBefore != 0 {
+		if a.Mtime = cmn.LastModified(lom.GetCustomKey); a.Mtime == 0 {
+			if finfo, err := os.Stat(lom.FQN); err == nil {
+				a.Mtime = finfo.ModTime().UnixNano()
+			}
+		}
+	}
+	return wi.flt.Match(&a)
+}
+
 func (wi *walkInfo) lsmsg() *apc.LsoMsg { return wi.msg }
 
 func (wi *walkInfo) processDir(fqn string) error {
@@ -89,6 +132,9 @@ func (wi *walkInfo) match(objName string) bool {
 
 // new entry to be added to the listed page (note: slow path)
 func (wi *walkInfo) ls(lom *core.LOM, status uint16) (en *cmn.LsoEnt) {
+	if wi.flt != nil && !wi.matchLOM(lom) {
+		return nil
+	}
 	en = &cmn.LsoEnt{Name: lom.ObjName, Flags: status | apc.EntryIsCached}
 
 	if lom.IsFntl() {
@@ -175,7 +221,7 @@ func (wi *walkInfo) _cb(lom *core.LOM, fqn string) (*cmn.LsoEnt, error) {
 	}
 
 	// [shortcut]: name-only optimizes-out loading md (NOTE: won't show misplaced and copies)
-	if wi.msg.IsFlagSet(apc.LsNameOnly) && !fs.HasPrefixFntl(lom.ObjName) {
+	if wi.msg.IsFlagSet(apc.LsNameOnly) && !fs.HasPrefixFntl(lom.ObjName) && (wi.flt == nil || wi.flt.NameOnly()) {
 		if !isOK(status) {
 			return nil, nil
 		}